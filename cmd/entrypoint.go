@@ -37,6 +37,12 @@ func getConfig(configPath string) (*definitions.GleeceConfig, error) {
 		return nil, fmt.Errorf(`configuration file "%s" is invalid - "%s"`, configPath, validation.ExtractValidationErrorMessage(err, nil))
 	}
 
+	// Type mappings affect the extraction and generation phases alike and are hence resolved alongside the generator's configuration
+	config.OpenAPIGeneratorConfig.TypeMappings, err = definitions.NewTypeMappings(config.TypeMappings)
+	if err != nil {
+		return nil, fmt.Errorf(`configuration file "%s" is invalid - "%v"`, configPath, err)
+	}
+
 	return &config, nil
 }
 
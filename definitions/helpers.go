@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/gopher-fleece/runtime"
 )
@@ -85,6 +86,103 @@ var validHttpStatusCode = map[uint]struct{}{
 	uint(runtime.StatusNetworkAuthenticationRequired): {},
 }
 
+// wellKnownTypes maps the fully qualified names of commonly used standard library (and de-facto standard) types
+// to their OpenAPI representation, matching the way encoding/json serializes them
+var wellKnownTypes = map[string]WellKnownType{
+	"time.Time":                   {OpenApiType: "string", OpenApiFormat: "date-time"},
+	"time.Duration":               {OpenApiType: "integer", OpenApiFormat: "int64"},
+	"encoding/json.RawMessage":    {},
+	"[]byte":                      {OpenApiType: "string", OpenApiFormat: "byte"},
+	"[]uint8":                     {OpenApiType: "string", OpenApiFormat: "byte"},
+	"net.IP":                      {OpenApiType: "string"},
+	"net/url.URL":                 {OpenApiType: "string", OpenApiFormat: "uri"},
+	"github.com/google/uuid.UUID": {OpenApiType: "string", OpenApiFormat: "uuid"},
+	FormFileTypeName:              {OpenApiType: "string", OpenApiFormat: "binary"},
+	ReaderTypeName:                {OpenApiType: "string", OpenApiFormat: "binary"},
+	ReadCloserTypeName:            {OpenApiType: "string", OpenApiFormat: "binary"},
+}
+
+// NewTypeMappings resolves the user-configured type mappings (see GleeceConfig.TypeMappings)
+func NewTypeMappings(mappings map[string]TypeMapping) (TypeMappings, error) {
+	newMappings := TypeMappings{}
+	for fullTypeName, mapping := range mappings {
+		mappedType := WellKnownType{Schema: mapping.Schema}
+
+		// The type and format are kept alongside the fragment for the benefit of type-dependent logic, e.g. validations
+		if openApiType, isString := mapping.Schema["type"].(string); isString {
+			mappedType.OpenApiType = openApiType
+		}
+		if openApiFormat, isString := mapping.Schema["format"].(string); isString {
+			mappedType.OpenApiFormat = openApiFormat
+		}
+
+		if mapping.ParseFunc != "" {
+			separatorIndex := strings.LastIndex(mapping.ParseFunc, ".")
+			if separatorIndex <= 0 || separatorIndex == len(mapping.ParseFunc)-1 {
+				return nil, fmt.Errorf(
+					"parse function '%s' of type mapping '%s' must be fully qualified, e.g. 'github.com/oklog/ulid/v2.Parse'",
+					mapping.ParseFunc,
+					fullTypeName,
+				)
+			}
+			mappedType.ParseFuncPackage = mapping.ParseFunc[:separatorIndex]
+			mappedType.ParseFuncName = mapping.ParseFunc[separatorIndex+1:]
+		}
+
+		newMappings[fullTypeName] = mappedType
+	}
+
+	return newMappings, nil
+}
+
+// GetWellKnownType returns the OpenAPI representation of the given fully qualified type name,
+// if it's one of the built-in well-known types, which the generated routes know how to parse.
+//
+// User-mapped types are resolved via TypeMappings.GetWellKnownType
+func GetWellKnownType(fullTypeName string) (WellKnownType, bool) {
+	wellKnownType, exists := wellKnownTypes[fullTypeName]
+	return wellKnownType, exists
+}
+
+// GetWellKnownType returns the OpenAPI representation of the given fully qualified type name,
+// if it's either a user-mapped type or a built-in well-known one. User mappings take precedence over built-in types.
+//
+// A nil TypeMappings resolves built-in types only
+func (m TypeMappings) GetWellKnownType(fullTypeName string) (WellKnownType, bool) {
+	if mappedType, exists := m[fullTypeName]; exists {
+		return mappedType, true
+	}
+	return GetWellKnownType(fullTypeName)
+}
+
+// GetGenericSchemaName returns the deterministic schema name of an instantiated generic type,
+// e.g. 'PageOfUser' for 'Page[User]' or 'PairOfStringAndInt' for 'Pair[string, int]'.
+//
+// Slice type arguments are named after their element type, e.g. 'PageOfUserList' for 'Page[[]User]'
+// and map type arguments are named as if they were a generic 'Map[K, V]', e.g. 'PageOfMapOfStringAndInt' for 'Page[map[string]int]'
+func GetGenericSchemaName(baseName string, typeArgNames []string) string {
+	argNames := make([]string, len(typeArgNames))
+	for i, argName := range typeArgNames {
+		suffix := ""
+		for strings.HasPrefix(argName, "[]") {
+			argName = strings.TrimPrefix(argName, "[]")
+			suffix += "List"
+		}
+
+		if argName != "" {
+			argName = strings.ToUpper(argName[:1]) + argName[1:]
+		}
+		argNames[i] = argName + suffix
+	}
+	return baseName + "Of" + strings.Join(argNames, "And")
+}
+
+// GetMapTypeArgName returns the name by which a map type argument is referred to in a generic schema name,
+// e.g. 'MapOfStringAndInt' for 'map[string]int'
+func GetMapTypeArgName(keyName string, valueName string) string {
+	return GetGenericSchemaName("Map", []string{keyName, valueName})
+}
+
 func IsValidHttpVerb(verb string) bool {
 	_, exists := validHttpVerbs[verb]
 	return exists
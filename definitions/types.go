@@ -1,21 +1,49 @@
 package definitions
 
 import (
+	"fmt"
+
 	"github.com/gopher-fleece/runtime"
 )
 
 const Rfc7807ErrorName = "Rfc7807Error"
 const Rfc7807ErrorFullPackage = "github.com/gopher-fleece/runtime"
 
-// Enum of HTTP parma type (header, query, path, body)
+// Enum of HTTP parma type (header, query, path, body, form, form file, cookie)
 type ParamPassedIn string
 
 const (
-	PassedInHeader ParamPassedIn = "Header"
-	PassedInQuery  ParamPassedIn = "Query"
-	PassedInPath   ParamPassedIn = "Path"
-	PassedInBody   ParamPassedIn = "Body"
-	PassedInForm   ParamPassedIn = "Form"
+	PassedInHeader   ParamPassedIn = "Header"
+	PassedInQuery    ParamPassedIn = "Query"
+	PassedInPath     ParamPassedIn = "Path"
+	PassedInBody     ParamPassedIn = "Body"
+	PassedInForm     ParamPassedIn = "Form"
+	PassedInFormFile ParamPassedIn = "FormFile"
+	PassedInCookie   ParamPassedIn = "Cookie"
+)
+
+// The fully qualified name of the type files uploaded in multipart forms are bound to
+const FormFileTypeName = "mime/multipart.FileHeader"
+
+// The fully qualified names of the types controllers may return to stream a response body as-is
+const (
+	ReaderTypeName     = "io.Reader"
+	ReadCloserTypeName = "io.ReadCloser"
+)
+
+// The fully qualified name of the type controllers may return to send a file (see TypeMetadata.IsFileResponse)
+const FileResponseTypeName = "github.com/gopher-fleece/gleece/responses.FileResponse"
+
+// The serialization style of an array parameter, as defined by the OpenAPI specification
+type ParamStyle string
+
+const (
+	// Query parameters - 'ids=1&ids=2' when exploded or 'ids=1,2' otherwise
+	ParamStyleForm ParamStyle = "form"
+	// Header parameters - '1,2'
+	ParamStyleSimple ParamStyle = "simple"
+	// Query parameters - 'ids=1|2'
+	ParamStylePipeDelimited ParamStyle = "pipeDelimited"
 )
 
 type HttpVerb string
@@ -42,6 +70,7 @@ const (
 	ContentTypeFormURLEncoded ContentType = "application/x-www-form-urlencoded"
 	ContentTypeMultipartForm  ContentType = "multipart/form-data"
 	ContentTypeOctetStream    ContentType = "application/octet-stream"
+	ContentTypeEventStream    ContentType = "text/event-stream"
 	ContentTypePDF            ContentType = "application/pdf"
 	ContentTypePNG            ContentType = "image/png"
 	ContentTypeJPEG           ContentType = "image/jpeg"
@@ -94,6 +123,47 @@ type FuncParam struct {
 	UniqueImportSerial uint64
 	Validator          string
 	Deprecation        *DeprecationOptions
+
+	// The serialization style of array parameters. Empty for non-array parameters
+	Style ParamStyle
+
+	// Whether each value of an array parameter is sent separately, i.e., 'ids=1&ids=2'
+	Explode bool
+
+	// The fields of a struct-typed query parameter, each bound to a query parameter of its own.
+	// Empty for non-struct parameters
+	QueryFields []FuncParam
+
+	// For the fields of struct-typed query parameters - the name of the struct field the parameter populates
+	FieldName string
+
+	// The value of a query, header or form parameter when absent from the request, as it would be sent in the request.
+	// Set by the 'default' property of the parameter's annotation. Empty if the parameter has no default
+	DefaultValue string
+
+	// For body parameters - a JSON object holding the default values of the body's fields, as set by their @Default annotations.
+	// The body is decoded over these values so they apply to the fields absent from it. Empty if none of the fields have defaults
+	BodyDefaults string
+
+	// For form file parameters - the maximum size, in bytes, of each uploaded file. Zero if unlimited
+	MaxFileSize int64
+
+	// For form file parameters - the MIME types uploaded files may be of, e.g. 'image/png' or 'image/*'. Empty if unrestricted
+	AllowedMimeTypes []string
+}
+
+// ValuesSeparator returns the separator between the values of an array parameter.
+// Returns an empty string if each value is sent separately
+func (p FuncParam) ValuesSeparator() string {
+	switch p.Style {
+	case ParamStylePipeDelimited:
+		return "|"
+	case ParamStyleForm:
+		if p.Explode {
+			return ""
+		}
+	}
+	return ","
 }
 
 type FuncReturnValue struct {
@@ -110,6 +180,197 @@ type TypeMetadata struct {
 	IsUniverseType        bool
 	IsByAddress           bool
 	EntityKind            AstNodeKind
+
+	// The universe type a named primitive is declared over (e.g. 'string' for 'type OrderStatus string' or 'type UserID = string').
+	// Empty for non-primitive types
+	UnderlyingType string
+
+	// The values of the constants declared for a named primitive (i.e., an 'enum'), in declaration order
+	EnumValues []string
+
+	// The type arguments of an instantiated generic type, e.g. 'User' for 'Page[User]'
+	TypeArgs []TypeMetadata
+
+	// The name of the type's schema in the spec's components, as determined by the schema naming strategy.
+	// Empty for types that are not models
+	SchemaName string
+
+	// The type of a slice's elements, e.g. 'int' for '[]int'. Nil for non-slice types
+	ElementType *TypeMetadata
+
+	// The types of a map's keys and values, e.g. 'string' and 'User' for 'map[string]User'. Nil for non-map types
+	KeyType   *TypeMetadata
+	ValueType *TypeMetadata
+
+	// For polymorphic interfaces - the name of the property identifying the concrete type of a value (see '@Discriminator')
+	Discriminator string
+
+	// For polymorphic interfaces - the structs the interface may hold (see '@OneOf')
+	OneOf []OneOfMember
+
+	// The user-configured mapping of the type (see GleeceConfig.TypeMappings). Nil for types that are not mapped
+	Mapping *WellKnownType
+
+	// Whether the type is a file returned by a route, i.e., a 'responses.FileResponse', whose content is streamed as the response body
+	// along with the file name and the content type it's sent with
+	IsFileResponse bool
+}
+
+func (t TypeMetadata) IsSlice() bool {
+	return t.ElementType != nil && !t.IsChannel()
+}
+
+// IsChannel returns whether the type is a receive-only channel, whose values are streamed as server-sent events.
+// Like slices, channels describe the type of their values in 'ElementType'
+func (t TypeMetadata) IsChannel() bool {
+	return t.EntityKind == AstNodeKindChannel
+}
+
+func (t TypeMetadata) IsMap() bool {
+	return t.ValueType != nil
+}
+
+// IsNamedPrimitive returns whether the type is declared over a universe type, e.g. 'type UserID string'.
+// Enums are named primitives as well
+func (t TypeMetadata) IsNamedPrimitive() bool {
+	return t.UnderlyingType != ""
+}
+
+func (t TypeMetadata) IsEnum() bool {
+	return len(t.EnumValues) > 0
+}
+
+// IsFormFile returns whether the type is a file uploaded in a multipart form or a slice of such files
+func (t TypeMetadata) IsFormFile() bool {
+	if t.IsSlice() {
+		return t.ElementType.IsFormFile()
+	}
+	return t.FullName() == FormFileTypeName
+}
+
+// IsStream returns whether the type is an io.Reader or io.ReadCloser whose content is streamed as a response body
+func (t TypeMetadata) IsStream() bool {
+	fullName := t.FullName()
+	return fullName == ReaderTypeName || fullName == ReadCloserTypeName
+}
+
+func (t TypeMetadata) IsPolymorphic() bool {
+	return len(t.OneOf) > 0
+}
+
+// FullName returns the fully qualified name of the type, e.g. 'time.Time' or 'github.com/google/uuid.UUID'
+func (t TypeMetadata) FullName() string {
+	if t.FullyQualifiedPackage == "" {
+		return t.Name
+	}
+	return t.FullyQualifiedPackage + "." + t.Name
+}
+
+func (t TypeMetadata) IsGenericInstance() bool {
+	return len(t.TypeArgs) > 0
+}
+
+// GetWellKnownType returns the OpenAPI representation of the type if it's either user-mapped or a built-in well-known type
+func (t TypeMetadata) GetWellKnownType() (WellKnownType, bool) {
+	// File responses are described by their content
+	if t.IsFileResponse {
+		return GetWellKnownType(ReaderTypeName)
+	}
+
+	if t.Mapping != nil {
+		return *t.Mapping, true
+	}
+	return GetWellKnownType(t.FullName())
+}
+
+func (t TypeMetadata) IsWellKnownType() bool {
+	_, isWellKnown := t.GetWellKnownType()
+	return isWellKnown
+}
+
+// SchemaTypeName returns the name the spec generators use to refer to the type.
+// Well-known types are referred to by their full name so they may be mapped to their dedicated schemas.
+// Instantiated generic types are referred to by their generic schema name, e.g. 'PageOfUser'
+func (t TypeMetadata) SchemaTypeName() string {
+	if t.IsFileResponse {
+		return ReaderTypeName
+	}
+
+	if t.SchemaName != "" {
+		return t.SchemaName
+	}
+
+	if t.IsWellKnownType() {
+		return t.FullName()
+	}
+
+	// Named primitives without schemas of their own are described by the universe type they're declared over
+	if t.IsNamedPrimitive() && !t.IsEnum() {
+		return t.UnderlyingType
+	}
+
+	// Channels are described as the sequence of values they carry
+	if t.IsSlice() || t.IsChannel() {
+		return "[]" + t.ElementType.SchemaTypeName()
+	}
+
+	if t.IsMap() {
+		return fmt.Sprintf("map[%s]%s", t.KeyType.SchemaTypeName(), t.ValueType.SchemaTypeName())
+	}
+
+	if t.IsGenericInstance() {
+		argNames := make([]string, len(t.TypeArgs))
+		for i, arg := range t.TypeArgs {
+			argNames[i] = arg.typeArgName()
+		}
+		return GetGenericSchemaName(t.Name, argNames)
+	}
+
+	return t.Name
+}
+
+// typeArgName returns the name by which the type is referred to when given as a type argument, see GetGenericSchemaName
+func (t TypeMetadata) typeArgName() string {
+	switch {
+	case t.IsGenericInstance():
+		return t.SchemaTypeName()
+	case t.IsSlice():
+		return "[]" + t.ElementType.typeArgName()
+	case t.IsMap():
+		return GetMapTypeArgName(t.KeyType.typeArgName(), t.ValueType.typeArgName())
+	default:
+		return t.Name
+	}
+}
+
+// WellKnownType describes the OpenAPI representation of a commonly used, non-primitive type such as 'time.Time'
+type WellKnownType struct {
+	// The OpenAPI type of the schema. Empty for types that may hold any JSON value
+	OpenApiType string
+
+	// The OpenAPI format of the schema, if any
+	OpenApiFormat string
+
+	// A user-provided OpenAPI schema fragment which, when set, is used as-is instead of the type and format
+	Schema map[string]any
+
+	// The package and name of a user-provided 'func(string) (T, error)' used to parse non-body parameters of the type
+	ParseFuncPackage string
+	ParseFuncName    string
+}
+
+// TypeMappings holds the resolved user-configured type mappings (see GleeceConfig.TypeMappings), keyed by fully qualified type name.
+// Mapped types are treated as well-known types
+type TypeMappings map[string]WellKnownType
+
+// TypeMapping maps a Go type to a custom OpenAPI representation
+type TypeMapping struct {
+	// The OpenAPI schema fragment used to represent the type, e.g. { "type": "string", "format": "ulid" }
+	Schema map[string]any `json:"schema" validate:"required"`
+
+	// An optional, fully qualified function with a 'func(string) (T, error)' signature, used by the generated routes to
+	// parse header, path, query and form parameters of the type, e.g. 'github.com/oklog/ulid/v2.Parse'
+	ParseFunc string `json:"parseFunc"`
 }
 
 type ErrorResponse struct {
@@ -163,16 +424,20 @@ type RouteMetadata struct {
 	// Metadata on the type of errors that may be returned from the operation
 	ErrorResponses []ErrorResponse
 
-	// The expected request content type.
-	//
-	// Currently hard-coded to application/json.
+	// The primary request content type, i.e., the first of the media types in Consumes
 	RequestContentType ContentType
 
-	// The expected response content type.
-	//
-	// Currently hard-coded to application/json.
+	// The primary response content type, i.e., the first of the media types in Produces
 	ResponseContentType ContentType
 
+	// The media types the operation's request body may be sent in, in order of preference.
+	// Set by the route's or controller's @Consumes annotations; Defaults to application/json
+	Consumes []ContentType
+
+	// The media types the operation's response may be sent in, in order of preference.
+	// Set by the route's or controller's @Produces annotations; Defaults to application/json
+	Produces []ContentType
+
 	// The security schema/s used for the operation
 	Security []RouteSecurity // OR between security routes
 
@@ -223,6 +488,11 @@ type ControllerMetadata struct {
 	// The default security schema/s used for the controller's operations.
 	// May be overridden at the route level
 	Security []RouteSecurity
+
+	// The default media types the controller's operations consume and produce.
+	// May be overridden at the route level
+	Consumes []ContentType
+	Produces []ContentType
 }
 
 type ModelMetadata struct {
@@ -231,6 +501,53 @@ type ModelMetadata struct {
 	Description           string
 	Fields                []FieldMetadata
 	Deprecation           DeprecationOptions
+
+	// For named primitive models, including enums - the universe type the model is declared over
+	UnderlyingType string
+
+	// The name of the model's XML element, as given by the tag of the struct's 'XMLName' field.
+	// Empty if the struct has no such field
+	XmlName string
+
+	// For enum models - the values of the enum's constants, in declaration order
+	EnumValues []string
+
+	// When embedded structs are composed via 'allOf' - the names of the models embedded in this one.
+	// The fields of embedded models are not repeated in Fields
+	EmbeddedModels []string
+
+	// For polymorphic interface models - the name of the property identifying the concrete type of a value
+	Discriminator string
+
+	// For polymorphic interface models - the structs the interface may hold
+	OneOf []OneOfMember
+}
+
+func (m ModelMetadata) IsNamedPrimitive() bool {
+	return m.UnderlyingType != ""
+}
+
+func (m ModelMetadata) IsEnum() bool {
+	return len(m.EnumValues) > 0
+}
+
+func (m ModelMetadata) IsPolymorphic() bool {
+	return len(m.OneOf) > 0
+}
+
+// OneOfMember is a struct a polymorphic interface may hold, as listed by the interface's '@OneOf' annotations
+type OneOfMember struct {
+	// The name of the struct, declared in the interface's package
+	Name string
+
+	// The value of the discriminator property identifying the struct
+	DiscriminatorValue string
+
+	// Whether the interface is implemented by a pointer to the struct rather than by the struct itself
+	IsByAddress bool
+
+	// The name of the struct's schema in the spec's components
+	SchemaName string
 }
 
 type FieldMetadata struct {
@@ -239,6 +556,27 @@ type FieldMetadata struct {
 	Description string
 	Tag         string
 	Deprecation *DeprecationOptions
+
+	// Whether the field is a pointer, i.e., may be null
+	IsByAddress bool
+
+	// The example and default values of the field, as set by its @Example and @Default annotations and parsed per the field's type.
+	// Nil if not set
+	Example any
+	Default any
+
+	// The format and pattern of the field's values, as set by its @Format and @Pattern annotations
+	Format  string
+	Pattern string
+
+	// Whether the field is only sent in responses (@ReadOnly) or only in requests (@WriteOnly)
+	ReadOnly  bool
+	WriteOnly bool
+}
+
+// HasValueAnnotations returns whether any of the field's @Example, @Default, @Format, @Pattern, @ReadOnly or @WriteOnly annotations are set
+func (f FieldMetadata) HasValueAnnotations() bool {
+	return f.Example != nil || f.Default != nil || f.Format != "" || f.Pattern != "" || f.ReadOnly || f.WriteOnly
 }
 
 type SecuritySchemeType string
@@ -297,8 +635,48 @@ type OpenAPIGeneratorConfig struct {
 	SecuritySchemes      []SecuritySchemeConfig       `json:"securitySchemes" validate:"dive"`
 	DefaultRouteSecurity *SecurityAnnotationComponent `json:"defaultSecurity"`
 	SpecGeneratorConfig  SpecGeneratorConfig          `json:"specGeneratorConfig" validate:"required"`
+	EmbeddedStructsMode  EmbeddedStructsMode          `json:"embeddedStructsMode" validate:"omitempty,oneof=flatten allOf"`
+	SchemaNamingStrategy SchemaNamingStrategy         `json:"schemaNamingStrategy" validate:"omitempty,oneof=short packageQualified"`
+	NamedPrimitivesMode  NamedPrimitivesMode          `json:"namedPrimitivesMode" validate:"omitempty,oneof=inline schema"`
+
+	// The resolved type mappings (see GleeceConfig.TypeMappings).
+	// Not read from the configuration file - populated once the configuration is loaded
+	TypeMappings TypeMappings `json:"-"`
 }
 
+// EmbeddedStructsMode determines how the fields of embedded structs are represented in model schemas
+type EmbeddedStructsMode string
+
+const (
+	// Promote the fields of embedded structs into the embedding struct, the same way encoding/json does.
+	// This is the default
+	EmbeddedStructsModeFlatten EmbeddedStructsMode = "flatten"
+	// Generate a schema for each embedded struct and compose the embedding struct's schema using 'allOf'
+	EmbeddedStructsModeAllOf EmbeddedStructsMode = "allOf"
+)
+
+// SchemaNamingStrategy determines how model schemas are named in the spec's components.
+// A struct's '@Name' annotation, when present, takes precedence over the strategy
+type SchemaNamingStrategy string
+
+const (
+	// Name schemas after their type, e.g. 'Invoice'. This is the default
+	SchemaNamingStrategyShort SchemaNamingStrategy = "short"
+	// Name schemas after their package and type, e.g. 'billing.Invoice'
+	SchemaNamingStrategyPackageQualified SchemaNamingStrategy = "packageQualified"
+)
+
+// NamedPrimitivesMode determines how named primitives that are not enums, e.g. 'type UserID string', are described.
+// Enums always have schemas of their own
+type NamedPrimitivesMode string
+
+const (
+	// Describe named primitives by the universe type they're declared over, e.g. 'string'. This is the default
+	NamedPrimitivesModeInline NamedPrimitivesMode = "inline"
+	// Generate a schema for each named primitive, described by the type's doc comment, and refer to it by name
+	NamedPrimitivesModeSchema NamedPrimitivesMode = "schema"
+)
+
 type RoutingEngineType string
 
 const (
@@ -332,6 +710,9 @@ type GleeceConfig struct {
 	CommonConfig           CommonConfig           `json:"commonConfig" validate:"required"`
 	RoutesConfig           RoutesConfig           `json:"routesConfig" validate:"required"`
 	OpenAPIGeneratorConfig OpenAPIGeneratorConfig `json:"openapiGeneratorConfig" validate:"required"`
+
+	// Custom representations for Go types, keyed by their fully qualified name, e.g. 'github.com/oklog/ulid/v2.ULID'
+	TypeMappings map[string]TypeMapping `json:"typeMappings" validate:"dive"`
 }
 
 type AstNodeKind string
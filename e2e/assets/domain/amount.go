@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var amountPattern = regexp.MustCompile(`^\d+(\.\d{2})?$`)
+
+// Amount is a monetary amount, serialized as a decimal string such as "12.50"
+type Amount struct {
+	cents int64
+}
+
+func ParseAmount(value string) (Amount, error) {
+	if !amountPattern.MatchString(value) {
+		return Amount{}, fmt.Errorf("'%s' is not a valid amount", value)
+	}
+
+	digits := strings.Replace(value, ".", "", 1)
+	if !strings.Contains(value, ".") {
+		digits += "00"
+	}
+
+	cents, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil {
+		return Amount{}, err
+	}
+	return Amount{cents: cents}, nil
+}
+
+func (a Amount) Add(other Amount) Amount {
+	return Amount{cents: a.cents + other.cents}
+}
+
+func (a Amount) String() string {
+	return fmt.Sprintf("%d.%02d", a.cents/100, a.cents%100)
+}
+
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.String())
+}
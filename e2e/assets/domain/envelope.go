@@ -0,0 +1,12 @@
+package domain
+
+// Envelope wraps a payload alongside its schema version
+type Envelope[T any] struct {
+	Data    T   `json:"data" validate:"required"`
+	Version int `json:"version"`
+}
+
+type Pair[K any, V any] struct {
+	Key   K `json:"key"`
+	Value V `json:"value"`
+}
@@ -0,0 +1,4 @@
+package domain
+
+// Region identifies a deployment region, e.g. 'eu-west'
+type Region string
@@ -1,11 +1,23 @@
 package assets
 
 import (
+	"encoding/json"
+	"encoding/xml"
 	"fmt"
+	"io"
+	"math"
+	"mime/multipart"
+	"net"
 	"net/http"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/gopher-fleece/gleece/e2e/assets/domain"
+	"github.com/gopher-fleece/gleece/responses"
 	"github.com/gopher-fleece/runtime"
 	"github.com/labstack/echo/v4"
 )
@@ -376,3 +388,440 @@ func (ec *E2EController) TemplateContext2() (string, error) {
 func (ec *E2EController) TestForm(item1 string, item2 string) (string, error) {
 	return item1 + item2, nil
 }
+
+// @Description The status of an order
+type OrderStatus string
+
+const (
+	OrderStatusPending   OrderStatus = "pending"
+	OrderStatusShipped   OrderStatus = "shipped"
+	OrderStatusDelivered OrderStatus = "delivered"
+)
+
+type OrderPriority int
+
+const (
+	OrderPriorityLow OrderPriority = iota
+	OrderPriorityMedium
+	OrderPriorityHigh
+)
+
+type OrderInfo struct {
+	Status   OrderStatus   `json:"status"`
+	Priority OrderPriority `json:"priority"`
+}
+
+// @Method(GET)
+// @Route(/enum-params/{status})
+// @Path(status)
+// @Query(priority)
+// @Header(headerStatus, { name: "x-status" })
+func (ec *E2EController) EnumParams(status OrderStatus, priority OrderPriority, headerStatus OrderStatus) (OrderInfo, error) {
+	if status != headerStatus {
+		return OrderInfo{}, fmt.Errorf("path status '%s' does not match header status '%s'", status, headerStatus)
+	}
+	return OrderInfo{Status: status, Priority: priority}, nil
+}
+
+type WellKnownTypesInfo struct {
+	ID      uuid.UUID       `json:"id"`
+	At      time.Time       `json:"at"`
+	Timeout time.Duration   `json:"timeout"`
+	Data    []byte          `json:"data"`
+	Raw     json.RawMessage `json:"raw"`
+	Address net.IP          `json:"address"`
+	Link    string          `json:"link"`
+}
+
+// @Method(GET)
+// @Route(/well-known-params/{id})
+// @Path(id)
+// @Query(at)
+// @Query(timeout)
+// @Query(data)
+// @Query(raw)
+// @Header(address, { name: "x-address" })
+// @Query(link)
+func (ec *E2EController) WellKnownParams(
+	id uuid.UUID,
+	at time.Time,
+	timeout time.Duration,
+	data []byte,
+	raw json.RawMessage,
+	address net.IP,
+	link url.URL,
+) (WellKnownTypesInfo, error) {
+	return WellKnownTypesInfo{
+		ID:      id,
+		At:      at,
+		Timeout: timeout,
+		Data:    data,
+		Raw:     raw,
+		Address: address,
+		Link:    link.String(),
+	}, nil
+}
+
+type AmountInfo struct {
+	Total domain.Amount `json:"total"`
+}
+
+// @Method(GET)
+// @Route(/mapped-params/{amount})
+// @Path(amount)
+// @Query(tip)
+func (ec *E2EController) MappedParams(amount domain.Amount, tip domain.Amount) (AmountInfo, error) {
+	return AmountInfo{Total: amount.Add(tip)}, nil
+}
+
+// @Method(POST)
+// @Route(/generic-envelope)
+// @Body(envelope)
+func (ec *E2EController) GenericEnvelope(envelope domain.Envelope[BodyInfo]) (domain.Envelope[domain.Pair[string, BodyInfo]], error) {
+	return domain.Envelope[domain.Pair[string, BodyInfo]]{
+		Data:    domain.Pair[string, BodyInfo]{Key: "echo", Value: envelope.Data},
+		Version: envelope.Version + 1,
+	}, nil
+}
+
+// @Method(POST)
+// @Route(/generic-envelope-map)
+// @Body(envelope)
+func (ec *E2EController) GenericEnvelopeMap(envelope domain.Envelope[map[domain.Region]BodyInfo]) (domain.Envelope[map[domain.Region]string], error) {
+	data := map[domain.Region]string{}
+	for region, info := range envelope.Data {
+		data[region] = info.BodyParam
+	}
+	return domain.Envelope[map[domain.Region]string]{Data: data, Version: envelope.Version + 1}, nil
+}
+
+type SliceParamsInfo struct {
+	Ids      []int    `json:"ids"`
+	Tags     []string `json:"tags"`
+	Names    []string `json:"names"`
+	Statuses []string `json:"statuses"`
+	Keys     []string `json:"keys"`
+	Labels   []string `json:"labels"`
+}
+
+// @Method(GET)
+// @Route(/slice-params)
+// @Query(ids)
+// @Query(tags, { style: "form", explode: false })
+// @Query(names, { style: "pipeDelimited" })
+// @Query(statuses)
+// @Query(keys, { validate: "max=2" })
+// @Header(labels, { name: "x-labels" })
+func (ec *E2EController) SliceParams(
+	ids []int,
+	tags []string,
+	names []string,
+	statuses []OrderStatus,
+	keys []uuid.UUID,
+	labels []string,
+) (SliceParamsInfo, error) {
+	info := SliceParamsInfo{Ids: ids, Tags: tags, Names: names, Labels: labels}
+	for _, status := range statuses {
+		info.Statuses = append(info.Statuses, string(status))
+	}
+	for _, key := range keys {
+		info.Keys = append(info.Keys, key.String())
+	}
+	return info, nil
+}
+
+type ListFilter struct {
+	// The page to fetch
+	Page     int         `query:"page" validate:"min=1"`
+	PageSize *int        `query:"page_size" validate:"omitempty,max=100"`
+	Tags     []string    `query:"tag"`
+	Status   OrderStatus `query:"status"`
+	Internal string      `query:"-"`
+}
+
+type ListFilterInfo struct {
+	Page     int      `json:"page"`
+	PageSize *int     `json:"pageSize"`
+	Tags     []string `json:"tags"`
+	Status   string   `json:"status"`
+	Other    string   `json:"other"`
+}
+
+// @Method(GET)
+// @Route(/query-object)
+// @Query(filter)
+// @Query(other)
+func (ec *E2EController) QueryObject(filter ListFilter, other string) (ListFilterInfo, error) {
+	return ListFilterInfo{
+		Page:     filter.Page,
+		PageSize: filter.PageSize,
+		Tags:     filter.Tags,
+		Status:   string(filter.Status),
+		Other:    other,
+	}, nil
+}
+
+// A pet, told apart by its kind
+// @Discriminator(kind)
+// @OneOf(CatInfo, { value: "cat" })
+// @OneOf(DogInfo, { value: "dog" })
+type Pet interface {
+	PetName() string
+}
+
+type CatInfo struct {
+	Kind  string `json:"kind" validate:"required"`
+	Name  string `json:"name" validate:"required"`
+	Lives int    `json:"lives" validate:"min=1,max=9"`
+}
+
+func (c CatInfo) PetName() string {
+	return c.Name
+}
+
+type DogInfo struct {
+	Kind    string `json:"kind" validate:"required"`
+	Name    string `json:"name" validate:"required"`
+	GoodBoy bool   `json:"goodBoy"`
+}
+
+func (d *DogInfo) PetName() string {
+	return d.Name
+}
+
+type PetInfo struct {
+	Type string `json:"type"`
+	Pet  Pet    `json:"pet"`
+}
+
+// @Method(POST)
+// @Route(/polymorphic-body)
+// @Body(pet)
+func (ec *E2EController) PolymorphicBody(pet Pet) (PetInfo, error) {
+	return PetInfo{Type: fmt.Sprintf("%T", pet), Pet: pet}, nil
+}
+
+// @Method(GET)
+// @Route(/map-response)
+// @Query(names)
+func (ec *E2EController) MapResponse(names []string) (map[string]ListFilterInfo, error) {
+	infos := map[string]ListFilterInfo{}
+	for index, name := range names {
+		infos[name] = ListFilterInfo{Page: index, Other: name}
+	}
+	return infos, nil
+}
+
+type CustomerID string
+
+type Cents int64
+
+type Currency = string
+
+type ChargeInfo struct {
+	CustomerID CustomerID `json:"customerId"`
+	Amount     Cents      `json:"amount"`
+	Currency   Currency   `json:"currency"`
+	Splits     []Cents    `json:"splits"`
+}
+
+// @Method(GET)
+// @Route(/named-primitive-params/{customerId})
+// @Path(customerId)
+// @Query(amount)
+// @Query(splits)
+// @Header(currency, { name: "x-currency" })
+func (ec *E2EController) NamedPrimitiveParams(customerId CustomerID, amount Cents, splits []Cents, currency Currency) (ChargeInfo, error) {
+	return ChargeInfo{CustomerID: customerId, Amount: amount, Currency: currency, Splits: splits}, nil
+}
+
+type PagingInfo struct {
+	// @Default 1
+	Page int `json:"page"`
+
+	// @Default 50
+	Size int `json:"size"`
+}
+
+type DefaultsInfo struct {
+	// @Default guest
+	Name string `json:"name"`
+
+	// @Default 20
+	Limit int `json:"limit"`
+
+	// @Default true
+	Notify *bool `json:"notify"`
+
+	// @Default shipped
+	Status OrderStatus `json:"status"`
+
+	Paging PagingInfo `json:"paging"`
+}
+
+// @Method(POST)
+// @Route(/body-defaults)
+// @Body(info)
+func (ec *E2EController) BodyDefaults(info DefaultsInfo) (DefaultsInfo, error) {
+	return info, nil
+}
+
+type ParamDefaultsInfo struct {
+	Limit  int         `json:"limit"`
+	Notify *bool       `json:"notify"`
+	Status OrderStatus `json:"status"`
+	Region string      `json:"region"`
+	Note   string      `json:"note"`
+}
+
+// @Method(POST)
+// @Route(/param-defaults)
+// @Query(limit, { default: 20, validate: "required" })
+// @Query(notify, { default: true })
+// @Query(status, { default: "shipped" })
+// @Header(region, { name: "x-region", default: "eu" })
+// @FormField(note, { default: "none" })
+func (ec *E2EController) ParamDefaults(limit int, notify *bool, status OrderStatus, region string, note string) (ParamDefaultsInfo, error) {
+	return ParamDefaultsInfo{Limit: limit, Notify: notify, Status: status, Region: region, Note: note}, nil
+}
+
+type CookieInfo struct {
+	Session string  `json:"session"`
+	Theme   *string `json:"theme"`
+	Visits  int     `json:"visits"`
+}
+
+// @Method(GET)
+// @Route(/cookie-params)
+// @Cookie(session, { name: "session_id" })
+// @Cookie(theme)
+// @Cookie(visits, { validate: "gte=0" })
+func (ec *E2EController) CookieParams(session string, theme *string, visits int) (CookieInfo, error) {
+	return CookieInfo{Session: session, Theme: theme, Visits: visits}, nil
+}
+
+// @Method(POST)
+// @Route(/content-negotiation)
+// @Consumes(application/json)
+// @Produces(application/json)
+// @Body(info)
+func (ec *E2EController) ContentNegotiation(info DefaultsInfo) (DefaultsInfo, error) {
+	return info, nil
+}
+
+type XmlBook struct {
+	XMLName xml.Name `json:"-" xml:"book"`
+	Id      string   `json:"id" xml:"id,attr"`
+	Title   string   `json:"title" xml:"title" validate:"required"`
+}
+
+// @Method(POST)
+// @Route(/xml-serialization)
+// @Consumes(application/xml)
+// @Consumes(application/json)
+// @Produces(application/json)
+// @Produces(application/xml)
+// @Body(book)
+func (ec *E2EController) XmlSerialization(book XmlBook) (XmlBook, error) {
+	return book, nil
+}
+
+// @Method(GET)
+// @Route(/plain-text)
+// @Query(name)
+// @Produces(application/json)
+// @Produces(text/plain)
+func (ec *E2EController) PlainText(name string) (string, error) {
+	return "hello " + name, nil
+}
+
+type UploadInfo struct {
+	Title       string   `json:"title"`
+	Avatar      string   `json:"avatar"`
+	Attachments []string `json:"attachments"`
+}
+
+// @Method(POST)
+// @Route(/form-files)
+// @FormField(title)
+// @FormFile(avatar, { validate: "required", maxSize: 16, mimeTypes: ["image/*"] })
+// @FormFile(attachments, { name: "attachment", mimeTypes: ["text/plain", "application/pdf"] })
+func (ec *E2EController) FormFiles(title string, avatar *multipart.FileHeader, attachments []*multipart.FileHeader) (UploadInfo, error) {
+	file, err := avatar.Open()
+	if err != nil {
+		return UploadInfo{}, err
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return UploadInfo{}, err
+	}
+
+	info := UploadInfo{Title: title, Avatar: string(content), Attachments: []string{}}
+	for _, attachment := range attachments {
+		info.Attachments = append(info.Attachments, attachment.Filename)
+	}
+	return info, nil
+}
+
+// @Method(GET)
+// @Route(/stream-report)
+// @Query(name)
+// @Produces(text/csv)
+func (ec *E2EController) StreamReport(name string) (responses.FileResponse, error) {
+	return responses.FileResponse{
+		Content:  strings.NewReader("id,title\n1,Go"),
+		FileName: name + ".csv",
+	}, nil
+}
+
+// @Method(GET)
+// @Route(/download-notes)
+func (ec *E2EController) DownloadNotes() (responses.FileResponse, error) {
+	return responses.FileResponse{
+		Content:     strings.NewReader("some notes"),
+		FileName:    "my notes.txt",
+		ContentType: "text/plain; charset=utf-8",
+	}, nil
+}
+
+// @Method(GET)
+// @Route(/stream-bytes)
+func (ec *E2EController) StreamBytes() (io.Reader, error) {
+	return strings.NewReader("raw-bytes"), nil
+}
+
+type ProgressEvent struct {
+	Percent int `json:"percent"`
+}
+
+// @Method(GET)
+// @Route(/event-stream)
+// @Query(steps)
+// @Produces(text/event-stream)
+func (ec *E2EController) EventStream(steps int) (<-chan ProgressEvent, error) {
+	events := make(chan ProgressEvent)
+	go func() {
+		defer close(events)
+		for step := 1; step <= steps; step++ {
+			events <- ProgressEvent{Percent: step * 100 / steps}
+		}
+	}()
+	return events, nil
+}
+
+// @Method(GET)
+// @Route(/event-stream-error)
+// @Produces(text/event-stream)
+func (ec *E2EController) EventStreamError() (<-chan float64, error) {
+	events := make(chan float64)
+	go func() {
+		defer close(events)
+		// Infinity cannot be serialized, ending the stream. The value after it is discarded
+		for _, value := range []float64{1.5, math.Inf(1), 2} {
+			events <- value
+		}
+	}()
+	return events, nil
+}
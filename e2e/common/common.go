@@ -1,13 +1,23 @@
 package common
 
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/textproto"
+)
+
 type RouterTest struct {
 	Name                string
 	Path                string
 	Method              string
 	Body                any
+	RawBody             string // Sent as is, with the Content-Type given in Headers
 	Query               map[string]string
 	Headers             map[string]string
 	Form                map[string]string
+	Files               []FormFile // When set, Form is sent alongside the files as a multipart form
 	ExpectedStatus      int
 	ExpectedBody        string
 	ExpectedBodyContain string
@@ -19,3 +29,32 @@ type RouterTestResult struct {
 	Body    string
 	Headers map[string]string
 }
+
+type FormFile struct {
+	Field       string
+	FileName    string
+	ContentType string
+	Content     string
+}
+
+// BuildMultipartForm encodes the given form values and files as a multipart form,
+// returning the encoded body and its Content-Type
+func BuildMultipartForm(form map[string]string, files []FormFile) (io.Reader, string) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	for field, value := range form {
+		writer.WriteField(field, value)
+	}
+
+	for _, file := range files {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`, file.Field, file.FileName))
+		header.Set("Content-Type", file.ContentType)
+		part, _ := writer.CreatePart(header)
+		part.Write([]byte(file.Content))
+	}
+
+	writer.Close()
+	return body, writer.FormDataContentType()
+}
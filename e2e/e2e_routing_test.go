@@ -364,3 +364,771 @@ var _ = Describe("E2E Routing Spec", func() {
 		})
 	})
 })
+
+var _ = Describe("E2E Enum Routing Spec", func() {
+	It("Should accept valid enum parameters", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should accept valid enum parameters",
+			ExpectedStatus:      200,
+			ExpectedBodyContain: "{\"status\":\"shipped\",\"priority\":2}",
+			Path:                "/e2e/enum-params/shipped",
+			Method:              "GET",
+			Query:               map[string]string{"priority": "2"},
+			Headers:             map[string]string{"x-status": "shipped"},
+		})
+	})
+
+	It("Should reject an invalid string enum path parameter", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should reject an invalid string enum path parameter",
+			ExpectedStatus:      422,
+			ExpectedBodyContain: "'lost' is not a valid OrderStatus value",
+			Path:                "/e2e/enum-params/lost",
+			Method:              "GET",
+			Query:               map[string]string{"priority": "2"},
+			Headers:             map[string]string{"x-status": "shipped"},
+		})
+	})
+
+	It("Should reject an invalid string enum header parameter", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should reject an invalid string enum header parameter",
+			ExpectedStatus:      422,
+			ExpectedBodyContain: "'Shipped' is not a valid OrderStatus value",
+			Path:                "/e2e/enum-params/shipped",
+			Method:              "GET",
+			Query:               map[string]string{"priority": "1"},
+			Headers:             map[string]string{"x-status": "Shipped"},
+		})
+	})
+
+	It("Should reject an out of range integer enum query parameter", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should reject an out of range integer enum query parameter",
+			ExpectedStatus:      422,
+			ExpectedBodyContain: "'7' is not a valid OrderPriority value",
+			Path:                "/e2e/enum-params/pending",
+			Method:              "GET",
+			Query:               map[string]string{"priority": "7"},
+			Headers:             map[string]string{"x-status": "pending"},
+		})
+	})
+
+	It("Should reject a non-integer integer enum query parameter", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should reject a non-integer integer enum query parameter",
+			ExpectedStatus: 422,
+			Path:           "/e2e/enum-params/pending",
+			Method:         "GET",
+			Query:          map[string]string{"priority": "high"},
+			Headers:        map[string]string{"x-status": "pending"},
+		})
+	})
+})
+
+// getWellKnownQuery returns a valid query for the well-known types route, with the given parameter overridden
+func getWellKnownQuery(overrideName string, overrideValue string) map[string]string {
+	query := map[string]string{
+		"at":      "2024-01-02T03:04:05Z",
+		"timeout": "5000000000",
+		"data":    "aGVsbG8=",
+		"raw":     "{\"a\":1}",
+		"link":    "https://example.com/docs",
+	}
+
+	if overrideName != "" {
+		query[overrideName] = overrideValue
+	}
+	return query
+}
+
+var _ = Describe("E2E Well-Known Types Routing Spec", func() {
+	It("Should parse well-known type parameters", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should parse well-known type parameters",
+			ExpectedStatus: 200,
+			ExpectedBody: "{\"id\":\"5f0c6b1e-8d3a-4a1e-9a59-3f1a2b3c4d5e\",\"at\":\"2024-01-02T03:04:05Z\",\"timeout\":5000000000," +
+				"\"data\":\"aGVsbG8=\",\"raw\":{\"a\":1},\"address\":\"10.0.0.1\",\"link\":\"https://example.com/docs\"}",
+			Path:    "/e2e/well-known-params/5f0c6b1e-8d3a-4a1e-9a59-3f1a2b3c4d5e",
+			Method:  "GET",
+			Query:   getWellKnownQuery("", ""),
+			Headers: map[string]string{"x-address": "10.0.0.1"},
+		})
+	})
+
+	It("Should reject an invalid UUID path parameter", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should reject an invalid UUID path parameter",
+			ExpectedStatus:      422,
+			ExpectedBodyContain: "invalid UUID",
+			Path:                "/e2e/well-known-params/not-a-uuid",
+			Method:              "GET",
+			Query:               getWellKnownQuery("", ""),
+			Headers:             map[string]string{"x-address": "10.0.0.1"},
+		})
+	})
+
+	It("Should reject an invalid time query parameter", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should reject an invalid time query parameter",
+			ExpectedStatus: 422,
+			Path:           "/e2e/well-known-params/5f0c6b1e-8d3a-4a1e-9a59-3f1a2b3c4d5e",
+			Method:         "GET",
+			Query:          getWellKnownQuery("at", "yesterday"),
+			Headers:        map[string]string{"x-address": "10.0.0.1"},
+		})
+	})
+
+	It("Should reject an invalid IP header parameter", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should reject an invalid IP header parameter",
+			ExpectedStatus:      422,
+			ExpectedBodyContain: "'10.0.0' is not a valid IP address",
+			Path:                "/e2e/well-known-params/5f0c6b1e-8d3a-4a1e-9a59-3f1a2b3c4d5e",
+			Method:              "GET",
+			Query:               getWellKnownQuery("", ""),
+			Headers:             map[string]string{"x-address": "10.0.0"},
+		})
+	})
+
+	It("Should reject an invalid JSON query parameter", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should reject an invalid JSON query parameter",
+			ExpectedStatus:      422,
+			ExpectedBodyContain: "is not valid JSON",
+			Path:                "/e2e/well-known-params/5f0c6b1e-8d3a-4a1e-9a59-3f1a2b3c4d5e",
+			Method:              "GET",
+			Query:               getWellKnownQuery("raw", "{a"),
+			Headers:             map[string]string{"x-address": "10.0.0.1"},
+		})
+	})
+})
+
+var _ = Describe("E2E Type Mappings Routing Spec", func() {
+	It("Should parse mapped type parameters using the configured parse function", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should parse mapped type parameters using the configured parse function",
+			ExpectedStatus: 200,
+			ExpectedBody:   "{\"total\":\"15.00\"}",
+			Path:           "/e2e/mapped-params/12.50",
+			Method:         "GET",
+			Query:          map[string]string{"tip": "2.50"},
+		})
+	})
+
+	It("Should reject mapped type parameters the parse function fails on", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should reject mapped type parameters the parse function fails on",
+			ExpectedStatus:      422,
+			ExpectedBodyContain: "'12.5' is not a valid amount",
+			Path:                "/e2e/mapped-params/12.5",
+			Method:              "GET",
+			Query:               map[string]string{"tip": "2.50"},
+		})
+	})
+})
+
+var _ = Describe("E2E Generic Types Routing Spec", func() {
+	It("Should bind generic request bodies and reply with generic responses", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should bind generic request bodies and reply with generic responses",
+			ExpectedStatus: 200,
+			ExpectedBody:   "{\"data\":{\"key\":\"echo\",\"value\":{\"bodyParam\":\"the body\"}},\"version\":2}",
+			Path:           "/e2e/generic-envelope",
+			Method:         "POST",
+			Body:           map[string]any{"data": map[string]any{"bodyParam": "the body"}, "version": 1},
+		})
+	})
+
+	It("Should validate the type arguments of generic request bodies", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should validate the type arguments of generic request bodies",
+			ExpectedStatus:      422,
+			ExpectedBodyContain: "BodyParam",
+			Path:                "/e2e/generic-envelope",
+			Method:              "POST",
+			Body:                map[string]any{"data": map[string]any{}, "version": 1},
+		})
+	})
+
+	It("Should bind generic request bodies with map type arguments", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should bind generic request bodies with map type arguments",
+			ExpectedStatus: 200,
+			ExpectedBody:   "{\"data\":{\"eu-west\":\"the body\"},\"version\":2}",
+			Path:           "/e2e/generic-envelope-map",
+			Method:         "POST",
+			Body:           map[string]any{"data": map[string]any{"eu-west": map[string]any{"bodyParam": "the body"}}, "version": 1},
+		})
+	})
+})
+
+var _ = Describe("E2E Slice Parameters Routing Spec", func() {
+	It("Should parse slice query and header parameters in all supported styles", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should parse slice query and header parameters in all supported styles",
+			ExpectedStatus: 200,
+			ExpectedBody: "{\"ids\":[1,2],\"tags\":[\"a\",\"b\"],\"names\":[\"x\",\"y\"],\"statuses\":[\"pending\",\"shipped\"]," +
+				"\"keys\":[\"5d7f1a5e-4f3c-4a3f-9c59-2b0fbd4a6a1e\"],\"labels\":[\"l1\",\"l2\"]}",
+			Path: "/e2e/slice-params?ids=1&ids=2&tags=a,b&names=x|y&statuses=pending&statuses=shipped" +
+				"&keys=5d7f1a5e-4f3c-4a3f-9c59-2b0fbd4a6a1e",
+			Method:  "GET",
+			Headers: map[string]string{"x-labels": "l1, l2"},
+		})
+	})
+
+	It("Should reject slice parameters with invalid elements", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should reject slice parameters with invalid elements",
+			ExpectedStatus:      422,
+			ExpectedBodyContain: "Expected int but got string",
+			Path:                "/e2e/slice-params?ids=1&ids=two&tags=a&names=x&statuses=pending&keys=5d7f1a5e-4f3c-4a3f-9c59-2b0fbd4a6a1e",
+			Method:              "GET",
+			Headers:             map[string]string{"x-labels": "l1"},
+		})
+	})
+
+	It("Should reject slice parameters with invalid enum elements", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should reject slice parameters with invalid enum elements",
+			ExpectedStatus:      422,
+			ExpectedBodyContain: "'lost' is not a valid OrderStatus value",
+			Path:                "/e2e/slice-params?ids=1&tags=a&names=x&statuses=pending&statuses=lost&keys=5d7f1a5e-4f3c-4a3f-9c59-2b0fbd4a6a1e",
+			Method:              "GET",
+			Headers:             map[string]string{"x-labels": "l1"},
+		})
+	})
+
+	It("Should run validations on slice parameters", func() {
+		keys := "keys=5d7f1a5e-4f3c-4a3f-9c59-2b0fbd4a6a1e&keys=6d7f1a5e-4f3c-4a3f-9c59-2b0fbd4a6a1e&keys=7d7f1a5e-4f3c-4a3f-9c59-2b0fbd4a6a1e"
+		RunRouterTest(common.RouterTest{
+			Name:                "Should run validations on slice parameters",
+			ExpectedStatus:      422,
+			ExpectedBodyContain: "parameter 'keys' did not pass validation",
+			Path:                "/e2e/slice-params?ids=1&tags=a&names=x&statuses=pending&" + keys,
+			Method:              "GET",
+			Headers:             map[string]string{"x-labels": "l1"},
+		})
+	})
+
+	It("Should reject missing required slice parameters", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should reject missing required slice parameters",
+			ExpectedStatus:      422,
+			ExpectedBodyContain: "parameter 'ids' did not pass validation",
+			Path:                "/e2e/slice-params?tags=a&names=x&statuses=pending&keys=5d7f1a5e-4f3c-4a3f-9c59-2b0fbd4a6a1e",
+			Method:              "GET",
+			Headers:             map[string]string{"x-labels": "l1"},
+		})
+	})
+})
+
+var _ = Describe("E2E Query Object Routing Spec", func() {
+	It("Should populate a query object from its fields' query parameters", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should populate a query object from its fields' query parameters",
+			ExpectedStatus: 200,
+			ExpectedBody:   "{\"page\":2,\"pageSize\":10,\"tags\":[\"a\",\"b\"],\"status\":\"shipped\",\"other\":\"o\"}",
+			Path:           "/e2e/query-object?page=2&page_size=10&tag=a&tag=b&status=shipped&other=o",
+			Method:         "GET",
+		})
+	})
+
+	It("Should leave absent query object fields at their zero values", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should leave absent query object fields at their zero values",
+			ExpectedStatus: 200,
+			ExpectedBody:   "{\"page\":1,\"pageSize\":null,\"tags\":null,\"status\":\"\",\"other\":\"o\"}",
+			Path:           "/e2e/query-object?page=1&other=o",
+			Method:         "GET",
+		})
+	})
+
+	It("Should reject query object fields with invalid values", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should reject query object fields with invalid values",
+			ExpectedStatus:      422,
+			ExpectedBodyContain: "'lost' is not a valid OrderStatus value",
+			Path:                "/e2e/query-object?page=1&status=lost&other=o",
+			Method:              "GET",
+		})
+	})
+
+	It("Should run the struct validations of query objects", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should run the struct validations of query objects",
+			ExpectedStatus:      422,
+			ExpectedBodyContain: "Field 'PageSize' failed validation with tag 'max'",
+			Path:                "/e2e/query-object?page=1&page_size=500&other=o",
+			Method:              "GET",
+		})
+	})
+
+	It("Should ignore query parameters of excluded query object fields", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should ignore query parameters of excluded query object fields",
+			ExpectedStatus:      422,
+			ExpectedBodyContain: "Field 'Page' failed validation with tag 'min'",
+			Path:                "/e2e/query-object?Internal=x&other=o",
+			Method:              "GET",
+		})
+	})
+})
+
+var _ = Describe("E2E Polymorphic Body Routing Spec", func() {
+	It("Should unmarshal a polymorphic body into the struct identified by its discriminator", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should unmarshal a polymorphic body into the struct identified by its discriminator",
+			ExpectedStatus: 200,
+			ExpectedBody:   "{\"type\":\"assets.CatInfo\",\"pet\":{\"kind\":\"cat\",\"name\":\"Tom\",\"lives\":9}}",
+			Path:           "/e2e/polymorphic-body",
+			Method:         "POST",
+			Body:           map[string]any{"kind": "cat", "name": "Tom", "lives": 9},
+		})
+	})
+
+	It("Should unmarshal members implementing the interface via a pointer by address", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should unmarshal members implementing the interface via a pointer by address",
+			ExpectedStatus: 200,
+			ExpectedBody:   "{\"type\":\"*assets.DogInfo\",\"pet\":{\"kind\":\"dog\",\"name\":\"Rex\",\"goodBoy\":true}}",
+			Path:           "/e2e/polymorphic-body",
+			Method:         "POST",
+			Body:           map[string]any{"kind": "dog", "name": "Rex", "goodBoy": true},
+		})
+	})
+
+	It("Should validate the concrete struct of a polymorphic body", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should validate the concrete struct of a polymorphic body",
+			ExpectedStatus:      422,
+			ExpectedBodyContain: "Field 'Lives' failed validation with tag 'max'",
+			Path:                "/e2e/polymorphic-body",
+			Method:              "POST",
+			Body:                map[string]any{"kind": "cat", "name": "Tom", "lives": 10},
+		})
+	})
+
+	It("Should reject polymorphic bodies with an unknown discriminator value", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should reject polymorphic bodies with an unknown discriminator value",
+			ExpectedStatus:      422,
+			ExpectedBodyContain: "'bird' is not a valid value for discriminator property 'kind'",
+			Path:                "/e2e/polymorphic-body",
+			Method:              "POST",
+			Body:                map[string]any{"kind": "bird", "name": "Tweety"},
+		})
+	})
+
+	It("Should reject polymorphic bodies without a discriminator", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should reject polymorphic bodies without a discriminator",
+			ExpectedStatus:      422,
+			ExpectedBodyContain: "body is missing string discriminator property 'kind'",
+			Path:                "/e2e/polymorphic-body",
+			Method:              "POST",
+			Body:                map[string]any{"name": "Tom"},
+		})
+	})
+})
+
+var _ = Describe("E2E Map Response Routing Spec", func() {
+	It("Should reply with map responses as JSON objects", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should reply with map responses as JSON objects",
+			ExpectedStatus: 200,
+			ExpectedBody: "{\"a\":{\"page\":0,\"pageSize\":null,\"tags\":null,\"status\":\"\",\"other\":\"a\"}," +
+				"\"b\":{\"page\":1,\"pageSize\":null,\"tags\":null,\"status\":\"\",\"other\":\"b\"}}",
+			Path:   "/e2e/map-response?names=a&names=b",
+			Method: "GET",
+		})
+	})
+})
+
+var _ = Describe("E2E Named Primitive Params Routing Spec", func() {
+	It("Should convert named primitive params to their declared types", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should convert named primitive params to their declared types",
+			ExpectedStatus: 200,
+			ExpectedBody:   "{\"customerId\":\"cus_1\",\"amount\":1250,\"currency\":\"usd\",\"splits\":[1000,250]}",
+			Path:           "/e2e/named-primitive-params/cus_1?amount=1250&splits=1000&splits=250",
+			Method:         "GET",
+			Headers:        map[string]string{"x-currency": "usd"},
+		})
+	})
+
+	It("Should reject named primitive params that cannot be converted", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should reject named primitive params that cannot be converted",
+			ExpectedStatus: 422,
+			Path:           "/e2e/named-primitive-params/cus_1?amount=ten",
+			Method:         "GET",
+			Headers:        map[string]string{"x-currency": "usd"},
+		})
+	})
+})
+
+var _ = Describe("E2E Body Defaults Routing Spec", func() {
+	It("Should apply default values to fields absent from the body", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should apply default values to fields absent from the body",
+			ExpectedStatus: 200,
+			ExpectedBody:   "{\"name\":\"alice\",\"limit\":20,\"notify\":true,\"status\":\"shipped\",\"paging\":{\"page\":1,\"size\":50}}",
+			Path:           "/e2e/body-defaults",
+			Method:         "POST",
+			Body:           map[string]any{"name": "alice"},
+		})
+	})
+
+	It("Should retain values present in the body", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should retain values present in the body",
+			ExpectedStatus: 200,
+			ExpectedBody:   "{\"name\":\"guest\",\"limit\":0,\"notify\":false,\"status\":\"pending\",\"paging\":{\"page\":1,\"size\":50}}",
+			Path:           "/e2e/body-defaults",
+			Method:         "POST",
+			Body:           map[string]any{"limit": 0, "notify": false, "status": "pending"},
+		})
+	})
+
+	It("Should apply default values to fields absent from nested objects", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should apply default values to fields absent from nested objects",
+			ExpectedStatus: 200,
+			ExpectedBody:   "{\"name\":\"guest\",\"limit\":20,\"notify\":true,\"status\":\"shipped\",\"paging\":{\"page\":3,\"size\":50}}",
+			Path:           "/e2e/body-defaults",
+			Method:         "POST",
+			Body:           map[string]any{"paging": map[string]any{"page": 3}},
+		})
+	})
+})
+
+var _ = Describe("E2E Param Defaults Routing Spec", func() {
+	It("Should apply default values to params absent from the request", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should apply default values to params absent from the request",
+			ExpectedStatus: 200,
+			ExpectedBody:   "{\"limit\":20,\"notify\":true,\"status\":\"shipped\",\"region\":\"eu\",\"note\":\"none\"}",
+			Path:           "/e2e/param-defaults",
+			Method:         "POST",
+			Form:           map[string]string{},
+		})
+	})
+
+	It("Should retain values present in the request", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should retain values present in the request",
+			ExpectedStatus: 200,
+			ExpectedBody:   "{\"limit\":5,\"notify\":false,\"status\":\"pending\",\"region\":\"us\",\"note\":\"fragile\"}",
+			Path:           "/e2e/param-defaults?limit=5&notify=false&status=pending",
+			Method:         "POST",
+			Headers:        map[string]string{"x-region": "us"},
+			Form:           map[string]string{"note": "fragile"},
+		})
+	})
+})
+
+var _ = Describe("E2E Cookie Params Routing Spec", func() {
+	It("Should parse cookie params", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should parse cookie params",
+			ExpectedStatus: 200,
+			ExpectedBody:   "{\"session\":\"abc123\",\"theme\":\"dark\",\"visits\":3}",
+			Path:           "/e2e/cookie-params",
+			Method:         "GET",
+			Headers:        map[string]string{"Cookie": "session_id=abc123; theme=dark; visits=3"},
+		})
+	})
+
+	It("Should leave optional cookie params absent from the request unset", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should leave optional cookie params absent from the request unset",
+			ExpectedStatus: 200,
+			ExpectedBody:   "{\"session\":\"abc123\",\"theme\":null,\"visits\":0}",
+			Path:           "/e2e/cookie-params",
+			Method:         "GET",
+			Headers:        map[string]string{"Cookie": "session_id=abc123; visits=0"},
+		})
+	})
+
+	It("Should reject requests missing required cookie params", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should reject requests missing required cookie params",
+			ExpectedStatus: 422,
+			Path:           "/e2e/cookie-params",
+			Method:         "GET",
+			Headers:        map[string]string{"Cookie": "visits=1"},
+		})
+	})
+
+	It("Should reject cookie params that cannot be converted", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should reject cookie params that cannot be converted",
+			ExpectedStatus: 422,
+			Path:           "/e2e/cookie-params",
+			Method:         "GET",
+			Headers:        map[string]string{"Cookie": "session_id=abc123; visits=many"},
+		})
+	})
+})
+
+var _ = Describe("E2E Content Negotiation Routing Spec", func() {
+	It("Should respond in a produced media type accepted by the client", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should respond in a produced media type accepted by the client",
+			ExpectedStatus: 200,
+			ExpectedBody:   "{\"name\":\"alice\",\"limit\":20,\"notify\":true,\"status\":\"shipped\",\"paging\":{\"page\":1,\"size\":50}}",
+			Path:           "/e2e/content-negotiation",
+			Method:         "POST",
+			Body:           map[string]any{"name": "alice"},
+			Headers:        map[string]string{"Accept": "text/html;q=0.9, application/*;q=0.5"},
+		})
+	})
+
+	It("Should respond with 406 when the client accepts none of the produced media types", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should respond with 406 when the client accepts none of the produced media types",
+			ExpectedStatus:      406,
+			ExpectedBodyContain: "cannot produce a response in any of the accepted media types",
+			Path:                "/e2e/content-negotiation",
+			Method:              "POST",
+			Body:                map[string]any{"name": "alice"},
+			Headers:             map[string]string{"Accept": "text/html, application/json;q=0"},
+		})
+	})
+
+	It("Should respond with 415 when the request body is of a media type that is not consumed", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should respond with 415 when the request body is of a media type that is not consumed",
+			ExpectedStatus:      415,
+			ExpectedBodyContain: "does not accept request bodies of type 'text/plain'",
+			Path:                "/e2e/content-negotiation",
+			Method:              "POST",
+			Headers:             map[string]string{"Content-Type": "text/plain"},
+		})
+	})
+})
+
+var _ = Describe("E2E XML Serialization Routing Spec", func() {
+	It("Should deserialize XML request bodies and serialize XML responses", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should deserialize XML request bodies and serialize XML responses",
+			ExpectedStatus:      200,
+			ExpectedBodyContain: "<book id=\"1\"><title>Go</title></book>",
+			Path:                "/e2e/xml-serialization",
+			Method:              "POST",
+			RawBody:             "<book id=\"1\"><title>Go</title></book>",
+			Headers:             map[string]string{"Content-Type": "application/xml", "Accept": "application/xml"},
+		})
+	})
+
+	It("Should respond in JSON to XML request bodies when JSON is accepted", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should respond in JSON to XML request bodies when JSON is accepted",
+			ExpectedStatus: 200,
+			ExpectedBody:   "{\"id\":\"1\",\"title\":\"Go\"}",
+			Path:           "/e2e/xml-serialization",
+			Method:         "POST",
+			RawBody:        "<book id=\"1\"><title>Go</title></book>",
+			Headers:        map[string]string{"Content-Type": "application/xml", "Accept": "application/json"},
+		})
+	})
+
+	It("Should validate XML request bodies", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should validate XML request bodies",
+			ExpectedStatus:      422,
+			ExpectedBodyContain: "Title",
+			Path:                "/e2e/xml-serialization",
+			Method:              "POST",
+			RawBody:             "<book id=\"1\"></book>",
+			Headers:             map[string]string{"Content-Type": "application/xml", "Accept": "application/xml"},
+		})
+	})
+})
+
+var _ = Describe("E2E Plain Text Routing Spec", func() {
+	It("Should respond in plain text when plain text is accepted", func() {
+		RunRouterTest(common.RouterTest{
+			Name:            "Should respond in plain text when plain text is accepted",
+			ExpectedStatus:  200,
+			ExpectedBody:    "hello gopher",
+			Path:            "/e2e/plain-text",
+			Method:          "GET",
+			Query:           map[string]string{"name": "gopher"},
+			Headers:         map[string]string{"Accept": "text/plain"},
+			ExpendedHeaders: map[string]string{"Content-Type": "text/plain; charset=utf-8"},
+		})
+	})
+
+	It("Should respond in JSON when JSON is accepted", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should respond in JSON when JSON is accepted",
+			ExpectedStatus: 200,
+			ExpectedBody:   "\"hello gopher\"",
+			Path:           "/e2e/plain-text",
+			Method:         "GET",
+			Query:          map[string]string{"name": "gopher"},
+			Headers:        map[string]string{"Accept": "application/json"},
+		})
+	})
+})
+
+var _ = Describe("E2E Form Files Routing Spec", func() {
+	It("Should bind uploaded files and form fields of multipart forms", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should bind uploaded files and form fields of multipart forms",
+			ExpectedStatus: 200,
+			ExpectedBody:   "{\"title\":\"profile\",\"avatar\":\"png-bytes\",\"attachments\":[\"cv.pdf\",\"notes.txt\"]}",
+			Path:           "/e2e/form-files",
+			Method:         "POST",
+			Form:           map[string]string{"title": "profile"},
+			Files: []common.FormFile{
+				{Field: "avatar", FileName: "avatar.png", ContentType: "image/png", Content: "png-bytes"},
+				{Field: "attachment", FileName: "cv.pdf", ContentType: "application/pdf", Content: "pdf-bytes"},
+				{Field: "attachment", FileName: "notes.txt", ContentType: "text/plain; charset=utf-8", Content: "notes"},
+			},
+		})
+	})
+
+	It("Should reject requests missing a required file", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should reject requests missing a required file",
+			ExpectedStatus:      422,
+			ExpectedBodyContain: "parameter 'avatar' did not pass validation",
+			Path:                "/e2e/form-files",
+			Method:              "POST",
+			Form:                map[string]string{"title": "profile"},
+			Files: []common.FormFile{
+				{Field: "attachment", FileName: "cv.pdf", ContentType: "application/pdf", Content: "pdf-bytes"},
+			},
+		})
+	})
+
+	It("Should reject files exceeding the maximum size", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should reject files exceeding the maximum size",
+			ExpectedStatus:      422,
+			ExpectedBodyContain: "file 'avatar.png' is 17 bytes long which exceeds the maximum size of 16 bytes",
+			Path:                "/e2e/form-files",
+			Method:              "POST",
+			Form:                map[string]string{"title": "profile"},
+			Files: []common.FormFile{
+				{Field: "avatar", FileName: "avatar.png", ContentType: "image/png", Content: "png-bytes-too-big"},
+				{Field: "attachment", FileName: "cv.pdf", ContentType: "application/pdf", Content: "pdf-bytes"},
+			},
+		})
+	})
+
+	It("Should reject files of types that are not allowed", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should reject files of types that are not allowed",
+			ExpectedStatus:      422,
+			ExpectedBodyContain: "file 'run.sh' is of type 'application/x-sh' which is not one of the allowed types",
+			Path:                "/e2e/form-files",
+			Method:              "POST",
+			Form:                map[string]string{"title": "profile"},
+			Files: []common.FormFile{
+				{Field: "avatar", FileName: "avatar.png", ContentType: "image/png", Content: "png-bytes"},
+				{Field: "attachment", FileName: "run.sh", ContentType: "application/x-sh", Content: "echo"},
+			},
+		})
+	})
+})
+
+var _ = Describe("E2E Streams Routing Spec", func() {
+	It("Should stream responses of the produced media type", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should stream responses of the produced media type",
+			ExpectedStatus: 200,
+			ExpectedBody:   "id,title\n1,Go",
+			Path:           "/e2e/stream-report",
+			Method:         "GET",
+			Query:          map[string]string{"name": "books"},
+			ExpendedHeaders: map[string]string{
+				"Content-Type":        "text/csv",
+				"Content-Disposition": "attachment; filename=books.csv",
+			},
+		})
+	})
+
+	It("Should send file responses as their own media type", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should send file responses as their own media type",
+			ExpectedStatus: 200,
+			ExpectedBody:   "some notes",
+			Path:           "/e2e/download-notes",
+			Method:         "GET",
+			ExpendedHeaders: map[string]string{
+				"Content-Type":        "text/plain; charset=utf-8",
+				"Content-Disposition": "attachment; filename=\"my notes.txt\"",
+			},
+		})
+	})
+
+	It("Should stream responses as application/octet-stream by default", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should stream responses as application/octet-stream by default",
+			ExpectedStatus: 200,
+			ExpectedBody:   "raw-bytes",
+			Path:           "/e2e/stream-bytes",
+			Method:         "GET",
+			ExpendedHeaders: map[string]string{
+				"Content-Type": "application/octet-stream",
+			},
+		})
+	})
+
+	It("Should respond with 406 when the streamed media type is not accepted", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should respond with 406 when the streamed media type is not accepted",
+			ExpectedStatus:      406,
+			ExpectedBodyContain: "cannot produce a response in any of the accepted media types",
+			Path:                "/e2e/stream-report",
+			Method:              "GET",
+			Query:               map[string]string{"name": "books"},
+			Headers:             map[string]string{"Accept": "application/json"},
+		})
+	})
+})
+
+var _ = Describe("E2E Server-Sent Events Routing Spec", func() {
+	It("Should send each value of the returned channel as an event", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should send each value of the returned channel as an event",
+			ExpectedStatus:      200,
+			ExpectedBodyContain: "data: {\"percent\":50}\n\ndata: {\"percent\":100}",
+			Path:                "/e2e/event-stream",
+			Method:              "GET",
+			Query:               map[string]string{"steps": "2"},
+			Headers:             map[string]string{"Accept": "text/event-stream"},
+			ExpendedHeaders: map[string]string{
+				"Content-Type":  "text/event-stream",
+				"Cache-Control": "no-cache",
+			},
+		})
+	})
+
+	It("Should end the stream with an error event when a value cannot be serialized", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should end the stream with an error event when a value cannot be serialized",
+			ExpectedStatus:      200,
+			ExpectedBodyContain: "data: 1.5\n\nevent: error\ndata: {\"error\":\"json: unsupported value: +Inf\"}",
+			Path:                "/e2e/event-stream-error",
+			Method:              "GET",
+			Headers:             map[string]string{"Accept": "text/event-stream"},
+		})
+	})
+
+	It("Should respond with 406 when event streams are not accepted", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should respond with 406 when event streams are not accepted",
+			ExpectedStatus:      406,
+			ExpectedBodyContain: "cannot produce a response in any of the accepted media types",
+			Path:                "/e2e/event-stream",
+			Method:              "GET",
+			Query:               map[string]string{"steps": "2"},
+			Headers:             map[string]string{"Accept": "application/json"},
+		})
+	})
+})
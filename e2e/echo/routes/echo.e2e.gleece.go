@@ -5,7 +5,7 @@ It includes routes and handlers by the Gleece API Routes Generator.
 --
 Authors: Haim Kastner & Yuval Pomerchik
 Generated by: Gleece Routes Generator
-Generated Date: 2025-02-25
+Generated Date: 2026-10-18
 Target Engine: Echo v4 (https://github.com/labstack/echo)
 --
 Usage:
@@ -16,11 +16,19 @@ Repository: https://github.com/gopher-fleece/gleece
 */
 package routes
 import (
+	"context"
+	"encoding/base64"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 	"io"
+	"mime"
+	"mime/multipart"
 	"net/http"
+	"reflect"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 	"github.com/go-playground/validator/v10"
 	E2EControllerImport "github.com/gopher-fleece/gleece/e2e/assets"
@@ -32,6 +40,39 @@ import (
 	Param41theBody "github.com/gopher-fleece/gleece/e2e/assets"
 	Response62CustomError "github.com/gopher-fleece/gleece/e2e/assets"
 	Response65CustomError "github.com/gopher-fleece/gleece/e2e/assets"
+	Param80status "github.com/gopher-fleece/gleece/e2e/assets"
+	Param81priority "github.com/gopher-fleece/gleece/e2e/assets"
+	Param82headerStatus "github.com/gopher-fleece/gleece/e2e/assets"
+	Param85id "github.com/google/uuid"
+	Param86at "time"
+	Param87timeout "time"
+	Param89raw "encoding/json"
+	Param90address "net"
+	Param91link "net/url"
+	Param94amount "github.com/gopher-fleece/gleece/e2e/assets/domain"
+	Param95tip "github.com/gopher-fleece/gleece/e2e/assets/domain"
+	ParamParser94amount "github.com/gopher-fleece/gleece/e2e/assets/domain"
+	ParamParser95tip "github.com/gopher-fleece/gleece/e2e/assets/domain"
+	Param98envelopeArg0 "github.com/gopher-fleece/gleece/e2e/assets"
+	Param98envelope "github.com/gopher-fleece/gleece/e2e/assets/domain"
+	Param101envelopeArg0Value "github.com/gopher-fleece/gleece/e2e/assets"
+	Param101envelope "github.com/gopher-fleece/gleece/e2e/assets/domain"
+	Param101envelopeArg0Key "github.com/gopher-fleece/gleece/e2e/assets/domain"
+	Param107statusesItem "github.com/gopher-fleece/gleece/e2e/assets"
+	Param108keysItem "github.com/google/uuid"
+	Param112filter "github.com/gopher-fleece/gleece/e2e/assets"
+	Param116filterStatus "github.com/gopher-fleece/gleece/e2e/assets"
+	Param120pet "github.com/gopher-fleece/gleece/e2e/assets"
+	Param126customerId "github.com/gopher-fleece/gleece/e2e/assets"
+	Param127amount "github.com/gopher-fleece/gleece/e2e/assets"
+	Param128splitsItem "github.com/gopher-fleece/gleece/e2e/assets"
+	Param129currency "github.com/gopher-fleece/gleece/e2e/assets"
+	Param132info "github.com/gopher-fleece/gleece/e2e/assets"
+	Param137status "github.com/gopher-fleece/gleece/e2e/assets"
+	Param147info "github.com/gopher-fleece/gleece/e2e/assets"
+	Param150book "github.com/gopher-fleece/gleece/e2e/assets"
+	Param157avatar "mime/multipart"
+	Param158attachmentsItem "mime/multipart"
 	E2EClassSecControllerImport "github.com/gopher-fleece/gleece/e2e/assets"
 	// import extension placeholder
 )
@@ -41,6 +82,8 @@ type SecurityListRelation string
 const (
 	SecurityListRelationAnd SecurityListRelation = "AND"
 )
+// The amount of memory multipart forms are parsed into, matching net/http's default. Larger files are stored on disk
+const multipartFormMaxMemory = 32 << 20
 type SecurityCheckList struct {
 	Checks   []runtime.SecurityCheck
 	Relation SecurityListRelation
@@ -76,7 +119,7 @@ func getStatusCode(controller runtime.Controller, hasReturnValue bool, err error
 	}
 	return http.StatusNoContent
 }
-func bindAndValidateBody[TOutput any](ctx echo.Context, contentType string, validation string, output **TOutput) error {
+func bindAndValidateBody[TOutput any](ctx echo.Context, contentType string, validation string, defaults string, output **TOutput) error {
 	var err error
 	bodyBytes, err := io.ReadAll(ctx.Request().Body)
 	if err != nil || len(bodyBytes) == 0 {
@@ -86,9 +129,17 @@ func bindAndValidateBody[TOutput any](ctx echo.Context, contentType string, vali
 		return nil
 	}
 	var deserializedOutput TOutput
+	// Fields absent from the body retain their default values
+	if defaults != "" {
+		if err = json.Unmarshal([]byte(defaults), &deserializedOutput); err != nil {
+			return err
+		}
+	}
 	switch contentType {
 	case "application/json":
 		err = json.Unmarshal(bodyBytes, &deserializedOutput)
+	case "application/xml":
+		err = xml.Unmarshal(bodyBytes, &deserializedOutput)
 	default:
 		return fmt.Errorf("content-type %s is not currently supported by the validation subsystem", contentType)
 	}
@@ -154,6 +205,78 @@ func handleAuthorizationError(ctx echo.Context, authErr *runtime.SecurityError,
 	}
 	return ctx.JSON(statusCode, stdError)
 }
+func handleContentNegotiationError(ctx echo.Context, statusCode int, detail string, operationId string) error {
+	stdError := runtime.Rfc7807Error{
+		Type:     http.StatusText(statusCode),
+		Detail:   detail,
+		Status:   statusCode,
+		Instance: "/gleece/negotiation/error/" + operationId,
+	}
+	return ctx.JSON(statusCode, stdError)
+}
+// negotiateContentType returns the media type a response should be sent in, per the request's 'Accept' header.
+// The media type the client prefers is chosen among those the operation produces, with ties broken by the operation's order.
+// Returns an empty string if the client accepts none of them
+func negotiateContentType(acceptHeader string, produces []string) string {
+	if strings.TrimSpace(acceptHeader) == "" {
+		return produces[0]
+	}
+	bestContentType := ""
+	bestQuality := 0.0
+	for _, contentType := range produces {
+		if quality := getAcceptedQuality(acceptHeader, contentType); quality > bestQuality {
+			bestContentType = contentType
+			bestQuality = quality
+		}
+	}
+	return bestContentType
+}
+// getAcceptedQuality returns the quality an 'Accept' header assigns to the given media type,
+// per the most specific of the header's media ranges matching it. Returns 0 if no media range matches
+func getAcceptedQuality(acceptHeader string, contentType string) float64 {
+	mainType, _, _ := strings.Cut(contentType, "/")
+	quality := 0.0
+	bestSpecificity := -1
+	for _, mediaRange := range strings.Split(acceptHeader, ",") {
+		rangeType, params, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+		if err != nil {
+			continue
+		}
+		specificity := -1
+		switch rangeType {
+		case contentType:
+			specificity = 2
+		case mainType + "/*":
+			specificity = 1
+		case "*/*":
+			specificity = 0
+		}
+		if specificity <= bestSpecificity {
+			continue
+		}
+		bestSpecificity = specificity
+		quality = 1.0
+		if rawQuality, hasQuality := params["q"]; hasQuality {
+			if parsedQuality, err := strconv.ParseFloat(rawQuality, 64); err == nil {
+				quality = parsedQuality
+			}
+		}
+	}
+	return quality
+}
+// getRequestContentType returns the media type of a request's body, per its 'Content-Type' header.
+// Requests without a 'Content-Type' header are assumed to be sent in the first media type the operation consumes.
+// Returns false if the body's media type is not one the operation consumes
+func getRequestContentType(contentTypeHeader string, consumes []string) (string, bool) {
+	if strings.TrimSpace(contentTypeHeader) == "" {
+		return consumes[0], true
+	}
+	contentType, _, err := mime.ParseMediaType(contentTypeHeader)
+	if err != nil || !slices.Contains(consumes, contentType) {
+		return "", false
+	}
+	return contentType, true
+}
 func wrapValidatorError(validatorErr error, operationId string, fieldName string) runtime.Rfc7807Error {
 	return runtime.Rfc7807Error{
 		Type: http.StatusText(http.StatusUnprocessableEntity),
@@ -167,6 +290,208 @@ func wrapValidatorError(validatorErr error, operationId string, fieldName string
 		Instance: fmt.Sprintf("/gleece/validation/error/%s", operationId),
 	}
 }
+// wrapStructValidatorError wraps the validation errors of a struct-typed parameter, naming each of the struct's failed fields
+func wrapStructValidatorError(validatorErr error, operationId string, paramName string) runtime.Rfc7807Error {
+	return runtime.Rfc7807Error{
+		Type: http.StatusText(http.StatusUnprocessableEntity),
+		Detail: fmt.Sprintf(
+			"A request was made to operation '%s' but parameter '%s' did not pass validation - %s",
+			operationId,
+			paramName,
+			extractValidationErrorMessage(validatorErr, nil),
+		),
+		Status:   http.StatusUnprocessableEntity,
+		Instance: fmt.Sprintf("/gleece/validation/error/%s", operationId),
+	}
+}
+// bindAndValidatePolymorphicBody binds a body to a polymorphic interface.
+// The body is unmarshalled into the struct identified by the value of its discriminator property, which is then validated
+func bindAndValidatePolymorphicBody[TOutput any](
+	ctx echo.Context,
+	contentType string,
+	validation string,
+	discriminator string,
+	unmarshallers map[string]func([]byte) (TOutput, error),
+	output **TOutput,
+) error {
+	bodyBytes, err := io.ReadAll(ctx.Request().Body)
+	if err != nil || len(bodyBytes) == 0 {
+		if strings.Contains(validation, "required") {
+			return fmt.Errorf("body is required but was not provided")
+		}
+		return nil
+	}
+	if contentType != "application/json" {
+		return fmt.Errorf("content-type %s is not currently supported by the validation subsystem", contentType)
+	}
+	var properties map[string]json.RawMessage
+	if err := json.Unmarshal(bodyBytes, &properties); err != nil {
+		return err
+	}
+	var discriminatorValue string
+	if err := json.Unmarshal(properties[discriminator], &discriminatorValue); err != nil {
+		return fmt.Errorf("body is missing string discriminator property '%s'", discriminator)
+	}
+	unmarshal, isKnown := unmarshallers[discriminatorValue]
+	if !isKnown {
+		return fmt.Errorf("'%s' is not a valid value for discriminator property '%s'", discriminatorValue, discriminator)
+	}
+	deserializedOutput, err := unmarshal(bodyBytes)
+	if err != nil {
+		return err
+	}
+	if err = validatorInstance.Struct(deserializedOutput); err != nil {
+		return err
+	}
+	*output = &deserializedOutput
+	return nil
+}
+// unmarshalOneOfMember returns a function unmarshalling a body into the polymorphic interface member TMember.
+// Members implementing the interface via a pointer are returned by address
+func unmarshalOneOfMember[TMember any, TOutput any](isByAddress bool) func([]byte) (TOutput, error) {
+	return func(bodyBytes []byte) (TOutput, error) {
+		var member TMember
+		var output TOutput
+		if err := json.Unmarshal(bodyBytes, &member); err != nil {
+			return output, err
+		}
+		if isByAddress {
+			return any(&member).(TOutput), nil
+		}
+		return any(member).(TOutput), nil
+	}
+}
+// splitParamValues returns the individual values of an array parameter.
+// An empty separator indicates each value was sent separately (i.e., 'ids=1&ids=2').
+// Otherwise, values are delimited by the separator (i.e., 'ids=1,2') and empty raw values yield no items
+func splitParamValues(rawValues []string, separator string) []string {
+	if separator == "" {
+		return rawValues
+	}
+	values := []string{}
+	for _, rawValue := range rawValues {
+		if rawValue == "" {
+			continue
+		}
+		for _, value := range strings.Split(rawValue, separator) {
+			values = append(values, strings.TrimSpace(value))
+		}
+	}
+	return values
+}
+// getFormFiles returns the files uploaded under the given name in a multipart form, if any
+func getFormFiles(ctx echo.Context, name string) []*multipart.FileHeader {
+	form, err := ctx.MultipartForm()
+	if err != nil {
+		return nil
+	}
+	return form.File[name]
+}
+// validateFormFiles verifies the files uploaded for a form file parameter.
+// Parameters bound to a single file may not receive several, and each file must not exceed the parameter's maximum size
+// (if non-zero) and must be of one of its allowed MIME types (if any)
+func validateFormFiles(files []*multipart.FileHeader, allowsMultiple bool, maxSize int64, allowedMimeTypes []string) error {
+	if !allowsMultiple && len(files) > 1 {
+		return fmt.Errorf("expected a single file but got %d", len(files))
+	}
+	for _, file := range files {
+		if maxSize > 0 && file.Size > maxSize {
+			return fmt.Errorf("file '%s' is %d bytes long which exceeds the maximum size of %d bytes", file.Filename, file.Size, maxSize)
+		}
+		fileMimeType := file.Header.Get("Content-Type")
+		if len(allowedMimeTypes) > 0 && !isMimeTypeAllowed(fileMimeType, allowedMimeTypes) {
+			return fmt.Errorf("file '%s' is of type '%s' which is not one of the allowed types %v", file.Filename, fileMimeType, allowedMimeTypes)
+		}
+	}
+	return nil
+}
+// isMimeTypeAllowed returns whether a MIME type matches any of the allowed ones, which may use a wildcard subtype, e.g. 'image/*'
+func isMimeTypeAllowed(mimeType string, allowedMimeTypes []string) bool {
+	mediaType, _, err := mime.ParseMediaType(mimeType)
+	if err != nil {
+		return false
+	}
+	for _, allowed := range allowedMimeTypes {
+		if allowed == mediaType {
+			return true
+		}
+		if mainType, isWildcard := strings.CutSuffix(allowed, "/*"); isWildcard && strings.HasPrefix(mediaType, mainType+"/") {
+			return true
+		}
+	}
+	return false
+}
+// writeStream writes a streamed response body of the given media type, closing the stream afterwards if it's an io.Closer.
+// Files are offered for download under the given file name, if any. A nil stream results in an empty body.
+// Returns the error that interrupted the stream, if any. The status code has been sent by then
+func writeStream(w http.ResponseWriter, statusCode int, contentType string, fileName string, stream io.Reader) error {
+	if closer, isCloser := stream.(io.Closer); isCloser {
+		defer closer.Close()
+	}
+	w.Header().Set("Content-Type", contentType)
+	if fileName != "" {
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileName}))
+	}
+	w.WriteHeader(statusCode)
+	if stream == nil {
+		return nil
+	}
+	_, err := io.Copy(w, stream)
+	return err
+}
+// writeEventStream writes each value received from a channel as a server-sent event carrying its JSON representation,
+// flushing it to the client right away. Writing stops once the channel is closed, a value cannot be serialized
+// or the client disconnects, i.e., the request's context is done. A value that cannot be serialized is reported as an 'error' event.
+//
+// Values sent after writing stops early are discarded so their sender is not blocked forever.
+// Senders should nonetheless stop sending, and close the channel, once the request's context is done
+func writeEventStream[TValue any](w http.ResponseWriter, requestCtx context.Context, statusCode int, events <-chan TValue) {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(statusCode)
+	flusher, canFlush := w.(http.Flusher)
+	if canFlush {
+		flusher.Flush()
+	}
+	if events == nil {
+		return
+	}
+	for {
+		select {
+		case <-requestCtx.Done():
+			go discardEvents(events)
+			return
+		case event, isOpen := <-events:
+			if !isOpen {
+				return
+			}
+			data, err := json.Marshal(event)
+			if err != nil {
+				writeEventStreamError(w, err)
+				if canFlush {
+					flusher.Flush()
+				}
+				go discardEvents(events)
+				return
+			}
+			fmt.Fprintf(w, "data: %s\n\n", data)
+			if canFlush {
+				flusher.Flush()
+			}
+		}
+	}
+}
+// writeEventStreamError writes a server-sent 'error' event describing why an event stream has ended early
+func writeEventStreamError(w io.Writer, err error) {
+	data, _ := json.Marshal(map[string]string{"error": err.Error()})
+	fmt.Fprintf(w, "event: error\ndata: %s\n\n", data)
+}
+// discardEvents receives and discards the values of an event stream that's no longer written, until the channel is closed
+func discardEvents[TValue any](events <-chan TValue) {
+	for range events {
+	}
+}
 // function declarations extension placeholder
 type MiddlewareFunc func(ctx echo.Context) bool
 type ErrorMiddlewareFunc func(ctx echo.Context, err error) bool
@@ -215,6 +540,14 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "SimpleGet")
 		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'SimpleGet' cannot produce a response in any of the accepted media types",
+				"SimpleGet",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -260,8 +593,12 @@ func RegisterRoutes(engine *echo.Echo) {
 			// json error response extension placeholder
 			return ctx.JSON(statusCode, stdError)
 		}
-		// json response extension placeholder
-		return ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.GET(toEchoUrl("/e2e/simple-get-empty-string"), func(ctx echo.Context) error {
 		// route start routes extension placeholder
@@ -284,6 +621,14 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "SimpleGetEmptyString")
 		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'SimpleGetEmptyString' cannot produce a response in any of the accepted media types",
+				"SimpleGetEmptyString",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -329,8 +674,12 @@ func RegisterRoutes(engine *echo.Echo) {
 			// json error response extension placeholder
 			return ctx.JSON(statusCode, stdError)
 		}
-		// json response extension placeholder
-		return ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.GET(toEchoUrl("/e2e/simple-get-ptr-string"), func(ctx echo.Context) error {
 		// route start routes extension placeholder
@@ -353,6 +702,14 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "SimpleGetPtrString")
 		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'SimpleGetPtrString' cannot produce a response in any of the accepted media types",
+				"SimpleGetPtrString",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -398,8 +755,12 @@ func RegisterRoutes(engine *echo.Echo) {
 			// json error response extension placeholder
 			return ctx.JSON(statusCode, stdError)
 		}
-		// json response extension placeholder
-		return ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.GET(toEchoUrl("/e2e/simple-get-null-string"), func(ctx echo.Context) error {
 		// route start routes extension placeholder
@@ -422,6 +783,14 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "SimpleGetNullString")
 		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'SimpleGetNullString' cannot produce a response in any of the accepted media types",
+				"SimpleGetNullString",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -467,8 +836,12 @@ func RegisterRoutes(engine *echo.Echo) {
 			// json error response extension placeholder
 			return ctx.JSON(statusCode, stdError)
 		}
-		// json response extension placeholder
-		return ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.GET(toEchoUrl("/e2e/simple-get-object"), func(ctx echo.Context) error {
 		// route start routes extension placeholder
@@ -491,6 +864,14 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "SimpleGetObject")
 		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'SimpleGetObject' cannot produce a response in any of the accepted media types",
+				"SimpleGetObject",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -536,8 +917,12 @@ func RegisterRoutes(engine *echo.Echo) {
 			// json error response extension placeholder
 			return ctx.JSON(statusCode, stdError)
 		}
-		// json response extension placeholder
-		return ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.GET(toEchoUrl("/e2e/simple-get-object-ptr"), func(ctx echo.Context) error {
 		// route start routes extension placeholder
@@ -560,6 +945,14 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "SimpleGetObjectPtr")
 		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'SimpleGetObjectPtr' cannot produce a response in any of the accepted media types",
+				"SimpleGetObjectPtr",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -605,8 +998,12 @@ func RegisterRoutes(engine *echo.Echo) {
 			// json error response extension placeholder
 			return ctx.JSON(statusCode, stdError)
 		}
-		// json response extension placeholder
-		return ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.GET(toEchoUrl("/e2e/simple-get-object-null"), func(ctx echo.Context) error {
 		// route start routes extension placeholder
@@ -629,6 +1026,14 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "SimpleGetObjectNull")
 		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'SimpleGetObjectNull' cannot produce a response in any of the accepted media types",
+				"SimpleGetObjectNull",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -674,8 +1079,12 @@ func RegisterRoutes(engine *echo.Echo) {
 			// json error response extension placeholder
 			return ctx.JSON(statusCode, stdError)
 		}
-		// json response extension placeholder
-		return ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.GET(toEchoUrl("/e2e/simple-get-empty"), func(ctx echo.Context) error {
 		// route start routes extension placeholder
@@ -698,6 +1107,14 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "SimpleGetEmpty")
 		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'SimpleGetEmpty' cannot produce a response in any of the accepted media types",
+				"SimpleGetEmpty",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var queryParamRawPtr *string = nil
@@ -755,9 +1172,13 @@ func RegisterRoutes(engine *echo.Echo) {
 			// json error response extension placeholder
 			return ctx.JSON(statusCode, stdError)
 		}
-		// json response extension placeholder
-		ctx.Response().Header().Set("Content-Type", "application/json")
-		ctx.Response().WriteHeader(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.Response().Header().Set("Content-Type", "application/json")
+			ctx.Response().WriteHeader(statusCode)
+			return nil
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
 		return nil
 	})
 	engine.GET(toEchoUrl("/e2e/get-with-all-params/{pathParam}"), func(ctx echo.Context) error {
@@ -781,6 +1202,14 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "GetWithAllParams")
 		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'GetWithAllParams' cannot produce a response in any of the accepted media types",
+				"GetWithAllParams",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var queryParamRawPtr *string = nil
@@ -867,8 +1296,12 @@ func RegisterRoutes(engine *echo.Echo) {
 			// json error response extension placeholder
 			return ctx.JSON(statusCode, stdError)
 		}
-		// json response extension placeholder
-		return ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.GET(toEchoUrl("/e2e/get-with-all-params-ptr/{pathParam}"), func(ctx echo.Context) error {
 		// route start routes extension placeholder
@@ -891,6 +1324,14 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "GetWithAllParamsPtr")
 		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'GetWithAllParamsPtr' cannot produce a response in any of the accepted media types",
+				"GetWithAllParamsPtr",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var queryParamRawPtr *string = nil
@@ -967,8 +1408,12 @@ func RegisterRoutes(engine *echo.Echo) {
 			// json error response extension placeholder
 			return ctx.JSON(statusCode, stdError)
 		}
-		// json response extension placeholder
-		return ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.GET(toEchoUrl("/e2e/get-with-all-params-required-ptr/{pathParam}"), func(ctx echo.Context) error {
 		// route start routes extension placeholder
@@ -991,6 +1436,14 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "GetWithAllParamsRequiredPtr")
 		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'GetWithAllParamsRequiredPtr' cannot produce a response in any of the accepted media types",
+				"GetWithAllParamsRequiredPtr",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var queryParamRawPtr *string = nil
@@ -1077,8 +1530,12 @@ func RegisterRoutes(engine *echo.Echo) {
 			// json error response extension placeholder
 			return ctx.JSON(statusCode, stdError)
 		}
-		// json response extension placeholder
-		return ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.POST(toEchoUrl("/e2e/post-with-all-params-body"), func(ctx echo.Context) error {
 		// route start routes extension placeholder
@@ -1101,6 +1558,14 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "PostWithAllParamsWithBody")
 		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'PostWithAllParamsWithBody' cannot produce a response in any of the accepted media types",
+				"PostWithAllParamsWithBody",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var conversionErr error
@@ -1134,7 +1599,15 @@ func RegisterRoutes(engine *echo.Echo) {
 			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
 		}
 		var theBodyRawPtr *Param33theBody.BodyInfo = nil
-		conversionErr = bindAndValidateBody(ctx, "application/json", "required", &theBodyRawPtr)
+		requestContentType, isRequestContentTypeSupported := getRequestContentType(ctx.Request().Header.Get("Content-Type"), []string{"application/json"})
+		if !isRequestContentTypeSupported {
+			return handleContentNegotiationError(ctx,
+				http.StatusUnsupportedMediaType,
+				fmt.Sprintf("Operation 'PostWithAllParamsWithBody' does not accept request bodies of type '%s'", ctx.Request().Header.Get("Content-Type")),
+				"PostWithAllParamsWithBody",
+			)
+		}
+		conversionErr = bindAndValidateBody(ctx, requestContentType, "required", "", &theBodyRawPtr)
 		if conversionErr != nil {
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
@@ -1193,8 +1666,12 @@ func RegisterRoutes(engine *echo.Echo) {
 			// json error response extension placeholder
 			return ctx.JSON(statusCode, stdError)
 		}
-		// json response extension placeholder
-		return ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.POST(toEchoUrl("/e2e/post-with-all-params-body-ptr"), func(ctx echo.Context) error {
 		// route start routes extension placeholder
@@ -1217,6 +1694,14 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "PostWithAllParamsWithBodyPtr")
 		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'PostWithAllParamsWithBodyPtr' cannot produce a response in any of the accepted media types",
+				"PostWithAllParamsWithBodyPtr",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var conversionErr error
@@ -1240,7 +1725,15 @@ func RegisterRoutes(engine *echo.Echo) {
 			headerParamRawPtr = &headerParam
 		}
 		var theBodyRawPtr *Param38theBody.BodyInfo = nil
-		conversionErr = bindAndValidateBody(ctx, "application/json", "", &theBodyRawPtr)
+		requestContentType, isRequestContentTypeSupported := getRequestContentType(ctx.Request().Header.Get("Content-Type"), []string{"application/json"})
+		if !isRequestContentTypeSupported {
+			return handleContentNegotiationError(ctx,
+				http.StatusUnsupportedMediaType,
+				fmt.Sprintf("Operation 'PostWithAllParamsWithBodyPtr' does not accept request bodies of type '%s'", ctx.Request().Header.Get("Content-Type")),
+				"PostWithAllParamsWithBodyPtr",
+			)
+		}
+		conversionErr = bindAndValidateBody(ctx, requestContentType, "", "", &theBodyRawPtr)
 		if conversionErr != nil {
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
@@ -1299,8 +1792,12 @@ func RegisterRoutes(engine *echo.Echo) {
 			// json error response extension placeholder
 			return ctx.JSON(statusCode, stdError)
 		}
-		// json response extension placeholder
-		return ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.POST(toEchoUrl("/e2e/post-with-all-params-body-required-ptr"), func(ctx echo.Context) error {
 		// route start routes extension placeholder
@@ -1323,11 +1820,27 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "PostWithAllParamsWithBodyRequiredPtr")
 		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'PostWithAllParamsWithBodyRequiredPtr' cannot produce a response in any of the accepted media types",
+				"PostWithAllParamsWithBodyRequiredPtr",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var conversionErr error
 		var theBodyRawPtr *Param41theBody.BodyInfo = nil
-		conversionErr = bindAndValidateBody(ctx, "application/json", "required", &theBodyRawPtr)
+		requestContentType, isRequestContentTypeSupported := getRequestContentType(ctx.Request().Header.Get("Content-Type"), []string{"application/json"})
+		if !isRequestContentTypeSupported {
+			return handleContentNegotiationError(ctx,
+				http.StatusUnsupportedMediaType,
+				fmt.Sprintf("Operation 'PostWithAllParamsWithBodyRequiredPtr' does not accept request bodies of type '%s'", ctx.Request().Header.Get("Content-Type")),
+				"PostWithAllParamsWithBodyRequiredPtr",
+			)
+		}
+		conversionErr = bindAndValidateBody(ctx, requestContentType, "required", "", &theBodyRawPtr)
 		if conversionErr != nil {
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
@@ -1386,8 +1899,12 @@ func RegisterRoutes(engine *echo.Echo) {
 			// json error response extension placeholder
 			return ctx.JSON(statusCode, stdError)
 		}
-		// json response extension placeholder
-		return ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.GET(toEchoUrl("/e2e/get-header-start-with-letter"), func(ctx echo.Context) error {
 		// route start routes extension placeholder
@@ -1410,6 +1927,14 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "GetHeaderStartWithLetter")
 		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'GetHeaderStartWithLetter' cannot produce a response in any of the accepted media types",
+				"GetHeaderStartWithLetter",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var headerParamRawPtr *string = nil
@@ -1472,8 +1997,12 @@ func RegisterRoutes(engine *echo.Echo) {
 			// json error response extension placeholder
 			return ctx.JSON(statusCode, stdError)
 		}
-		// json response extension placeholder
-		return ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.GET(toEchoUrl("/e2e/with-default-config-security"), func(ctx echo.Context) error {
 		// route start routes extension placeholder
@@ -1496,6 +2025,14 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "WithDefaultConfigSecurity")
 		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'WithDefaultConfigSecurity' cannot produce a response in any of the accepted media types",
+				"WithDefaultConfigSecurity",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var headerParamRawPtr *string = nil
@@ -1558,8 +2095,12 @@ func RegisterRoutes(engine *echo.Echo) {
 			// json error response extension placeholder
 			return ctx.JSON(statusCode, stdError)
 		}
-		// json response extension placeholder
-		return ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.GET(toEchoUrl("/e2e/with-one-security"), func(ctx echo.Context) error {
 		// route start routes extension placeholder
@@ -1582,6 +2123,14 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "WithOneSecurity")
 		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'WithOneSecurity' cannot produce a response in any of the accepted media types",
+				"WithOneSecurity",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var headerParamRawPtr *string = nil
@@ -1644,8 +2193,12 @@ func RegisterRoutes(engine *echo.Echo) {
 			// json error response extension placeholder
 			return ctx.JSON(statusCode, stdError)
 		}
-		// json response extension placeholder
-		return ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.GET(toEchoUrl("/e2e/with-two-security"), func(ctx echo.Context) error {
 		// route start routes extension placeholder
@@ -1680,6 +2233,14 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "WithTwoSecurity")
 		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'WithTwoSecurity' cannot produce a response in any of the accepted media types",
+				"WithTwoSecurity",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var headerParamRawPtr *string = nil
@@ -1742,8 +2303,12 @@ func RegisterRoutes(engine *echo.Echo) {
 			// json error response extension placeholder
 			return ctx.JSON(statusCode, stdError)
 		}
-		// json response extension placeholder
-		return ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.GET(toEchoUrl("/e2e/with-two-security-same-method"), func(ctx echo.Context) error {
 		// route start routes extension placeholder
@@ -1778,6 +2343,14 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "WithTwoSecuritySameMethod")
 		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'WithTwoSecuritySameMethod' cannot produce a response in any of the accepted media types",
+				"WithTwoSecuritySameMethod",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var headerParamRawPtr *string = nil
@@ -1840,8 +2413,12 @@ func RegisterRoutes(engine *echo.Echo) {
 			// json error response extension placeholder
 			return ctx.JSON(statusCode, stdError)
 		}
-		// json response extension placeholder
-		return ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.GET(toEchoUrl("/e2e/default-error"), func(ctx echo.Context) error {
 		// route start routes extension placeholder
@@ -1864,6 +2441,14 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "DefaultError")
 		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'DefaultError' cannot produce a response in any of the accepted media types",
+				"DefaultError",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -1909,9 +2494,13 @@ func RegisterRoutes(engine *echo.Echo) {
 			// json error response extension placeholder
 			return ctx.JSON(statusCode, stdError)
 		}
-		// json response extension placeholder
-		ctx.Response().Header().Set("Content-Type", "application/json")
-		ctx.Response().WriteHeader(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.Response().Header().Set("Content-Type", "application/json")
+			ctx.Response().WriteHeader(statusCode)
+			return nil
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
 		return nil
 	})
 	engine.GET(toEchoUrl("/e2e/default-error-with-payload"), func(ctx echo.Context) error {
@@ -1935,6 +2524,14 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "DefaultErrorWithPayload")
 		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'DefaultErrorWithPayload' cannot produce a response in any of the accepted media types",
+				"DefaultErrorWithPayload",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -1980,8 +2577,12 @@ func RegisterRoutes(engine *echo.Echo) {
 			// json error response extension placeholder
 			return ctx.JSON(statusCode, stdError)
 		}
-		// json response extension placeholder
-		return ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.GET(toEchoUrl("/e2e/custom-error"), func(ctx echo.Context) error {
 		// route start routes extension placeholder
@@ -2004,6 +2605,14 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "CustomError")
 		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'CustomError' cannot produce a response in any of the accepted media types",
+				"CustomError",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -2042,9 +2651,13 @@ func RegisterRoutes(engine *echo.Echo) {
 			// End middlewares onErrorMiddlewares section
 			return ctx.JSON(statusCode, opError)
 		}
-		// json response extension placeholder
-		ctx.Response().Header().Set("Content-Type", "application/json")
-		ctx.Response().WriteHeader(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.Response().Header().Set("Content-Type", "application/json")
+			ctx.Response().WriteHeader(statusCode)
+			return nil
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
 		return nil
 	})
 	engine.GET(toEchoUrl("/e2e/custom-error-ptr"), func(ctx echo.Context) error {
@@ -2068,6 +2681,14 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "CustomPtrError")
 		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'CustomPtrError' cannot produce a response in any of the accepted media types",
+				"CustomPtrError",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -2105,9 +2726,13 @@ func RegisterRoutes(engine *echo.Echo) {
 			// End middlewares onErrorMiddlewares section
 			return ctx.JSON(statusCode, opError)
 		}
-		// json response extension placeholder
-		ctx.Response().Header().Set("Content-Type", "application/json")
-		ctx.Response().WriteHeader(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.Response().Header().Set("Content-Type", "application/json")
+			ctx.Response().WriteHeader(statusCode)
+			return nil
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
 		return nil
 	})
 	engine.GET(toEchoUrl("/e2e/503-error-code"), func(ctx echo.Context) error {
@@ -2131,6 +2756,14 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "Error503")
 		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'Error503' cannot produce a response in any of the accepted media types",
+				"Error503",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -2176,9 +2809,13 @@ func RegisterRoutes(engine *echo.Echo) {
 			// json error response extension placeholder
 			return ctx.JSON(statusCode, stdError)
 		}
-		// json response extension placeholder
-		ctx.Response().Header().Set("Content-Type", "application/json")
-		ctx.Response().WriteHeader(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.Response().Header().Set("Content-Type", "application/json")
+			ctx.Response().WriteHeader(statusCode)
+			return nil
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
 		return nil
 	})
 	engine.GET(toEchoUrl("/e2e/custom-error-503"), func(ctx echo.Context) error {
@@ -2202,6 +2839,14 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "CustomError503")
 		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'CustomError503' cannot produce a response in any of the accepted media types",
+				"CustomError503",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -2240,9 +2885,13 @@ func RegisterRoutes(engine *echo.Echo) {
 			// End middlewares onErrorMiddlewares section
 			return ctx.JSON(statusCode, opError)
 		}
-		// json response extension placeholder
-		ctx.Response().Header().Set("Content-Type", "application/json")
-		ctx.Response().WriteHeader(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.Response().Header().Set("Content-Type", "application/json")
+			ctx.Response().WriteHeader(statusCode)
+			return nil
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
 		return nil
 	})
 	engine.GET(toEchoUrl("/e2e/context-access"), func(ctx echo.Context) error {
@@ -2266,6 +2915,14 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "ContextAccess")
 		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'ContextAccess' cannot produce a response in any of the accepted media types",
+				"ContextAccess",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -2311,9 +2968,13 @@ func RegisterRoutes(engine *echo.Echo) {
 			// json error response extension placeholder
 			return ctx.JSON(statusCode, stdError)
 		}
-		// json response extension placeholder
-		ctx.Response().Header().Set("Content-Type", "application/json")
-		ctx.Response().WriteHeader(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.Response().Header().Set("Content-Type", "application/json")
+			ctx.Response().WriteHeader(statusCode)
+			return nil
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
 		return nil
 	})
 	engine.GET(toEchoUrl("/e2e/http-method"), func(ctx echo.Context) error {
@@ -2337,6 +2998,14 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "Get")
 		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'Get' cannot produce a response in any of the accepted media types",
+				"Get",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -2382,9 +3051,13 @@ func RegisterRoutes(engine *echo.Echo) {
 			// json error response extension placeholder
 			return ctx.JSON(statusCode, stdError)
 		}
-		// json response extension placeholder
-		ctx.Response().Header().Set("Content-Type", "application/json")
-		ctx.Response().WriteHeader(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.Response().Header().Set("Content-Type", "application/json")
+			ctx.Response().WriteHeader(statusCode)
+			return nil
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
 		return nil
 	})
 	engine.POST(toEchoUrl("/e2e/http-method"), func(ctx echo.Context) error {
@@ -2408,6 +3081,14 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "Post")
 		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'Post' cannot produce a response in any of the accepted media types",
+				"Post",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -2453,9 +3134,13 @@ func RegisterRoutes(engine *echo.Echo) {
 			// json error response extension placeholder
 			return ctx.JSON(statusCode, stdError)
 		}
-		// json response extension placeholder
-		ctx.Response().Header().Set("Content-Type", "application/json")
-		ctx.Response().WriteHeader(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.Response().Header().Set("Content-Type", "application/json")
+			ctx.Response().WriteHeader(statusCode)
+			return nil
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
 		return nil
 	})
 	engine.PUT(toEchoUrl("/e2e/http-method"), func(ctx echo.Context) error {
@@ -2479,6 +3164,14 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "Put")
 		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'Put' cannot produce a response in any of the accepted media types",
+				"Put",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -2524,9 +3217,13 @@ func RegisterRoutes(engine *echo.Echo) {
 			// json error response extension placeholder
 			return ctx.JSON(statusCode, stdError)
 		}
-		// json response extension placeholder
-		ctx.Response().Header().Set("Content-Type", "application/json")
-		ctx.Response().WriteHeader(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.Response().Header().Set("Content-Type", "application/json")
+			ctx.Response().WriteHeader(statusCode)
+			return nil
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
 		return nil
 	})
 	engine.DELETE(toEchoUrl("/e2e/http-method"), func(ctx echo.Context) error {
@@ -2550,6 +3247,14 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "Delete")
 		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'Delete' cannot produce a response in any of the accepted media types",
+				"Delete",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -2595,9 +3300,13 @@ func RegisterRoutes(engine *echo.Echo) {
 			// json error response extension placeholder
 			return ctx.JSON(statusCode, stdError)
 		}
-		// json response extension placeholder
-		ctx.Response().Header().Set("Content-Type", "application/json")
-		ctx.Response().WriteHeader(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.Response().Header().Set("Content-Type", "application/json")
+			ctx.Response().WriteHeader(statusCode)
+			return nil
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
 		return nil
 	})
 	engine.PATCH(toEchoUrl("/e2e/http-method"), func(ctx echo.Context) error {
@@ -2621,6 +3330,14 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "Patch")
 		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'Patch' cannot produce a response in any of the accepted media types",
+				"Patch",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -2666,9 +3383,13 @@ func RegisterRoutes(engine *echo.Echo) {
 			// json error response extension placeholder
 			return ctx.JSON(statusCode, stdError)
 		}
-		// json response extension placeholder
-		ctx.Response().Header().Set("Content-Type", "application/json")
-		ctx.Response().WriteHeader(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.Response().Header().Set("Content-Type", "application/json")
+			ctx.Response().WriteHeader(statusCode)
+			return nil
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
 		return nil
 	})
 	engine.GET(toEchoUrl("/e2e/template-context-1"), func(ctx echo.Context) error {
@@ -2692,6 +3413,14 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "TemplateContext1")
 		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'TemplateContext1' cannot produce a response in any of the accepted media types",
+				"TemplateContext1",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -2738,8 +3467,12 @@ func RegisterRoutes(engine *echo.Echo) {
 			// json error response extension placeholder
 			return ctx.JSON(statusCode, stdError)
 		}
-		// json response extension placeholder
-		return ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.GET(toEchoUrl("/e2e/template-context-2"), func(ctx echo.Context) error {
 		// route start routes extension placeholder
@@ -2762,6 +3495,14 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "TemplateContext2")
 		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'TemplateContext2' cannot produce a response in any of the accepted media types",
+				"TemplateContext2",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -2809,8 +3550,12 @@ func RegisterRoutes(engine *echo.Echo) {
 			// json error response extension placeholder
 			return ctx.JSON(statusCode, stdError)
 		}
-		// json response extension placeholder
-		return ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.POST(toEchoUrl("/e2e/form"), func(ctx echo.Context) error {
 		// route start routes extension placeholder
@@ -2833,6 +3578,14 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "TestForm")
 		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'TestForm' cannot produce a response in any of the accepted media types",
+				"TestForm",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		ctx.Request().ParseForm()
@@ -2910,11 +3663,14 @@ func RegisterRoutes(engine *echo.Echo) {
 			// json error response extension placeholder
 			return ctx.JSON(statusCode, stdError)
 		}
-		// json response extension placeholder
-		return ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
-	// E2EClassSecController
-	engine.GET(toEchoUrl("/e2e/with-default-class-security"), func(ctx echo.Context) error {
+	engine.GET(toEchoUrl("/e2e/enum-params/{status}"), func(ctx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ctx,
@@ -2923,9 +3679,9 @@ func RegisterRoutes(engine *echo.Echo) {
 					Relation: SecurityListRelationAnd,
 					Checks: []runtime.SecurityCheck{
 						{
-							SchemaName: "securitySchemaName",
+							SchemaName: "securitySchemaName2",
 							Scopes: []string{
-								"class",
+								"config",
 							},
 						},
 					},
@@ -2933,24 +3689,3060 @@ func RegisterRoutes(engine *echo.Echo) {
 			},
 		)
 		if authErr != nil {
-			return handleAuthorizationError(ctx, authErr, "WithDefaultClassSecurity")
+			return handleAuthorizationError(ctx, authErr, "EnumParams")
+		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'EnumParams' cannot produce a response in any of the accepted media types",
+				"EnumParams",
+			)
 		}
-		controller := E2EClassSecControllerImport.E2EClassSecController{}
+		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
-		var headerParamRawPtr *string = nil
-		headerParamRaw := ctx.Request().Header.Get("x-test-scopes")
-		_, isheaderParamExists := ctx.Request().Header["x-test-scopes"]
-		if !isheaderParamExists {
-			// In echo, the ctx.Request().Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
-			headerValues := ctx.Request().Header.Values("x-test-scopes")
-			isheaderParamExists = len(headerValues) > 0
+		var statusRawPtr *Param80status.OrderStatus = nil
+		statusRaw := ctx.Param("status")
+		isstatusExists := true // if parameter is in route but not provided, it won't reach this handler
+		if isstatusExists {
+			status := statusRaw
+			statusEnum := Param80status.OrderStatus(status)
+			if !slices.Contains([]Param80status.OrderStatus{"pending", "shipped", "delivered"}, statusEnum) {
+				conversionErr := fmt.Errorf("'%s' is not a valid OrderStatus value", statusRaw)
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'EnumParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"status",
+						"OrderStatus",
+						reflect.TypeOf(statusRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/EnumParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			}
+			statusRawPtr = &statusEnum
 		}
-		if isheaderParamExists {
-			headerParam := headerParamRaw
-			headerParamRawPtr = &headerParam
+		if validatorErr := validatorInstance.Var(statusRawPtr, "required"); validatorErr != nil {
+			fieldName := "status"
+			validationError := wrapValidatorError(validatorErr, "EnumParams", fieldName)
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
 		}
-		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
-			fieldName := "headerParam"
+		var priorityRawPtr *Param81priority.OrderPriority = nil
+		priorityRaw := ctx.QueryParam("priority")
+		ispriorityExists := ctx.Request().URL.Query().Has("priority")
+		if ispriorityExists {
+			priorityUint64, conversionErr := strconv.Atoi(priorityRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'EnumParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"priority",
+						"OrderPriority",
+						reflect.TypeOf(priorityRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/EnumParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			}
+			priority := int(priorityUint64)
+			priorityEnum := Param81priority.OrderPriority(priority)
+			if !slices.Contains([]Param81priority.OrderPriority{0, 1, 2}, priorityEnum) {
+				conversionErr := fmt.Errorf("'%s' is not a valid OrderPriority value", priorityRaw)
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'EnumParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"priority",
+						"OrderPriority",
+						reflect.TypeOf(priorityRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/EnumParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			}
+			priorityRawPtr = &priorityEnum
+		}
+		if validatorErr := validatorInstance.Var(priorityRawPtr, "required"); validatorErr != nil {
+			fieldName := "priority"
+			validationError := wrapValidatorError(validatorErr, "EnumParams", fieldName)
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		var headerStatusRawPtr *Param82headerStatus.OrderStatus = nil
+		headerStatusRaw := ctx.Request().Header.Get("x-status")
+		_, isheaderStatusExists := ctx.Request().Header["x-status"]
+		if !isheaderStatusExists {
+			// In echo, the ctx.Request().Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := ctx.Request().Header.Values("x-status")
+			isheaderStatusExists = len(headerValues) > 0
+		}
+		if isheaderStatusExists {
+			headerStatus := headerStatusRaw
+			headerStatusEnum := Param82headerStatus.OrderStatus(headerStatus)
+			if !slices.Contains([]Param82headerStatus.OrderStatus{"pending", "shipped", "delivered"}, headerStatusEnum) {
+				conversionErr := fmt.Errorf("'%s' is not a valid OrderStatus value", headerStatusRaw)
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'EnumParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"headerStatus",
+						"OrderStatus",
+						reflect.TypeOf(headerStatusRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/EnumParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			}
+			headerStatusRawPtr = &headerStatusEnum
+		}
+		if validatorErr := validatorInstance.Var(headerStatusRawPtr, "required"); validatorErr != nil {
+			fieldName := "headerStatus"
+			validationError := wrapValidatorError(validatorErr, "EnumParams", fieldName)
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.EnumParams(*statusRawPtr, *priorityRawPtr, *headerStatusRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Response().Header().Set(key, value)
+		}
+		ctx.Response().Header().Set("x-inject", "true")
+		ctx.Response().Header().Set("x-extended", "EnumParams")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'EnumParams'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/EnumParams",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.JSON(statusCode, stdError)
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
+	})
+	engine.GET(toEchoUrl("/e2e/well-known-params/{id}"), func(ctx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "WellKnownParams")
+		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'WellKnownParams' cannot produce a response in any of the accepted media types",
+				"WellKnownParams",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var idRawPtr *Param85id.UUID = nil
+		idRaw := ctx.Param("id")
+		isidExists := true // if parameter is in route but not provided, it won't reach this handler
+		if isidExists {
+			id, conversionErr := Param85id.Parse(idRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'WellKnownParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"id",
+						"UUID",
+						reflect.TypeOf(idRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/WellKnownParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			}
+			idRawPtr = &id
+		}
+		if validatorErr := validatorInstance.Var(idRawPtr, "required"); validatorErr != nil {
+			fieldName := "id"
+			validationError := wrapValidatorError(validatorErr, "WellKnownParams", fieldName)
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		var atRawPtr *Param86at.Time = nil
+		atRaw := ctx.QueryParam("at")
+		isatExists := ctx.Request().URL.Query().Has("at")
+		if isatExists {
+			at, conversionErr := Param86at.Parse(Param86at.RFC3339, atRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'WellKnownParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"at",
+						"Time",
+						reflect.TypeOf(atRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/WellKnownParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			}
+			atRawPtr = &at
+		}
+		if validatorErr := validatorInstance.Var(atRawPtr, "required"); validatorErr != nil {
+			fieldName := "at"
+			validationError := wrapValidatorError(validatorErr, "WellKnownParams", fieldName)
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		var timeoutRawPtr *Param87timeout.Duration = nil
+		timeoutRaw := ctx.QueryParam("timeout")
+		istimeoutExists := ctx.Request().URL.Query().Has("timeout")
+		if istimeoutExists {
+			timeoutInt64, conversionErr := strconv.ParseInt(timeoutRaw, 10, 64)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'WellKnownParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"timeout",
+						"Duration",
+						reflect.TypeOf(timeoutRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/WellKnownParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			}
+			timeout := Param87timeout.Duration(timeoutInt64)
+			timeoutRawPtr = &timeout
+		}
+		if validatorErr := validatorInstance.Var(timeoutRawPtr, "required"); validatorErr != nil {
+			fieldName := "timeout"
+			validationError := wrapValidatorError(validatorErr, "WellKnownParams", fieldName)
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		var dataRawPtr *[]byte = nil
+		dataRaw := ctx.QueryParam("data")
+		isdataExists := ctx.Request().URL.Query().Has("data")
+		if isdataExists {
+			data, conversionErr := base64.StdEncoding.DecodeString(dataRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'WellKnownParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"data",
+						"[]byte",
+						reflect.TypeOf(dataRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/WellKnownParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			}
+			dataRawPtr = &data
+		}
+		if validatorErr := validatorInstance.Var(dataRawPtr, "required"); validatorErr != nil {
+			fieldName := "data"
+			validationError := wrapValidatorError(validatorErr, "WellKnownParams", fieldName)
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		var rawRawPtr *Param89raw.RawMessage = nil
+		rawRaw := ctx.QueryParam("raw")
+		israwExists := ctx.Request().URL.Query().Has("raw")
+		if israwExists {
+			raw := Param89raw.RawMessage(rawRaw)
+			if !Param89raw.Valid(raw) {
+				conversionErr := fmt.Errorf("'%s' is not valid JSON", rawRaw)
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'WellKnownParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"raw",
+						"RawMessage",
+						reflect.TypeOf(rawRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/WellKnownParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			}
+			rawRawPtr = &raw
+		}
+		if validatorErr := validatorInstance.Var(rawRawPtr, "required"); validatorErr != nil {
+			fieldName := "raw"
+			validationError := wrapValidatorError(validatorErr, "WellKnownParams", fieldName)
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		var addressRawPtr *Param90address.IP = nil
+		addressRaw := ctx.Request().Header.Get("x-address")
+		_, isaddressExists := ctx.Request().Header["x-address"]
+		if !isaddressExists {
+			// In echo, the ctx.Request().Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := ctx.Request().Header.Values("x-address")
+			isaddressExists = len(headerValues) > 0
+		}
+		if isaddressExists {
+			address := Param90address.ParseIP(addressRaw)
+			if address == nil {
+				conversionErr := fmt.Errorf("'%s' is not a valid IP address", addressRaw)
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'WellKnownParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"address",
+						"IP",
+						reflect.TypeOf(addressRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/WellKnownParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			}
+			addressRawPtr = &address
+		}
+		if validatorErr := validatorInstance.Var(addressRawPtr, "required"); validatorErr != nil {
+			fieldName := "address"
+			validationError := wrapValidatorError(validatorErr, "WellKnownParams", fieldName)
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		var linkRawPtr *Param91link.URL = nil
+		linkRaw := ctx.QueryParam("link")
+		islinkExists := ctx.Request().URL.Query().Has("link")
+		if islinkExists {
+			linkUrl, conversionErr := Param91link.ParseRequestURI(linkRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'WellKnownParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"link",
+						"URL",
+						reflect.TypeOf(linkRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/WellKnownParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			}
+			link := *linkUrl
+			linkRawPtr = &link
+		}
+		if validatorErr := validatorInstance.Var(linkRawPtr, "required"); validatorErr != nil {
+			fieldName := "link"
+			validationError := wrapValidatorError(validatorErr, "WellKnownParams", fieldName)
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.WellKnownParams(*idRawPtr, *atRawPtr, *timeoutRawPtr, *dataRawPtr, *rawRawPtr, *addressRawPtr, *linkRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Response().Header().Set(key, value)
+		}
+		ctx.Response().Header().Set("x-inject", "true")
+		ctx.Response().Header().Set("x-extended", "WellKnownParams")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WellKnownParams'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/WellKnownParams",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.JSON(statusCode, stdError)
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
+	})
+	engine.GET(toEchoUrl("/e2e/mapped-params/{amount}"), func(ctx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "MappedParams")
+		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'MappedParams' cannot produce a response in any of the accepted media types",
+				"MappedParams",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var amountRawPtr *Param94amount.Amount = nil
+		amountRaw := ctx.Param("amount")
+		isamountExists := true // if parameter is in route but not provided, it won't reach this handler
+		if isamountExists {
+			amount, conversionErr := ParamParser94amount.ParseAmount(amountRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'MappedParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"amount",
+						"Amount",
+						reflect.TypeOf(amountRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/MappedParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			}
+			amountRawPtr = &amount
+		}
+		if validatorErr := validatorInstance.Var(amountRawPtr, "required"); validatorErr != nil {
+			fieldName := "amount"
+			validationError := wrapValidatorError(validatorErr, "MappedParams", fieldName)
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		var tipRawPtr *Param95tip.Amount = nil
+		tipRaw := ctx.QueryParam("tip")
+		istipExists := ctx.Request().URL.Query().Has("tip")
+		if istipExists {
+			tip, conversionErr := ParamParser95tip.ParseAmount(tipRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'MappedParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"tip",
+						"Amount",
+						reflect.TypeOf(tipRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/MappedParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			}
+			tipRawPtr = &tip
+		}
+		if validatorErr := validatorInstance.Var(tipRawPtr, "required"); validatorErr != nil {
+			fieldName := "tip"
+			validationError := wrapValidatorError(validatorErr, "MappedParams", fieldName)
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.MappedParams(*amountRawPtr, *tipRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Response().Header().Set(key, value)
+		}
+		ctx.Response().Header().Set("x-inject", "true")
+		ctx.Response().Header().Set("x-extended", "MappedParams")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'MappedParams'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/MappedParams",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.JSON(statusCode, stdError)
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
+	})
+	engine.POST(toEchoUrl("/e2e/generic-envelope"), func(ctx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "GenericEnvelope")
+		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'GenericEnvelope' cannot produce a response in any of the accepted media types",
+				"GenericEnvelope",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var conversionErr error
+		var envelopeRawPtr *Param98envelope.Envelope[Param98envelopeArg0.BodyInfo] = nil
+		requestContentType, isRequestContentTypeSupported := getRequestContentType(ctx.Request().Header.Get("Content-Type"), []string{"application/json"})
+		if !isRequestContentTypeSupported {
+			return handleContentNegotiationError(ctx,
+				http.StatusUnsupportedMediaType,
+				fmt.Sprintf("Operation 'GenericEnvelope' does not accept request bodies of type '%s'", ctx.Request().Header.Get("Content-Type")),
+				"GenericEnvelope",
+			)
+		}
+		conversionErr = bindAndValidateBody(ctx, requestContentType, "required", "", &envelopeRawPtr)
+		if conversionErr != nil {
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: fmt.Sprintf(
+					"A request was made to operation 'GenericEnvelope' but body parameter '%s' did not pass validation of '%s' - %s",
+					"envelope",
+					"Envelope",
+					extractValidationErrorMessage(conversionErr, nil),
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/gleece/validation/error/GenericEnvelope",
+			}
+			// json body validation error response extension placeholder
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.GenericEnvelope(*envelopeRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Response().Header().Set(key, value)
+		}
+		ctx.Response().Header().Set("x-inject", "true")
+		ctx.Response().Header().Set("x-extended", "GenericEnvelope")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'GenericEnvelope'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/GenericEnvelope",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.JSON(statusCode, stdError)
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
+	})
+	engine.POST(toEchoUrl("/e2e/generic-envelope-map"), func(ctx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "GenericEnvelopeMap")
+		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'GenericEnvelopeMap' cannot produce a response in any of the accepted media types",
+				"GenericEnvelopeMap",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var conversionErr error
+		var envelopeRawPtr *Param101envelope.Envelope[map[Param101envelopeArg0Key.Region]Param101envelopeArg0Value.BodyInfo] = nil
+		requestContentType, isRequestContentTypeSupported := getRequestContentType(ctx.Request().Header.Get("Content-Type"), []string{"application/json"})
+		if !isRequestContentTypeSupported {
+			return handleContentNegotiationError(ctx,
+				http.StatusUnsupportedMediaType,
+				fmt.Sprintf("Operation 'GenericEnvelopeMap' does not accept request bodies of type '%s'", ctx.Request().Header.Get("Content-Type")),
+				"GenericEnvelopeMap",
+			)
+		}
+		conversionErr = bindAndValidateBody(ctx, requestContentType, "required", "", &envelopeRawPtr)
+		if conversionErr != nil {
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: fmt.Sprintf(
+					"A request was made to operation 'GenericEnvelopeMap' but body parameter '%s' did not pass validation of '%s' - %s",
+					"envelope",
+					"Envelope",
+					extractValidationErrorMessage(conversionErr, nil),
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/gleece/validation/error/GenericEnvelopeMap",
+			}
+			// json body validation error response extension placeholder
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.GenericEnvelopeMap(*envelopeRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Response().Header().Set(key, value)
+		}
+		ctx.Response().Header().Set("x-inject", "true")
+		ctx.Response().Header().Set("x-extended", "GenericEnvelopeMap")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'GenericEnvelopeMap'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/GenericEnvelopeMap",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.JSON(statusCode, stdError)
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
+	})
+	engine.GET(toEchoUrl("/e2e/slice-params"), func(ctx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "SliceParams")
+		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'SliceParams' cannot produce a response in any of the accepted media types",
+				"SliceParams",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		idsRawValues, isidsExists := ctx.QueryParams()["ids"]
+		var idsRawPtr *[]int = nil
+		if isidsExists {
+			ids := []int{}
+			for _, paramValue := range splitParamValues(idsRawValues, "") {
+				var idsItemRawPtr *int = nil
+				idsItemRaw := paramValue
+				isidsItemExists := true
+				if isidsItemExists {
+					idsItemUint64, conversionErr := strconv.Atoi(idsItemRaw)
+					if conversionErr != nil {
+						validationError := runtime.Rfc7807Error{
+							Type: http.StatusText(http.StatusUnprocessableEntity),
+							Detail: fmt.Sprintf(
+								"A request was made to operation 'SliceParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+								"idsItem",
+								"int",
+								reflect.TypeOf(idsItemRaw).String(),
+							),
+							Status:     http.StatusUnprocessableEntity,
+							Instance:   "/gleece/validation/error/SliceParams",
+							Extensions: map[string]string{"error": conversionErr.Error()},
+						}
+						// json validation error response extension placeholder
+						return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+					}
+					idsItem := int(idsItemUint64)
+					idsItemRawPtr = &idsItem
+				}
+				ids = append(ids, *idsItemRawPtr)
+			}
+			idsRawPtr = &ids
+		}
+		if validatorErr := validatorInstance.Var(idsRawPtr, "required"); validatorErr != nil {
+			fieldName := "ids"
+			validationError := wrapValidatorError(validatorErr, "SliceParams", fieldName)
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		tagsRawValues, istagsExists := ctx.QueryParams()["tags"]
+		var tagsRawPtr *[]string = nil
+		if istagsExists {
+			tags := []string{}
+			for _, paramValue := range splitParamValues(tagsRawValues, ",") {
+				var tagsItemRawPtr *string = nil
+				tagsItemRaw := paramValue
+				istagsItemExists := true
+				if istagsItemExists {
+					tagsItem := tagsItemRaw
+					tagsItemRawPtr = &tagsItem
+				}
+				tags = append(tags, *tagsItemRawPtr)
+			}
+			tagsRawPtr = &tags
+		}
+		if validatorErr := validatorInstance.Var(tagsRawPtr, "required"); validatorErr != nil {
+			fieldName := "tags"
+			validationError := wrapValidatorError(validatorErr, "SliceParams", fieldName)
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		namesRawValues, isnamesExists := ctx.QueryParams()["names"]
+		var namesRawPtr *[]string = nil
+		if isnamesExists {
+			names := []string{}
+			for _, paramValue := range splitParamValues(namesRawValues, "|") {
+				var namesItemRawPtr *string = nil
+				namesItemRaw := paramValue
+				isnamesItemExists := true
+				if isnamesItemExists {
+					namesItem := namesItemRaw
+					namesItemRawPtr = &namesItem
+				}
+				names = append(names, *namesItemRawPtr)
+			}
+			namesRawPtr = &names
+		}
+		if validatorErr := validatorInstance.Var(namesRawPtr, "required"); validatorErr != nil {
+			fieldName := "names"
+			validationError := wrapValidatorError(validatorErr, "SliceParams", fieldName)
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		statusesRawValues, isstatusesExists := ctx.QueryParams()["statuses"]
+		var statusesRawPtr *[]Param107statusesItem.OrderStatus = nil
+		if isstatusesExists {
+			statuses := []Param107statusesItem.OrderStatus{}
+			for _, paramValue := range splitParamValues(statusesRawValues, "") {
+				var statusesItemRawPtr *Param107statusesItem.OrderStatus = nil
+				statusesItemRaw := paramValue
+				isstatusesItemExists := true
+				if isstatusesItemExists {
+					statusesItem := statusesItemRaw
+					statusesItemEnum := Param107statusesItem.OrderStatus(statusesItem)
+					if !slices.Contains([]Param107statusesItem.OrderStatus{"pending", "shipped", "delivered"}, statusesItemEnum) {
+						conversionErr := fmt.Errorf("'%s' is not a valid OrderStatus value", statusesItemRaw)
+						validationError := runtime.Rfc7807Error{
+							Type: http.StatusText(http.StatusUnprocessableEntity),
+							Detail: fmt.Sprintf(
+								"A request was made to operation 'SliceParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+								"statusesItem",
+								"OrderStatus",
+								reflect.TypeOf(statusesItemRaw).String(),
+							),
+							Status:     http.StatusUnprocessableEntity,
+							Instance:   "/gleece/validation/error/SliceParams",
+							Extensions: map[string]string{"error": conversionErr.Error()},
+						}
+						// json validation error response extension placeholder
+						return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+					}
+					statusesItemRawPtr = &statusesItemEnum
+				}
+				statuses = append(statuses, *statusesItemRawPtr)
+			}
+			statusesRawPtr = &statuses
+		}
+		if validatorErr := validatorInstance.Var(statusesRawPtr, "required"); validatorErr != nil {
+			fieldName := "statuses"
+			validationError := wrapValidatorError(validatorErr, "SliceParams", fieldName)
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		keysRawValues, iskeysExists := ctx.QueryParams()["keys"]
+		var keysRawPtr *[]Param108keysItem.UUID = nil
+		if iskeysExists {
+			keys := []Param108keysItem.UUID{}
+			for _, paramValue := range splitParamValues(keysRawValues, "") {
+				var keysItemRawPtr *Param108keysItem.UUID = nil
+				keysItemRaw := paramValue
+				iskeysItemExists := true
+				if iskeysItemExists {
+					keysItem, conversionErr := Param108keysItem.Parse(keysItemRaw)
+					if conversionErr != nil {
+						validationError := runtime.Rfc7807Error{
+							Type: http.StatusText(http.StatusUnprocessableEntity),
+							Detail: fmt.Sprintf(
+								"A request was made to operation 'SliceParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+								"keysItem",
+								"UUID",
+								reflect.TypeOf(keysItemRaw).String(),
+							),
+							Status:     http.StatusUnprocessableEntity,
+							Instance:   "/gleece/validation/error/SliceParams",
+							Extensions: map[string]string{"error": conversionErr.Error()},
+						}
+						// json validation error response extension placeholder
+						return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+					}
+					keysItemRawPtr = &keysItem
+				}
+				keys = append(keys, *keysItemRawPtr)
+			}
+			keysRawPtr = &keys
+		}
+		if validatorErr := validatorInstance.Var(keysRawPtr, "max=2,required"); validatorErr != nil {
+			fieldName := "keys"
+			validationError := wrapValidatorError(validatorErr, "SliceParams", fieldName)
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		labelsRawValues := ctx.Request().Header.Values("x-labels")
+		islabelsExists := len(labelsRawValues) > 0
+		var labelsRawPtr *[]string = nil
+		if islabelsExists {
+			labels := []string{}
+			for _, paramValue := range splitParamValues(labelsRawValues, ",") {
+				var labelsItemRawPtr *string = nil
+				labelsItemRaw := paramValue
+				islabelsItemExists := true
+				if islabelsItemExists {
+					labelsItem := labelsItemRaw
+					labelsItemRawPtr = &labelsItem
+				}
+				labels = append(labels, *labelsItemRawPtr)
+			}
+			labelsRawPtr = &labels
+		}
+		if validatorErr := validatorInstance.Var(labelsRawPtr, "required"); validatorErr != nil {
+			fieldName := "labels"
+			validationError := wrapValidatorError(validatorErr, "SliceParams", fieldName)
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.SliceParams(*idsRawPtr, *tagsRawPtr, *namesRawPtr, *statusesRawPtr, *keysRawPtr, *labelsRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Response().Header().Set(key, value)
+		}
+		ctx.Response().Header().Set("x-inject", "true")
+		ctx.Response().Header().Set("x-extended", "SliceParams")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SliceParams'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/SliceParams",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.JSON(statusCode, stdError)
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
+	})
+	engine.GET(toEchoUrl("/e2e/query-object"), func(ctx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "QueryObject")
+		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'QueryObject' cannot produce a response in any of the accepted media types",
+				"QueryObject",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var filterRawPtr = &Param112filter.ListFilter{}
+		var filterPageRawPtr *int = nil
+		filterPageRaw := ctx.QueryParam("page")
+		isfilterPageExists := ctx.Request().URL.Query().Has("page")
+		if isfilterPageExists {
+			filterPageUint64, conversionErr := strconv.Atoi(filterPageRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'QueryObject' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"filterPage",
+						"int",
+						reflect.TypeOf(filterPageRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/QueryObject",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			}
+			filterPage := int(filterPageUint64)
+			filterPageRawPtr = &filterPage
+		}
+		if filterPageRawPtr != nil {
+			filterRawPtr.Page = *filterPageRawPtr
+		}
+		var filterPageSizeRawPtr *int = nil
+		filterPageSizeRaw := ctx.QueryParam("page_size")
+		isfilterPageSizeExists := ctx.Request().URL.Query().Has("page_size")
+		if isfilterPageSizeExists {
+			filterPageSizeUint64, conversionErr := strconv.Atoi(filterPageSizeRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'QueryObject' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"filterPageSize",
+						"int",
+						reflect.TypeOf(filterPageSizeRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/QueryObject",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			}
+			filterPageSize := int(filterPageSizeUint64)
+			filterPageSizeRawPtr = &filterPageSize
+		}
+		if filterPageSizeRawPtr != nil {
+			filterRawPtr.PageSize = filterPageSizeRawPtr
+		}
+		filterTagsRawValues, isfilterTagsExists := ctx.QueryParams()["tag"]
+		var filterTagsRawPtr *[]string = nil
+		if isfilterTagsExists {
+			filterTags := []string{}
+			for _, paramValue := range splitParamValues(filterTagsRawValues, "") {
+				var filterTagsItemRawPtr *string = nil
+				filterTagsItemRaw := paramValue
+				isfilterTagsItemExists := true
+				if isfilterTagsItemExists {
+					filterTagsItem := filterTagsItemRaw
+					filterTagsItemRawPtr = &filterTagsItem
+				}
+				filterTags = append(filterTags, *filterTagsItemRawPtr)
+			}
+			filterTagsRawPtr = &filterTags
+		}
+		if filterTagsRawPtr != nil {
+			filterRawPtr.Tags = *filterTagsRawPtr
+		}
+		var filterStatusRawPtr *Param116filterStatus.OrderStatus = nil
+		filterStatusRaw := ctx.QueryParam("status")
+		isfilterStatusExists := ctx.Request().URL.Query().Has("status")
+		if isfilterStatusExists {
+			filterStatus := filterStatusRaw
+			filterStatusEnum := Param116filterStatus.OrderStatus(filterStatus)
+			if !slices.Contains([]Param116filterStatus.OrderStatus{"pending", "shipped", "delivered"}, filterStatusEnum) {
+				conversionErr := fmt.Errorf("'%s' is not a valid OrderStatus value", filterStatusRaw)
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'QueryObject' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"filterStatus",
+						"OrderStatus",
+						reflect.TypeOf(filterStatusRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/QueryObject",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			}
+			filterStatusRawPtr = &filterStatusEnum
+		}
+		if filterStatusRawPtr != nil {
+			filterRawPtr.Status = *filterStatusRawPtr
+		}
+		if validatorErr := validatorInstance.Struct(filterRawPtr); validatorErr != nil {
+			fieldName := "filter"
+			validationError := wrapStructValidatorError(validatorErr, "QueryObject", fieldName)
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		var otherRawPtr *string = nil
+		otherRaw := ctx.QueryParam("other")
+		isotherExists := ctx.Request().URL.Query().Has("other")
+		if isotherExists {
+			other := otherRaw
+			otherRawPtr = &other
+		}
+		if validatorErr := validatorInstance.Var(otherRawPtr, "required"); validatorErr != nil {
+			fieldName := "other"
+			validationError := wrapValidatorError(validatorErr, "QueryObject", fieldName)
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.QueryObject(*filterRawPtr, *otherRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Response().Header().Set(key, value)
+		}
+		ctx.Response().Header().Set("x-inject", "true")
+		ctx.Response().Header().Set("x-extended", "QueryObject")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'QueryObject'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/QueryObject",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.JSON(statusCode, stdError)
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
+	})
+	engine.POST(toEchoUrl("/e2e/polymorphic-body"), func(ctx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "PolymorphicBody")
+		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'PolymorphicBody' cannot produce a response in any of the accepted media types",
+				"PolymorphicBody",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var conversionErr error
+		var petRawPtr *Param120pet.Pet = nil
+		requestContentType, isRequestContentTypeSupported := getRequestContentType(ctx.Request().Header.Get("Content-Type"), []string{"application/json"})
+		if !isRequestContentTypeSupported {
+			return handleContentNegotiationError(ctx,
+				http.StatusUnsupportedMediaType,
+				fmt.Sprintf("Operation 'PolymorphicBody' does not accept request bodies of type '%s'", ctx.Request().Header.Get("Content-Type")),
+				"PolymorphicBody",
+			)
+		}
+		conversionErr = bindAndValidatePolymorphicBody(
+			ctx,
+			requestContentType,
+			"required",
+			"kind",
+			map[string]func([]byte) (Param120pet.Pet, error){
+				"cat": unmarshalOneOfMember[Param120pet.CatInfo, Param120pet.Pet](false),
+				"dog": unmarshalOneOfMember[Param120pet.DogInfo, Param120pet.Pet](true),
+			},
+			&petRawPtr,
+		)
+		if conversionErr != nil {
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: fmt.Sprintf(
+					"A request was made to operation 'PolymorphicBody' but body parameter '%s' did not pass validation of '%s' - %s",
+					"pet",
+					"Pet",
+					extractValidationErrorMessage(conversionErr, nil),
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/gleece/validation/error/PolymorphicBody",
+			}
+			// json body validation error response extension placeholder
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.PolymorphicBody(*petRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Response().Header().Set(key, value)
+		}
+		ctx.Response().Header().Set("x-inject", "true")
+		ctx.Response().Header().Set("x-extended", "PolymorphicBody")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PolymorphicBody'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/PolymorphicBody",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.JSON(statusCode, stdError)
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
+	})
+	engine.GET(toEchoUrl("/e2e/map-response"), func(ctx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "MapResponse")
+		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'MapResponse' cannot produce a response in any of the accepted media types",
+				"MapResponse",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		namesRawValues, isnamesExists := ctx.QueryParams()["names"]
+		var namesRawPtr *[]string = nil
+		if isnamesExists {
+			names := []string{}
+			for _, paramValue := range splitParamValues(namesRawValues, "") {
+				var namesItemRawPtr *string = nil
+				namesItemRaw := paramValue
+				isnamesItemExists := true
+				if isnamesItemExists {
+					namesItem := namesItemRaw
+					namesItemRawPtr = &namesItem
+				}
+				names = append(names, *namesItemRawPtr)
+			}
+			namesRawPtr = &names
+		}
+		if validatorErr := validatorInstance.Var(namesRawPtr, "required"); validatorErr != nil {
+			fieldName := "names"
+			validationError := wrapValidatorError(validatorErr, "MapResponse", fieldName)
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.MapResponse(*namesRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Response().Header().Set(key, value)
+		}
+		ctx.Response().Header().Set("x-inject", "true")
+		ctx.Response().Header().Set("x-extended", "MapResponse")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'MapResponse'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/MapResponse",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.JSON(statusCode, stdError)
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
+	})
+	engine.GET(toEchoUrl("/e2e/named-primitive-params/{customerId}"), func(ctx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "NamedPrimitiveParams")
+		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'NamedPrimitiveParams' cannot produce a response in any of the accepted media types",
+				"NamedPrimitiveParams",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var customerIdRawPtr *Param126customerId.CustomerID = nil
+		customerIdRaw := ctx.Param("customerId")
+		iscustomerIdExists := true // if parameter is in route but not provided, it won't reach this handler
+		if iscustomerIdExists {
+			customerId := customerIdRaw
+			customerIdNamed := Param126customerId.CustomerID(customerId)
+			customerIdRawPtr = &customerIdNamed
+		}
+		if validatorErr := validatorInstance.Var(customerIdRawPtr, "required"); validatorErr != nil {
+			fieldName := "customerId"
+			validationError := wrapValidatorError(validatorErr, "NamedPrimitiveParams", fieldName)
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		var amountRawPtr *Param127amount.Cents = nil
+		amountRaw := ctx.QueryParam("amount")
+		isamountExists := ctx.Request().URL.Query().Has("amount")
+		if isamountExists {
+			amountUint64, conversionErr := strconv.ParseInt(amountRaw, 10, 64)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'NamedPrimitiveParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"amount",
+						"Cents",
+						reflect.TypeOf(amountRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/NamedPrimitiveParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			}
+			amount := int64(amountUint64)
+			amountNamed := Param127amount.Cents(amount)
+			amountRawPtr = &amountNamed
+		}
+		if validatorErr := validatorInstance.Var(amountRawPtr, "required"); validatorErr != nil {
+			fieldName := "amount"
+			validationError := wrapValidatorError(validatorErr, "NamedPrimitiveParams", fieldName)
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		splitsRawValues, issplitsExists := ctx.QueryParams()["splits"]
+		var splitsRawPtr *[]Param128splitsItem.Cents = nil
+		if issplitsExists {
+			splits := []Param128splitsItem.Cents{}
+			for _, paramValue := range splitParamValues(splitsRawValues, "") {
+				var splitsItemRawPtr *Param128splitsItem.Cents = nil
+				splitsItemRaw := paramValue
+				issplitsItemExists := true
+				if issplitsItemExists {
+					splitsItemUint64, conversionErr := strconv.ParseInt(splitsItemRaw, 10, 64)
+					if conversionErr != nil {
+						validationError := runtime.Rfc7807Error{
+							Type: http.StatusText(http.StatusUnprocessableEntity),
+							Detail: fmt.Sprintf(
+								"A request was made to operation 'NamedPrimitiveParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+								"splitsItem",
+								"Cents",
+								reflect.TypeOf(splitsItemRaw).String(),
+							),
+							Status:     http.StatusUnprocessableEntity,
+							Instance:   "/gleece/validation/error/NamedPrimitiveParams",
+							Extensions: map[string]string{"error": conversionErr.Error()},
+						}
+						// json validation error response extension placeholder
+						return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+					}
+					splitsItem := int64(splitsItemUint64)
+					splitsItemNamed := Param128splitsItem.Cents(splitsItem)
+					splitsItemRawPtr = &splitsItemNamed
+				}
+				splits = append(splits, *splitsItemRawPtr)
+			}
+			splitsRawPtr = &splits
+		}
+		if validatorErr := validatorInstance.Var(splitsRawPtr, "required"); validatorErr != nil {
+			fieldName := "splits"
+			validationError := wrapValidatorError(validatorErr, "NamedPrimitiveParams", fieldName)
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		var currencyRawPtr *Param129currency.Currency = nil
+		currencyRaw := ctx.Request().Header.Get("x-currency")
+		_, iscurrencyExists := ctx.Request().Header["x-currency"]
+		if !iscurrencyExists {
+			// In echo, the ctx.Request().Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := ctx.Request().Header.Values("x-currency")
+			iscurrencyExists = len(headerValues) > 0
+		}
+		if iscurrencyExists {
+			currency := currencyRaw
+			currencyNamed := Param129currency.Currency(currency)
+			currencyRawPtr = &currencyNamed
+		}
+		if validatorErr := validatorInstance.Var(currencyRawPtr, "required"); validatorErr != nil {
+			fieldName := "currency"
+			validationError := wrapValidatorError(validatorErr, "NamedPrimitiveParams", fieldName)
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.NamedPrimitiveParams(*customerIdRawPtr, *amountRawPtr, *splitsRawPtr, *currencyRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Response().Header().Set(key, value)
+		}
+		ctx.Response().Header().Set("x-inject", "true")
+		ctx.Response().Header().Set("x-extended", "NamedPrimitiveParams")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'NamedPrimitiveParams'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/NamedPrimitiveParams",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.JSON(statusCode, stdError)
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
+	})
+	engine.POST(toEchoUrl("/e2e/body-defaults"), func(ctx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "BodyDefaults")
+		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'BodyDefaults' cannot produce a response in any of the accepted media types",
+				"BodyDefaults",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var conversionErr error
+		var infoRawPtr *Param132info.DefaultsInfo = nil
+		requestContentType, isRequestContentTypeSupported := getRequestContentType(ctx.Request().Header.Get("Content-Type"), []string{"application/json"})
+		if !isRequestContentTypeSupported {
+			return handleContentNegotiationError(ctx,
+				http.StatusUnsupportedMediaType,
+				fmt.Sprintf("Operation 'BodyDefaults' does not accept request bodies of type '%s'", ctx.Request().Header.Get("Content-Type")),
+				"BodyDefaults",
+			)
+		}
+		conversionErr = bindAndValidateBody(ctx, requestContentType, "required", "{\"limit\":20,\"name\":\"guest\",\"notify\":true,\"paging\":{\"page\":1,\"size\":50},\"status\":\"shipped\"}", &infoRawPtr)
+		if conversionErr != nil {
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: fmt.Sprintf(
+					"A request was made to operation 'BodyDefaults' but body parameter '%s' did not pass validation of '%s' - %s",
+					"info",
+					"DefaultsInfo",
+					extractValidationErrorMessage(conversionErr, nil),
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/gleece/validation/error/BodyDefaults",
+			}
+			// json body validation error response extension placeholder
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.BodyDefaults(*infoRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Response().Header().Set(key, value)
+		}
+		ctx.Response().Header().Set("x-inject", "true")
+		ctx.Response().Header().Set("x-extended", "BodyDefaults")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'BodyDefaults'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/BodyDefaults",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.JSON(statusCode, stdError)
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
+	})
+	engine.POST(toEchoUrl("/e2e/param-defaults"), func(ctx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "ParamDefaults")
+		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'ParamDefaults' cannot produce a response in any of the accepted media types",
+				"ParamDefaults",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var limitRawPtr *int = nil
+		limitRaw := ctx.QueryParam("limit")
+		islimitExists := ctx.Request().URL.Query().Has("limit")
+		if !islimitExists {
+			limitRaw = "20"
+			islimitExists = true
+		}
+		if islimitExists {
+			limitUint64, conversionErr := strconv.Atoi(limitRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'ParamDefaults' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"limit",
+						"int",
+						reflect.TypeOf(limitRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/ParamDefaults",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			}
+			limit := int(limitUint64)
+			limitRawPtr = &limit
+		}
+		if validatorErr := validatorInstance.Var(limitRawPtr, "required"); validatorErr != nil {
+			fieldName := "limit"
+			validationError := wrapValidatorError(validatorErr, "ParamDefaults", fieldName)
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		var notifyRawPtr *bool = nil
+		notifyRaw := ctx.QueryParam("notify")
+		isnotifyExists := ctx.Request().URL.Query().Has("notify")
+		if !isnotifyExists {
+			notifyRaw = "true"
+			isnotifyExists = true
+		}
+		if isnotifyExists {
+			notify, conversionErr := strconv.ParseBool(notifyRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'ParamDefaults' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"notify",
+						"bool",
+						reflect.TypeOf(notifyRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/ParamDefaults",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			}
+			notifyRawPtr = &notify
+		}
+		var statusRawPtr *Param137status.OrderStatus = nil
+		statusRaw := ctx.QueryParam("status")
+		isstatusExists := ctx.Request().URL.Query().Has("status")
+		if !isstatusExists {
+			statusRaw = "shipped"
+			isstatusExists = true
+		}
+		if isstatusExists {
+			status := statusRaw
+			statusEnum := Param137status.OrderStatus(status)
+			if !slices.Contains([]Param137status.OrderStatus{"pending", "shipped", "delivered"}, statusEnum) {
+				conversionErr := fmt.Errorf("'%s' is not a valid OrderStatus value", statusRaw)
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'ParamDefaults' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"status",
+						"OrderStatus",
+						reflect.TypeOf(statusRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/ParamDefaults",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			}
+			statusRawPtr = &statusEnum
+		}
+		if validatorErr := validatorInstance.Var(statusRawPtr, "required"); validatorErr != nil {
+			fieldName := "status"
+			validationError := wrapValidatorError(validatorErr, "ParamDefaults", fieldName)
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		var regionRawPtr *string = nil
+		regionRaw := ctx.Request().Header.Get("x-region")
+		_, isregionExists := ctx.Request().Header["x-region"]
+		if !isregionExists {
+			// In echo, the ctx.Request().Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := ctx.Request().Header.Values("x-region")
+			isregionExists = len(headerValues) > 0
+		}
+		if !isregionExists {
+			regionRaw = "eu"
+			isregionExists = true
+		}
+		if isregionExists {
+			region := regionRaw
+			regionRawPtr = &region
+		}
+		if validatorErr := validatorInstance.Var(regionRawPtr, "required"); validatorErr != nil {
+			fieldName := "region"
+			validationError := wrapValidatorError(validatorErr, "ParamDefaults", fieldName)
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		ctx.Request().ParseForm()
+		var noteRawPtr *string = nil
+		noteRawArr, isnoteExists := ctx.Request().PostForm["note"]
+		noteRaw := ""
+		if isnoteExists {
+			noteRaw = noteRawArr[0] // Get first value since form values are slices
+		}
+		if !isnoteExists {
+			noteRaw = "none"
+			isnoteExists = true
+		}
+		if isnoteExists {
+			note := noteRaw
+			noteRawPtr = &note
+		}
+		if validatorErr := validatorInstance.Var(noteRawPtr, "required"); validatorErr != nil {
+			fieldName := "note"
+			validationError := wrapValidatorError(validatorErr, "ParamDefaults", fieldName)
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.ParamDefaults(*limitRawPtr, notifyRawPtr, *statusRawPtr, *regionRawPtr, *noteRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Response().Header().Set(key, value)
+		}
+		ctx.Response().Header().Set("x-inject", "true")
+		ctx.Response().Header().Set("x-extended", "ParamDefaults")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'ParamDefaults'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/ParamDefaults",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.JSON(statusCode, stdError)
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
+	})
+	engine.GET(toEchoUrl("/e2e/cookie-params"), func(ctx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "CookieParams")
+		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'CookieParams' cannot produce a response in any of the accepted media types",
+				"CookieParams",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var sessionRawPtr *string = nil
+		sessionRawCookie, sessionCookieErr := ctx.Request().Cookie("session_id")
+		issessionExists := sessionCookieErr == nil
+		sessionRaw := ""
+		if issessionExists {
+			sessionRaw = sessionRawCookie.Value
+		}
+		if issessionExists {
+			session := sessionRaw
+			sessionRawPtr = &session
+		}
+		if validatorErr := validatorInstance.Var(sessionRawPtr, "required"); validatorErr != nil {
+			fieldName := "session"
+			validationError := wrapValidatorError(validatorErr, "CookieParams", fieldName)
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		var themeRawPtr *string = nil
+		themeRawCookie, themeCookieErr := ctx.Request().Cookie("theme")
+		isthemeExists := themeCookieErr == nil
+		themeRaw := ""
+		if isthemeExists {
+			themeRaw = themeRawCookie.Value
+		}
+		if isthemeExists {
+			theme := themeRaw
+			themeRawPtr = &theme
+		}
+		var visitsRawPtr *int = nil
+		visitsRawCookie, visitsCookieErr := ctx.Request().Cookie("visits")
+		isvisitsExists := visitsCookieErr == nil
+		visitsRaw := ""
+		if isvisitsExists {
+			visitsRaw = visitsRawCookie.Value
+		}
+		if isvisitsExists {
+			visitsUint64, conversionErr := strconv.Atoi(visitsRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'CookieParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"visits",
+						"int",
+						reflect.TypeOf(visitsRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/CookieParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			}
+			visits := int(visitsUint64)
+			visitsRawPtr = &visits
+		}
+		if validatorErr := validatorInstance.Var(visitsRawPtr, "gte=0,required"); validatorErr != nil {
+			fieldName := "visits"
+			validationError := wrapValidatorError(validatorErr, "CookieParams", fieldName)
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.CookieParams(*sessionRawPtr, themeRawPtr, *visitsRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Response().Header().Set(key, value)
+		}
+		ctx.Response().Header().Set("x-inject", "true")
+		ctx.Response().Header().Set("x-extended", "CookieParams")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'CookieParams'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/CookieParams",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.JSON(statusCode, stdError)
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
+	})
+	engine.POST(toEchoUrl("/e2e/content-negotiation"), func(ctx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "ContentNegotiation")
+		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'ContentNegotiation' cannot produce a response in any of the accepted media types",
+				"ContentNegotiation",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var conversionErr error
+		var infoRawPtr *Param147info.DefaultsInfo = nil
+		requestContentType, isRequestContentTypeSupported := getRequestContentType(ctx.Request().Header.Get("Content-Type"), []string{"application/json"})
+		if !isRequestContentTypeSupported {
+			return handleContentNegotiationError(ctx,
+				http.StatusUnsupportedMediaType,
+				fmt.Sprintf("Operation 'ContentNegotiation' does not accept request bodies of type '%s'", ctx.Request().Header.Get("Content-Type")),
+				"ContentNegotiation",
+			)
+		}
+		conversionErr = bindAndValidateBody(ctx, requestContentType, "required", "{\"limit\":20,\"name\":\"guest\",\"notify\":true,\"paging\":{\"page\":1,\"size\":50},\"status\":\"shipped\"}", &infoRawPtr)
+		if conversionErr != nil {
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: fmt.Sprintf(
+					"A request was made to operation 'ContentNegotiation' but body parameter '%s' did not pass validation of '%s' - %s",
+					"info",
+					"DefaultsInfo",
+					extractValidationErrorMessage(conversionErr, nil),
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/gleece/validation/error/ContentNegotiation",
+			}
+			// json body validation error response extension placeholder
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.ContentNegotiation(*infoRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Response().Header().Set(key, value)
+		}
+		ctx.Response().Header().Set("x-inject", "true")
+		ctx.Response().Header().Set("x-extended", "ContentNegotiation")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'ContentNegotiation'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/ContentNegotiation",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.JSON(statusCode, stdError)
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
+	})
+	engine.POST(toEchoUrl("/e2e/xml-serialization"), func(ctx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "XmlSerialization")
+		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json", "application/xml"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'XmlSerialization' cannot produce a response in any of the accepted media types",
+				"XmlSerialization",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var conversionErr error
+		var bookRawPtr *Param150book.XmlBook = nil
+		requestContentType, isRequestContentTypeSupported := getRequestContentType(ctx.Request().Header.Get("Content-Type"), []string{"application/xml", "application/json"})
+		if !isRequestContentTypeSupported {
+			return handleContentNegotiationError(ctx,
+				http.StatusUnsupportedMediaType,
+				fmt.Sprintf("Operation 'XmlSerialization' does not accept request bodies of type '%s'", ctx.Request().Header.Get("Content-Type")),
+				"XmlSerialization",
+			)
+		}
+		conversionErr = bindAndValidateBody(ctx, requestContentType, "required", "", &bookRawPtr)
+		if conversionErr != nil {
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: fmt.Sprintf(
+					"A request was made to operation 'XmlSerialization' but body parameter '%s' did not pass validation of '%s' - %s",
+					"book",
+					"XmlBook",
+					extractValidationErrorMessage(conversionErr, nil),
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/gleece/validation/error/XmlSerialization",
+			}
+			// json body validation error response extension placeholder
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.XmlSerialization(*bookRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Response().Header().Set(key, value)
+		}
+		ctx.Response().Header().Set("x-inject", "true")
+		ctx.Response().Header().Set("x-extended", "XmlSerialization")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'XmlSerialization'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/XmlSerialization",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.JSON(statusCode, stdError)
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		if responseContentType == "application/xml" {
+			// xml response extension placeholder
+			return ctx.XML(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
+	})
+	engine.GET(toEchoUrl("/e2e/plain-text"), func(ctx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "PlainText")
+		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json", "text/plain"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'PlainText' cannot produce a response in any of the accepted media types",
+				"PlainText",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var nameRawPtr *string = nil
+		nameRaw := ctx.QueryParam("name")
+		isnameExists := ctx.Request().URL.Query().Has("name")
+		if isnameExists {
+			name := nameRaw
+			nameRawPtr = &name
+		}
+		if validatorErr := validatorInstance.Var(nameRawPtr, "required"); validatorErr != nil {
+			fieldName := "name"
+			validationError := wrapValidatorError(validatorErr, "PlainText", fieldName)
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.PlainText(*nameRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Response().Header().Set(key, value)
+		}
+		ctx.Response().Header().Set("x-inject", "true")
+		ctx.Response().Header().Set("x-extended", "PlainText")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PlainText'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/PlainText",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.JSON(statusCode, stdError)
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		if responseContentType == "text/plain" {
+			// text response extension placeholder
+			return ctx.Blob(statusCode, "text/plain; charset=utf-8", []byte(fmt.Sprint(value)))
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
+	})
+	engine.POST(toEchoUrl("/e2e/form-files"), func(ctx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "FormFiles")
+		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'FormFiles' cannot produce a response in any of the accepted media types",
+				"FormFiles",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		ctx.Request().ParseMultipartForm(multipartFormMaxMemory)
+		var titleRawPtr *string = nil
+		titleRawArr, istitleExists := ctx.Request().PostForm["title"]
+		titleRaw := ""
+		if istitleExists {
+			titleRaw = titleRawArr[0] // Get first value since form values are slices
+		}
+		if istitleExists {
+			title := titleRaw
+			titleRawPtr = &title
+		}
+		if validatorErr := validatorInstance.Var(titleRawPtr, "required"); validatorErr != nil {
+			fieldName := "title"
+			validationError := wrapValidatorError(validatorErr, "FormFiles", fieldName)
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		var avatarRawPtr *Param157avatar.FileHeader = nil
+		avatarFiles := getFormFiles(ctx, "avatar")
+		if fileErr := validateFormFiles(avatarFiles, false, 16, []string{"image/*"}); fileErr != nil {
+			validationError := wrapValidatorError(fileErr, "FormFiles", "avatar")
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		if len(avatarFiles) > 0 {
+			avatarRawPtr = avatarFiles[0]
+		}
+		if validatorErr := validatorInstance.Var(avatarRawPtr, "required"); validatorErr != nil {
+			fieldName := "avatar"
+			validationError := wrapValidatorError(validatorErr, "FormFiles", fieldName)
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		var attachmentsRawPtr *[]*Param158attachmentsItem.FileHeader = nil
+		attachmentsFiles := getFormFiles(ctx, "attachment")
+		if fileErr := validateFormFiles(attachmentsFiles, true, 0, []string{"text/plain", "application/pdf"}); fileErr != nil {
+			validationError := wrapValidatorError(fileErr, "FormFiles", "attachments")
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		attachmentsRawPtr = &attachmentsFiles
+		if validatorErr := validatorInstance.Var(attachmentsRawPtr, "required"); validatorErr != nil {
+			fieldName := "attachments"
+			validationError := wrapValidatorError(validatorErr, "FormFiles", fieldName)
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.FormFiles(*titleRawPtr, avatarRawPtr, *attachmentsRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Response().Header().Set(key, value)
+		}
+		ctx.Response().Header().Set("x-inject", "true")
+		ctx.Response().Header().Set("x-extended", "FormFiles")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'FormFiles'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/FormFiles",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.JSON(statusCode, stdError)
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
+	})
+	engine.GET(toEchoUrl("/e2e/stream-report"), func(ctx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "StreamReport")
+		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"text/csv"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'StreamReport' cannot produce a response in any of the accepted media types",
+				"StreamReport",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var nameRawPtr *string = nil
+		nameRaw := ctx.QueryParam("name")
+		isnameExists := ctx.Request().URL.Query().Has("name")
+		if isnameExists {
+			name := nameRaw
+			nameRawPtr = &name
+		}
+		if validatorErr := validatorInstance.Var(nameRawPtr, "required"); validatorErr != nil {
+			fieldName := "name"
+			validationError := wrapValidatorError(validatorErr, "StreamReport", fieldName)
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.StreamReport(*nameRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Response().Header().Set(key, value)
+		}
+		ctx.Response().Header().Set("x-inject", "true")
+		ctx.Response().Header().Set("x-extended", "StreamReport")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'StreamReport'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/StreamReport",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.JSON(statusCode, stdError)
+		}
+		// stream response extension placeholder
+		// File responses are sent as their own media type, if given, rather than the negotiated one
+		if value.ContentType != "" {
+			responseContentType = value.ContentType
+		}
+		return writeStream(ctx.Response(), statusCode, responseContentType, value.FileName, value.Content)
+	})
+	engine.GET(toEchoUrl("/e2e/download-notes"), func(ctx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "DownloadNotes")
+		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/octet-stream"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'DownloadNotes' cannot produce a response in any of the accepted media types",
+				"DownloadNotes",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.DownloadNotes()
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Response().Header().Set(key, value)
+		}
+		ctx.Response().Header().Set("x-inject", "true")
+		ctx.Response().Header().Set("x-extended", "DownloadNotes")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'DownloadNotes'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/DownloadNotes",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.JSON(statusCode, stdError)
+		}
+		// stream response extension placeholder
+		// File responses are sent as their own media type, if given, rather than the negotiated one
+		if value.ContentType != "" {
+			responseContentType = value.ContentType
+		}
+		return writeStream(ctx.Response(), statusCode, responseContentType, value.FileName, value.Content)
+	})
+	engine.GET(toEchoUrl("/e2e/stream-bytes"), func(ctx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "StreamBytes")
+		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/octet-stream"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'StreamBytes' cannot produce a response in any of the accepted media types",
+				"StreamBytes",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.StreamBytes()
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Response().Header().Set(key, value)
+		}
+		ctx.Response().Header().Set("x-inject", "true")
+		ctx.Response().Header().Set("x-extended", "StreamBytes")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'StreamBytes'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/StreamBytes",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.JSON(statusCode, stdError)
+		}
+		// stream response extension placeholder
+		return writeStream(ctx.Response(), statusCode, responseContentType, "", value)
+	})
+	engine.GET(toEchoUrl("/e2e/event-stream"), func(ctx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "EventStream")
+		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"text/event-stream"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'EventStream' cannot produce a response in any of the accepted media types",
+				"EventStream",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var stepsRawPtr *int = nil
+		stepsRaw := ctx.QueryParam("steps")
+		isstepsExists := ctx.Request().URL.Query().Has("steps")
+		if isstepsExists {
+			stepsUint64, conversionErr := strconv.Atoi(stepsRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'EventStream' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"steps",
+						"int",
+						reflect.TypeOf(stepsRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/EventStream",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			}
+			steps := int(stepsUint64)
+			stepsRawPtr = &steps
+		}
+		if validatorErr := validatorInstance.Var(stepsRawPtr, "required"); validatorErr != nil {
+			fieldName := "steps"
+			validationError := wrapValidatorError(validatorErr, "EventStream", fieldName)
+			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.EventStream(*stepsRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Response().Header().Set(key, value)
+		}
+		ctx.Response().Header().Set("x-inject", "true")
+		ctx.Response().Header().Set("x-extended", "EventStream")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'EventStream'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/EventStream",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.JSON(statusCode, stdError)
+		}
+		// event stream response extension placeholder
+		writeEventStream(ctx.Response(), ctx.Request().Context(), statusCode, value)
+		return nil
+	})
+	engine.GET(toEchoUrl("/e2e/event-stream-error"), func(ctx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "EventStreamError")
+		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"text/event-stream"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'EventStreamError' cannot produce a response in any of the accepted media types",
+				"EventStreamError",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.EventStreamError()
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Response().Header().Set(key, value)
+		}
+		ctx.Response().Header().Set("x-inject", "true")
+		ctx.Response().Header().Set("x-extended", "EventStreamError")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'EventStreamError'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/EventStreamError",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.JSON(statusCode, stdError)
+		}
+		// event stream response extension placeholder
+		writeEventStream(ctx.Response(), ctx.Request().Context(), statusCode, value)
+		return nil
+	})
+	// E2EClassSecController
+	engine.GET(toEchoUrl("/e2e/with-default-class-security"), func(ctx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"class",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "WithDefaultClassSecurity")
+		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'WithDefaultClassSecurity' cannot produce a response in any of the accepted media types",
+				"WithDefaultClassSecurity",
+			)
+		}
+		controller := E2EClassSecControllerImport.E2EClassSecController{}
+		controller.InitController(ctx)
+		var headerParamRawPtr *string = nil
+		headerParamRaw := ctx.Request().Header.Get("x-test-scopes")
+		_, isheaderParamExists := ctx.Request().Header["x-test-scopes"]
+		if !isheaderParamExists {
+			// In echo, the ctx.Request().Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := ctx.Request().Header.Values("x-test-scopes")
+			isheaderParamExists = len(headerValues) > 0
+		}
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			fieldName := "headerParam"
 			validationError := wrapValidatorError(validatorErr, "WithDefaultClassSecurity", fieldName)
 			return ctx.JSON(http.StatusUnprocessableEntity, validationError)
 		}
@@ -2997,8 +6789,12 @@ func RegisterRoutes(engine *echo.Echo) {
 			// json error response extension placeholder
 			return ctx.JSON(statusCode, stdError)
 		}
-		// json response extension placeholder
-		return ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.GET(toEchoUrl("/e2e/with-default-override-class-security"), func(ctx echo.Context) error {
 		// route start routes extension placeholder
@@ -3021,6 +6817,14 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "WithOverrideClassSecurity")
 		}
+		responseContentType := negotiateContentType(ctx.Request().Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'WithOverrideClassSecurity' cannot produce a response in any of the accepted media types",
+				"WithOverrideClassSecurity",
+			)
+		}
 		controller := E2EClassSecControllerImport.E2EClassSecController{}
 		controller.InitController(ctx)
 		var headerParamRawPtr *string = nil
@@ -3083,7 +6887,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			// json error response extension placeholder
 			return ctx.JSON(statusCode, stdError)
 		}
-		// json response extension placeholder
-		return ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.JSON(statusCode, value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 }
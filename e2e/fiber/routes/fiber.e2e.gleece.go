@@ -5,7 +5,7 @@ It includes routes and handlers by the Gleece API Routes Generator.
 --
 Authors: Haim Kastner & Yuval Pomerchik
 Generated by: Gleece Routes Generator
-Generated Date: 2025-02-25
+Generated Date: 2026-10-18
 Target Engine: Fiber v2 (https://github.com/gofiber/fiber)
 --
 Usage:
@@ -16,11 +16,22 @@ Repository: https://github.com/gopher-fleece/gleece
 */
 package routes
 import (
+	"bufio"
+	"context"
+	"encoding/base64"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
 	"net/http"
+	"reflect"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
 	E2EControllerImport "github.com/gopher-fleece/gleece/e2e/assets"
@@ -31,6 +42,39 @@ import (
 	Param41theBody "github.com/gopher-fleece/gleece/e2e/assets"
 	Response62CustomError "github.com/gopher-fleece/gleece/e2e/assets"
 	Response65CustomError "github.com/gopher-fleece/gleece/e2e/assets"
+	Param80status "github.com/gopher-fleece/gleece/e2e/assets"
+	Param81priority "github.com/gopher-fleece/gleece/e2e/assets"
+	Param82headerStatus "github.com/gopher-fleece/gleece/e2e/assets"
+	Param85id "github.com/google/uuid"
+	Param86at "time"
+	Param87timeout "time"
+	Param89raw "encoding/json"
+	Param90address "net"
+	Param91link "net/url"
+	Param94amount "github.com/gopher-fleece/gleece/e2e/assets/domain"
+	Param95tip "github.com/gopher-fleece/gleece/e2e/assets/domain"
+	ParamParser94amount "github.com/gopher-fleece/gleece/e2e/assets/domain"
+	ParamParser95tip "github.com/gopher-fleece/gleece/e2e/assets/domain"
+	Param98envelopeArg0 "github.com/gopher-fleece/gleece/e2e/assets"
+	Param98envelope "github.com/gopher-fleece/gleece/e2e/assets/domain"
+	Param101envelopeArg0Value "github.com/gopher-fleece/gleece/e2e/assets"
+	Param101envelope "github.com/gopher-fleece/gleece/e2e/assets/domain"
+	Param101envelopeArg0Key "github.com/gopher-fleece/gleece/e2e/assets/domain"
+	Param107statusesItem "github.com/gopher-fleece/gleece/e2e/assets"
+	Param108keysItem "github.com/google/uuid"
+	Param112filter "github.com/gopher-fleece/gleece/e2e/assets"
+	Param116filterStatus "github.com/gopher-fleece/gleece/e2e/assets"
+	Param120pet "github.com/gopher-fleece/gleece/e2e/assets"
+	Param126customerId "github.com/gopher-fleece/gleece/e2e/assets"
+	Param127amount "github.com/gopher-fleece/gleece/e2e/assets"
+	Param128splitsItem "github.com/gopher-fleece/gleece/e2e/assets"
+	Param129currency "github.com/gopher-fleece/gleece/e2e/assets"
+	Param132info "github.com/gopher-fleece/gleece/e2e/assets"
+	Param137status "github.com/gopher-fleece/gleece/e2e/assets"
+	Param147info "github.com/gopher-fleece/gleece/e2e/assets"
+	Param150book "github.com/gopher-fleece/gleece/e2e/assets"
+	Param157avatar "mime/multipart"
+	Param158attachmentsItem "mime/multipart"
 	E2EClassSecControllerImport "github.com/gopher-fleece/gleece/e2e/assets"
 	// import extension placeholder
 )
@@ -75,7 +119,7 @@ func getStatusCode(controller runtime.Controller, hasReturnValue bool, err error
 	}
 	return http.StatusNoContent
 }
-func bindAndValidateBody[TOutput any](ctx *fiber.Ctx, contentType string, validation string, output **TOutput) error {
+func bindAndValidateBody[TOutput any](ctx *fiber.Ctx, contentType string, validation string, defaults string, output **TOutput) error {
 	var err error
 	bodyBytes := ctx.Body()
 	if len(bodyBytes) == 0 {
@@ -85,9 +129,17 @@ func bindAndValidateBody[TOutput any](ctx *fiber.Ctx, contentType string, valida
 		return nil
 	}
 	var deserializedOutput TOutput
+	// Fields absent from the body retain their default values
+	if defaults != "" {
+		if err = json.Unmarshal([]byte(defaults), &deserializedOutput); err != nil {
+			return err
+		}
+	}
 	switch contentType {
 	case "application/json":
 		err = json.Unmarshal(bodyBytes, &deserializedOutput)
+	case "application/xml":
+		err = xml.Unmarshal(bodyBytes, &deserializedOutput)
 	default:
 		return fmt.Errorf("content-type %s is not currently supported by the validation subsystem", contentType)
 	}
@@ -153,6 +205,78 @@ func handleAuthorizationError(ctx *fiber.Ctx, authErr *runtime.SecurityError, op
 	}
 	return ctx.Status(statusCode).JSON(stdError)
 }
+func handleContentNegotiationError(ctx *fiber.Ctx, statusCode int, detail string, operationId string) error {
+	stdError := runtime.Rfc7807Error{
+		Type:     http.StatusText(statusCode),
+		Detail:   detail,
+		Status:   statusCode,
+		Instance: "/gleece/negotiation/error/" + operationId,
+	}
+	return ctx.Status(statusCode).JSON(stdError)
+}
+// negotiateContentType returns the media type a response should be sent in, per the request's 'Accept' header.
+// The media type the client prefers is chosen among those the operation produces, with ties broken by the operation's order.
+// Returns an empty string if the client accepts none of them
+func negotiateContentType(acceptHeader string, produces []string) string {
+	if strings.TrimSpace(acceptHeader) == "" {
+		return produces[0]
+	}
+	bestContentType := ""
+	bestQuality := 0.0
+	for _, contentType := range produces {
+		if quality := getAcceptedQuality(acceptHeader, contentType); quality > bestQuality {
+			bestContentType = contentType
+			bestQuality = quality
+		}
+	}
+	return bestContentType
+}
+// getAcceptedQuality returns the quality an 'Accept' header assigns to the given media type,
+// per the most specific of the header's media ranges matching it. Returns 0 if no media range matches
+func getAcceptedQuality(acceptHeader string, contentType string) float64 {
+	mainType, _, _ := strings.Cut(contentType, "/")
+	quality := 0.0
+	bestSpecificity := -1
+	for _, mediaRange := range strings.Split(acceptHeader, ",") {
+		rangeType, params, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+		if err != nil {
+			continue
+		}
+		specificity := -1
+		switch rangeType {
+		case contentType:
+			specificity = 2
+		case mainType + "/*":
+			specificity = 1
+		case "*/*":
+			specificity = 0
+		}
+		if specificity <= bestSpecificity {
+			continue
+		}
+		bestSpecificity = specificity
+		quality = 1.0
+		if rawQuality, hasQuality := params["q"]; hasQuality {
+			if parsedQuality, err := strconv.ParseFloat(rawQuality, 64); err == nil {
+				quality = parsedQuality
+			}
+		}
+	}
+	return quality
+}
+// getRequestContentType returns the media type of a request's body, per its 'Content-Type' header.
+// Requests without a 'Content-Type' header are assumed to be sent in the first media type the operation consumes.
+// Returns false if the body's media type is not one the operation consumes
+func getRequestContentType(contentTypeHeader string, consumes []string) (string, bool) {
+	if strings.TrimSpace(contentTypeHeader) == "" {
+		return consumes[0], true
+	}
+	contentType, _, err := mime.ParseMediaType(contentTypeHeader)
+	if err != nil || !slices.Contains(consumes, contentType) {
+		return "", false
+	}
+	return contentType, true
+}
 func wrapValidatorError(validatorErr error, operationId string, fieldName string) runtime.Rfc7807Error {
 	return runtime.Rfc7807Error{
 		Type: http.StatusText(http.StatusUnprocessableEntity),
@@ -166,6 +290,194 @@ func wrapValidatorError(validatorErr error, operationId string, fieldName string
 		Instance: fmt.Sprintf("/gleece/validation/error/%s", operationId),
 	}
 }
+// wrapStructValidatorError wraps the validation errors of a struct-typed parameter, naming each of the struct's failed fields
+func wrapStructValidatorError(validatorErr error, operationId string, paramName string) runtime.Rfc7807Error {
+	return runtime.Rfc7807Error{
+		Type: http.StatusText(http.StatusUnprocessableEntity),
+		Detail: fmt.Sprintf(
+			"A request was made to operation '%s' but parameter '%s' did not pass validation - %s",
+			operationId,
+			paramName,
+			extractValidationErrorMessage(validatorErr, nil),
+		),
+		Status:   http.StatusUnprocessableEntity,
+		Instance: fmt.Sprintf("/gleece/validation/error/%s", operationId),
+	}
+}
+// bindAndValidatePolymorphicBody binds a body to a polymorphic interface.
+// The body is unmarshalled into the struct identified by the value of its discriminator property, which is then validated
+func bindAndValidatePolymorphicBody[TOutput any](
+	ctx *fiber.Ctx,
+	contentType string,
+	validation string,
+	discriminator string,
+	unmarshallers map[string]func([]byte) (TOutput, error),
+	output **TOutput,
+) error {
+	bodyBytes := ctx.Body()
+	if len(bodyBytes) == 0 {
+		if strings.Contains(validation, "required") {
+			return fmt.Errorf("body is required but was not provided")
+		}
+		return nil
+	}
+	if contentType != "application/json" {
+		return fmt.Errorf("content-type %s is not currently supported by the validation subsystem", contentType)
+	}
+	var properties map[string]json.RawMessage
+	if err := json.Unmarshal(bodyBytes, &properties); err != nil {
+		return err
+	}
+	var discriminatorValue string
+	if err := json.Unmarshal(properties[discriminator], &discriminatorValue); err != nil {
+		return fmt.Errorf("body is missing string discriminator property '%s'", discriminator)
+	}
+	unmarshal, isKnown := unmarshallers[discriminatorValue]
+	if !isKnown {
+		return fmt.Errorf("'%s' is not a valid value for discriminator property '%s'", discriminatorValue, discriminator)
+	}
+	deserializedOutput, err := unmarshal(bodyBytes)
+	if err != nil {
+		return err
+	}
+	if err = validatorInstance.Struct(deserializedOutput); err != nil {
+		return err
+	}
+	*output = &deserializedOutput
+	return nil
+}
+// unmarshalOneOfMember returns a function unmarshalling a body into the polymorphic interface member TMember.
+// Members implementing the interface via a pointer are returned by address
+func unmarshalOneOfMember[TMember any, TOutput any](isByAddress bool) func([]byte) (TOutput, error) {
+	return func(bodyBytes []byte) (TOutput, error) {
+		var member TMember
+		var output TOutput
+		if err := json.Unmarshal(bodyBytes, &member); err != nil {
+			return output, err
+		}
+		if isByAddress {
+			return any(&member).(TOutput), nil
+		}
+		return any(member).(TOutput), nil
+	}
+}
+// splitParamValues returns the individual values of an array parameter.
+// An empty separator indicates each value was sent separately (i.e., 'ids=1&ids=2').
+// Otherwise, values are delimited by the separator (i.e., 'ids=1,2') and empty raw values yield no items
+func splitParamValues(rawValues []string, separator string) []string {
+	if separator == "" {
+		return rawValues
+	}
+	values := []string{}
+	for _, rawValue := range rawValues {
+		if rawValue == "" {
+			continue
+		}
+		for _, value := range strings.Split(rawValue, separator) {
+			values = append(values, strings.TrimSpace(value))
+		}
+	}
+	return values
+}
+// getFormFiles returns the files uploaded under the given name in a multipart form, if any
+func getFormFiles(ctx *fiber.Ctx, name string) []*multipart.FileHeader {
+	form, err := ctx.MultipartForm()
+	if err != nil {
+		return nil
+	}
+	return form.File[name]
+}
+// validateFormFiles verifies the files uploaded for a form file parameter.
+// Parameters bound to a single file may not receive several, and each file must not exceed the parameter's maximum size
+// (if non-zero) and must be of one of its allowed MIME types (if any)
+func validateFormFiles(files []*multipart.FileHeader, allowsMultiple bool, maxSize int64, allowedMimeTypes []string) error {
+	if !allowsMultiple && len(files) > 1 {
+		return fmt.Errorf("expected a single file but got %d", len(files))
+	}
+	for _, file := range files {
+		if maxSize > 0 && file.Size > maxSize {
+			return fmt.Errorf("file '%s' is %d bytes long which exceeds the maximum size of %d bytes", file.Filename, file.Size, maxSize)
+		}
+		fileMimeType := file.Header.Get("Content-Type")
+		if len(allowedMimeTypes) > 0 && !isMimeTypeAllowed(fileMimeType, allowedMimeTypes) {
+			return fmt.Errorf("file '%s' is of type '%s' which is not one of the allowed types %v", file.Filename, fileMimeType, allowedMimeTypes)
+		}
+	}
+	return nil
+}
+// isMimeTypeAllowed returns whether a MIME type matches any of the allowed ones, which may use a wildcard subtype, e.g. 'image/*'
+func isMimeTypeAllowed(mimeType string, allowedMimeTypes []string) bool {
+	mediaType, _, err := mime.ParseMediaType(mimeType)
+	if err != nil {
+		return false
+	}
+	for _, allowed := range allowedMimeTypes {
+		if allowed == mediaType {
+			return true
+		}
+		if mainType, isWildcard := strings.CutSuffix(allowed, "/*"); isWildcard && strings.HasPrefix(mediaType, mainType+"/") {
+			return true
+		}
+	}
+	return false
+}
+// The longest an event stream is left idle. fasthttp only notices a client has disconnected once writing to it fails,
+// so idle streams are written a comment every so often
+const eventStreamKeepAliveInterval = 15 * time.Second
+// writeEventStream writes each value received from a channel as a server-sent event carrying its JSON representation,
+// flushing it to the client right away. Writing stops once the channel is closed, a value cannot be serialized,
+// the request's context is done (i.e., the server shuts down) or the client disconnects, i.e., flushing fails.
+// A value that cannot be serialized is reported as an 'error' event.
+//
+// Values sent after writing stops early are discarded so their sender is not blocked forever.
+// Senders should nonetheless stop sending, and close the channel, once the request's context is done
+func writeEventStream[TValue any](w *bufio.Writer, requestCtx context.Context, events <-chan TValue) {
+	if events == nil {
+		return
+	}
+	keepAlive := time.NewTicker(eventStreamKeepAliveInterval)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case <-requestCtx.Done():
+			go discardEvents(events)
+			return
+		case <-keepAlive.C:
+			fmt.Fprint(w, ": keep-alive\n\n")
+			if err := w.Flush(); err != nil {
+				go discardEvents(events)
+				return
+			}
+		case event, isOpen := <-events:
+			if !isOpen {
+				return
+			}
+			data, err := json.Marshal(event)
+			if err != nil {
+				writeEventStreamError(w, err)
+				w.Flush()
+				go discardEvents(events)
+				return
+			}
+			fmt.Fprintf(w, "data: %s\n\n", data)
+			if err := w.Flush(); err != nil {
+				go discardEvents(events)
+				return
+			}
+			keepAlive.Reset(eventStreamKeepAliveInterval)
+		}
+	}
+}
+// writeEventStreamError writes a server-sent 'error' event describing why an event stream has ended early
+func writeEventStreamError(w io.Writer, err error) {
+	data, _ := json.Marshal(map[string]string{"error": err.Error()})
+	fmt.Fprintf(w, "event: error\ndata: %s\n\n", data)
+}
+// discardEvents receives and discards the values of an event stream that's no longer written, until the channel is closed
+func discardEvents[TValue any](events <-chan TValue) {
+	for range events {
+	}
+}
 // function declarations extension placeholder
 type MiddlewareFunc func(ctx *fiber.Ctx) bool
 type ErrorMiddlewareFunc func(ctx *fiber.Ctx, err error) bool
@@ -214,6 +526,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "SimpleGet")
 		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'SimpleGet' cannot produce a response in any of the accepted media types",
+				"SimpleGet",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -259,8 +579,12 @@ func RegisterRoutes(engine *fiber.App) {
 			// json error response extension placeholder
 			return ctx.Status(statusCode).JSON(stdError)
 		}
-		// json response extension placeholder
-		return ctx.Status(statusCode).JSON(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.Get(toFiberUrl("/e2e/simple-get-empty-string"), func(ctx *fiber.Ctx) error {
 		// route start routes extension placeholder
@@ -283,6 +607,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "SimpleGetEmptyString")
 		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'SimpleGetEmptyString' cannot produce a response in any of the accepted media types",
+				"SimpleGetEmptyString",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -328,8 +660,12 @@ func RegisterRoutes(engine *fiber.App) {
 			// json error response extension placeholder
 			return ctx.Status(statusCode).JSON(stdError)
 		}
-		// json response extension placeholder
-		return ctx.Status(statusCode).JSON(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.Get(toFiberUrl("/e2e/simple-get-ptr-string"), func(ctx *fiber.Ctx) error {
 		// route start routes extension placeholder
@@ -352,6 +688,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "SimpleGetPtrString")
 		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'SimpleGetPtrString' cannot produce a response in any of the accepted media types",
+				"SimpleGetPtrString",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -397,8 +741,12 @@ func RegisterRoutes(engine *fiber.App) {
 			// json error response extension placeholder
 			return ctx.Status(statusCode).JSON(stdError)
 		}
-		// json response extension placeholder
-		return ctx.Status(statusCode).JSON(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.Get(toFiberUrl("/e2e/simple-get-null-string"), func(ctx *fiber.Ctx) error {
 		// route start routes extension placeholder
@@ -421,6 +769,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "SimpleGetNullString")
 		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'SimpleGetNullString' cannot produce a response in any of the accepted media types",
+				"SimpleGetNullString",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -466,8 +822,12 @@ func RegisterRoutes(engine *fiber.App) {
 			// json error response extension placeholder
 			return ctx.Status(statusCode).JSON(stdError)
 		}
-		// json response extension placeholder
-		return ctx.Status(statusCode).JSON(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.Get(toFiberUrl("/e2e/simple-get-object"), func(ctx *fiber.Ctx) error {
 		// route start routes extension placeholder
@@ -490,6 +850,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "SimpleGetObject")
 		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'SimpleGetObject' cannot produce a response in any of the accepted media types",
+				"SimpleGetObject",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -535,8 +903,12 @@ func RegisterRoutes(engine *fiber.App) {
 			// json error response extension placeholder
 			return ctx.Status(statusCode).JSON(stdError)
 		}
-		// json response extension placeholder
-		return ctx.Status(statusCode).JSON(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.Get(toFiberUrl("/e2e/simple-get-object-ptr"), func(ctx *fiber.Ctx) error {
 		// route start routes extension placeholder
@@ -559,6 +931,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "SimpleGetObjectPtr")
 		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'SimpleGetObjectPtr' cannot produce a response in any of the accepted media types",
+				"SimpleGetObjectPtr",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -604,8 +984,12 @@ func RegisterRoutes(engine *fiber.App) {
 			// json error response extension placeholder
 			return ctx.Status(statusCode).JSON(stdError)
 		}
-		// json response extension placeholder
-		return ctx.Status(statusCode).JSON(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.Get(toFiberUrl("/e2e/simple-get-object-null"), func(ctx *fiber.Ctx) error {
 		// route start routes extension placeholder
@@ -628,6 +1012,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "SimpleGetObjectNull")
 		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'SimpleGetObjectNull' cannot produce a response in any of the accepted media types",
+				"SimpleGetObjectNull",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -673,8 +1065,12 @@ func RegisterRoutes(engine *fiber.App) {
 			// json error response extension placeholder
 			return ctx.Status(statusCode).JSON(stdError)
 		}
-		// json response extension placeholder
-		return ctx.Status(statusCode).JSON(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.Get(toFiberUrl("/e2e/simple-get-empty"), func(ctx *fiber.Ctx) error {
 		// route start routes extension placeholder
@@ -697,6 +1093,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "SimpleGetEmpty")
 		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'SimpleGetEmpty' cannot produce a response in any of the accepted media types",
+				"SimpleGetEmpty",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var queryParamRawPtr *string = nil
@@ -754,9 +1158,13 @@ func RegisterRoutes(engine *fiber.App) {
 			// json error response extension placeholder
 			return ctx.Status(statusCode).JSON(stdError)
 		}
-		// json response extension placeholder
-		ctx.Set("Content-Type", "application/json")
-		ctx.Status(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.Set("Content-Type", "application/json")
+			ctx.Status(statusCode)
+			return nil
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
 		return nil
 	})
 	engine.Get(toFiberUrl("/e2e/get-with-all-params/{pathParam}"), func(ctx *fiber.Ctx) error {
@@ -780,6 +1188,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "GetWithAllParams")
 		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'GetWithAllParams' cannot produce a response in any of the accepted media types",
+				"GetWithAllParams",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var queryParamRawPtr *string = nil
@@ -861,8 +1277,12 @@ func RegisterRoutes(engine *fiber.App) {
 			// json error response extension placeholder
 			return ctx.Status(statusCode).JSON(stdError)
 		}
-		// json response extension placeholder
-		return ctx.Status(statusCode).JSON(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.Get(toFiberUrl("/e2e/get-with-all-params-ptr/{pathParam}"), func(ctx *fiber.Ctx) error {
 		// route start routes extension placeholder
@@ -885,6 +1305,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "GetWithAllParamsPtr")
 		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'GetWithAllParamsPtr' cannot produce a response in any of the accepted media types",
+				"GetWithAllParamsPtr",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var queryParamRawPtr *string = nil
@@ -956,8 +1384,12 @@ func RegisterRoutes(engine *fiber.App) {
 			// json error response extension placeholder
 			return ctx.Status(statusCode).JSON(stdError)
 		}
-		// json response extension placeholder
-		return ctx.Status(statusCode).JSON(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.Get(toFiberUrl("/e2e/get-with-all-params-required-ptr/{pathParam}"), func(ctx *fiber.Ctx) error {
 		// route start routes extension placeholder
@@ -980,6 +1412,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "GetWithAllParamsRequiredPtr")
 		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'GetWithAllParamsRequiredPtr' cannot produce a response in any of the accepted media types",
+				"GetWithAllParamsRequiredPtr",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var queryParamRawPtr *string = nil
@@ -1061,8 +1501,12 @@ func RegisterRoutes(engine *fiber.App) {
 			// json error response extension placeholder
 			return ctx.Status(statusCode).JSON(stdError)
 		}
-		// json response extension placeholder
-		return ctx.Status(statusCode).JSON(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.Post(toFiberUrl("/e2e/post-with-all-params-body"), func(ctx *fiber.Ctx) error {
 		// route start routes extension placeholder
@@ -1085,6 +1529,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "PostWithAllParamsWithBody")
 		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'PostWithAllParamsWithBody' cannot produce a response in any of the accepted media types",
+				"PostWithAllParamsWithBody",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var conversionErr error
@@ -1113,7 +1565,15 @@ func RegisterRoutes(engine *fiber.App) {
 			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
 		}
 		var theBodyRawPtr *Param33theBody.BodyInfo = nil
-		conversionErr = bindAndValidateBody(ctx, "application/json", "required", &theBodyRawPtr)
+		requestContentType, isRequestContentTypeSupported := getRequestContentType(ctx.Get("Content-Type"), []string{"application/json"})
+		if !isRequestContentTypeSupported {
+			return handleContentNegotiationError(ctx,
+				http.StatusUnsupportedMediaType,
+				fmt.Sprintf("Operation 'PostWithAllParamsWithBody' does not accept request bodies of type '%s'", ctx.Get("Content-Type")),
+				"PostWithAllParamsWithBody",
+			)
+		}
+		conversionErr = bindAndValidateBody(ctx, requestContentType, "required", "", &theBodyRawPtr)
 		if conversionErr != nil {
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
@@ -1172,8 +1632,12 @@ func RegisterRoutes(engine *fiber.App) {
 			// json error response extension placeholder
 			return ctx.Status(statusCode).JSON(stdError)
 		}
-		// json response extension placeholder
-		return ctx.Status(statusCode).JSON(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.Post(toFiberUrl("/e2e/post-with-all-params-body-ptr"), func(ctx *fiber.Ctx) error {
 		// route start routes extension placeholder
@@ -1196,6 +1660,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "PostWithAllParamsWithBodyPtr")
 		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'PostWithAllParamsWithBodyPtr' cannot produce a response in any of the accepted media types",
+				"PostWithAllParamsWithBodyPtr",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var conversionErr error
@@ -1214,7 +1686,15 @@ func RegisterRoutes(engine *fiber.App) {
 			headerParamRawPtr = &headerParam
 		}
 		var theBodyRawPtr *Param38theBody.BodyInfo = nil
-		conversionErr = bindAndValidateBody(ctx, "application/json", "", &theBodyRawPtr)
+		requestContentType, isRequestContentTypeSupported := getRequestContentType(ctx.Get("Content-Type"), []string{"application/json"})
+		if !isRequestContentTypeSupported {
+			return handleContentNegotiationError(ctx,
+				http.StatusUnsupportedMediaType,
+				fmt.Sprintf("Operation 'PostWithAllParamsWithBodyPtr' does not accept request bodies of type '%s'", ctx.Get("Content-Type")),
+				"PostWithAllParamsWithBodyPtr",
+			)
+		}
+		conversionErr = bindAndValidateBody(ctx, requestContentType, "", "", &theBodyRawPtr)
 		if conversionErr != nil {
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
@@ -1273,8 +1753,12 @@ func RegisterRoutes(engine *fiber.App) {
 			// json error response extension placeholder
 			return ctx.Status(statusCode).JSON(stdError)
 		}
-		// json response extension placeholder
-		return ctx.Status(statusCode).JSON(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.Post(toFiberUrl("/e2e/post-with-all-params-body-required-ptr"), func(ctx *fiber.Ctx) error {
 		// route start routes extension placeholder
@@ -1297,11 +1781,27 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "PostWithAllParamsWithBodyRequiredPtr")
 		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'PostWithAllParamsWithBodyRequiredPtr' cannot produce a response in any of the accepted media types",
+				"PostWithAllParamsWithBodyRequiredPtr",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var conversionErr error
 		var theBodyRawPtr *Param41theBody.BodyInfo = nil
-		conversionErr = bindAndValidateBody(ctx, "application/json", "required", &theBodyRawPtr)
+		requestContentType, isRequestContentTypeSupported := getRequestContentType(ctx.Get("Content-Type"), []string{"application/json"})
+		if !isRequestContentTypeSupported {
+			return handleContentNegotiationError(ctx,
+				http.StatusUnsupportedMediaType,
+				fmt.Sprintf("Operation 'PostWithAllParamsWithBodyRequiredPtr' does not accept request bodies of type '%s'", ctx.Get("Content-Type")),
+				"PostWithAllParamsWithBodyRequiredPtr",
+			)
+		}
+		conversionErr = bindAndValidateBody(ctx, requestContentType, "required", "", &theBodyRawPtr)
 		if conversionErr != nil {
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
@@ -1360,8 +1860,12 @@ func RegisterRoutes(engine *fiber.App) {
 			// json error response extension placeholder
 			return ctx.Status(statusCode).JSON(stdError)
 		}
-		// json response extension placeholder
-		return ctx.Status(statusCode).JSON(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.Get(toFiberUrl("/e2e/get-header-start-with-letter"), func(ctx *fiber.Ctx) error {
 		// route start routes extension placeholder
@@ -1384,6 +1888,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "GetHeaderStartWithLetter")
 		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'GetHeaderStartWithLetter' cannot produce a response in any of the accepted media types",
+				"GetHeaderStartWithLetter",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var headerParamRawPtr *string = nil
@@ -1441,8 +1953,12 @@ func RegisterRoutes(engine *fiber.App) {
 			// json error response extension placeholder
 			return ctx.Status(statusCode).JSON(stdError)
 		}
-		// json response extension placeholder
-		return ctx.Status(statusCode).JSON(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.Get(toFiberUrl("/e2e/with-default-config-security"), func(ctx *fiber.Ctx) error {
 		// route start routes extension placeholder
@@ -1465,6 +1981,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "WithDefaultConfigSecurity")
 		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'WithDefaultConfigSecurity' cannot produce a response in any of the accepted media types",
+				"WithDefaultConfigSecurity",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var headerParamRawPtr *string = nil
@@ -1522,8 +2046,12 @@ func RegisterRoutes(engine *fiber.App) {
 			// json error response extension placeholder
 			return ctx.Status(statusCode).JSON(stdError)
 		}
-		// json response extension placeholder
-		return ctx.Status(statusCode).JSON(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.Get(toFiberUrl("/e2e/with-one-security"), func(ctx *fiber.Ctx) error {
 		// route start routes extension placeholder
@@ -1546,6 +2074,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "WithOneSecurity")
 		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'WithOneSecurity' cannot produce a response in any of the accepted media types",
+				"WithOneSecurity",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var headerParamRawPtr *string = nil
@@ -1603,8 +2139,12 @@ func RegisterRoutes(engine *fiber.App) {
 			// json error response extension placeholder
 			return ctx.Status(statusCode).JSON(stdError)
 		}
-		// json response extension placeholder
-		return ctx.Status(statusCode).JSON(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.Get(toFiberUrl("/e2e/with-two-security"), func(ctx *fiber.Ctx) error {
 		// route start routes extension placeholder
@@ -1639,6 +2179,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "WithTwoSecurity")
 		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'WithTwoSecurity' cannot produce a response in any of the accepted media types",
+				"WithTwoSecurity",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var headerParamRawPtr *string = nil
@@ -1696,8 +2244,12 @@ func RegisterRoutes(engine *fiber.App) {
 			// json error response extension placeholder
 			return ctx.Status(statusCode).JSON(stdError)
 		}
-		// json response extension placeholder
-		return ctx.Status(statusCode).JSON(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.Get(toFiberUrl("/e2e/with-two-security-same-method"), func(ctx *fiber.Ctx) error {
 		// route start routes extension placeholder
@@ -1732,6 +2284,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "WithTwoSecuritySameMethod")
 		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'WithTwoSecuritySameMethod' cannot produce a response in any of the accepted media types",
+				"WithTwoSecuritySameMethod",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var headerParamRawPtr *string = nil
@@ -1789,8 +2349,12 @@ func RegisterRoutes(engine *fiber.App) {
 			// json error response extension placeholder
 			return ctx.Status(statusCode).JSON(stdError)
 		}
-		// json response extension placeholder
-		return ctx.Status(statusCode).JSON(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.Get(toFiberUrl("/e2e/default-error"), func(ctx *fiber.Ctx) error {
 		// route start routes extension placeholder
@@ -1813,6 +2377,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "DefaultError")
 		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'DefaultError' cannot produce a response in any of the accepted media types",
+				"DefaultError",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -1858,9 +2430,13 @@ func RegisterRoutes(engine *fiber.App) {
 			// json error response extension placeholder
 			return ctx.Status(statusCode).JSON(stdError)
 		}
-		// json response extension placeholder
-		ctx.Set("Content-Type", "application/json")
-		ctx.Status(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.Set("Content-Type", "application/json")
+			ctx.Status(statusCode)
+			return nil
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
 		return nil
 	})
 	engine.Get(toFiberUrl("/e2e/default-error-with-payload"), func(ctx *fiber.Ctx) error {
@@ -1884,6 +2460,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "DefaultErrorWithPayload")
 		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'DefaultErrorWithPayload' cannot produce a response in any of the accepted media types",
+				"DefaultErrorWithPayload",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -1929,8 +2513,12 @@ func RegisterRoutes(engine *fiber.App) {
 			// json error response extension placeholder
 			return ctx.Status(statusCode).JSON(stdError)
 		}
-		// json response extension placeholder
-		return ctx.Status(statusCode).JSON(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.Get(toFiberUrl("/e2e/custom-error"), func(ctx *fiber.Ctx) error {
 		// route start routes extension placeholder
@@ -1953,6 +2541,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "CustomError")
 		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'CustomError' cannot produce a response in any of the accepted media types",
+				"CustomError",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -1991,9 +2587,13 @@ func RegisterRoutes(engine *fiber.App) {
 			// End middlewares onErrorMiddlewares section
 			return ctx.Status(statusCode).JSON(opError)
 		}
-		// json response extension placeholder
-		ctx.Set("Content-Type", "application/json")
-		ctx.Status(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.Set("Content-Type", "application/json")
+			ctx.Status(statusCode)
+			return nil
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
 		return nil
 	})
 	engine.Get(toFiberUrl("/e2e/custom-error-ptr"), func(ctx *fiber.Ctx) error {
@@ -2017,6 +2617,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "CustomPtrError")
 		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'CustomPtrError' cannot produce a response in any of the accepted media types",
+				"CustomPtrError",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -2054,9 +2662,13 @@ func RegisterRoutes(engine *fiber.App) {
 			// End middlewares onErrorMiddlewares section
 			return ctx.Status(statusCode).JSON(opError)
 		}
-		// json response extension placeholder
-		ctx.Set("Content-Type", "application/json")
-		ctx.Status(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.Set("Content-Type", "application/json")
+			ctx.Status(statusCode)
+			return nil
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
 		return nil
 	})
 	engine.Get(toFiberUrl("/e2e/503-error-code"), func(ctx *fiber.Ctx) error {
@@ -2080,6 +2692,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "Error503")
 		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'Error503' cannot produce a response in any of the accepted media types",
+				"Error503",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -2125,9 +2745,13 @@ func RegisterRoutes(engine *fiber.App) {
 			// json error response extension placeholder
 			return ctx.Status(statusCode).JSON(stdError)
 		}
-		// json response extension placeholder
-		ctx.Set("Content-Type", "application/json")
-		ctx.Status(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.Set("Content-Type", "application/json")
+			ctx.Status(statusCode)
+			return nil
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
 		return nil
 	})
 	engine.Get(toFiberUrl("/e2e/custom-error-503"), func(ctx *fiber.Ctx) error {
@@ -2151,6 +2775,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "CustomError503")
 		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'CustomError503' cannot produce a response in any of the accepted media types",
+				"CustomError503",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -2189,9 +2821,13 @@ func RegisterRoutes(engine *fiber.App) {
 			// End middlewares onErrorMiddlewares section
 			return ctx.Status(statusCode).JSON(opError)
 		}
-		// json response extension placeholder
-		ctx.Set("Content-Type", "application/json")
-		ctx.Status(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.Set("Content-Type", "application/json")
+			ctx.Status(statusCode)
+			return nil
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
 		return nil
 	})
 	engine.Get(toFiberUrl("/e2e/context-access"), func(ctx *fiber.Ctx) error {
@@ -2215,6 +2851,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "ContextAccess")
 		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'ContextAccess' cannot produce a response in any of the accepted media types",
+				"ContextAccess",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -2260,9 +2904,13 @@ func RegisterRoutes(engine *fiber.App) {
 			// json error response extension placeholder
 			return ctx.Status(statusCode).JSON(stdError)
 		}
-		// json response extension placeholder
-		ctx.Set("Content-Type", "application/json")
-		ctx.Status(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.Set("Content-Type", "application/json")
+			ctx.Status(statusCode)
+			return nil
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
 		return nil
 	})
 	engine.Get(toFiberUrl("/e2e/http-method"), func(ctx *fiber.Ctx) error {
@@ -2286,6 +2934,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "Get")
 		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'Get' cannot produce a response in any of the accepted media types",
+				"Get",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -2331,9 +2987,13 @@ func RegisterRoutes(engine *fiber.App) {
 			// json error response extension placeholder
 			return ctx.Status(statusCode).JSON(stdError)
 		}
-		// json response extension placeholder
-		ctx.Set("Content-Type", "application/json")
-		ctx.Status(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.Set("Content-Type", "application/json")
+			ctx.Status(statusCode)
+			return nil
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
 		return nil
 	})
 	engine.Post(toFiberUrl("/e2e/http-method"), func(ctx *fiber.Ctx) error {
@@ -2357,6 +3017,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "Post")
 		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'Post' cannot produce a response in any of the accepted media types",
+				"Post",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -2402,9 +3070,13 @@ func RegisterRoutes(engine *fiber.App) {
 			// json error response extension placeholder
 			return ctx.Status(statusCode).JSON(stdError)
 		}
-		// json response extension placeholder
-		ctx.Set("Content-Type", "application/json")
-		ctx.Status(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.Set("Content-Type", "application/json")
+			ctx.Status(statusCode)
+			return nil
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
 		return nil
 	})
 	engine.Put(toFiberUrl("/e2e/http-method"), func(ctx *fiber.Ctx) error {
@@ -2428,6 +3100,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "Put")
 		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'Put' cannot produce a response in any of the accepted media types",
+				"Put",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -2473,9 +3153,13 @@ func RegisterRoutes(engine *fiber.App) {
 			// json error response extension placeholder
 			return ctx.Status(statusCode).JSON(stdError)
 		}
-		// json response extension placeholder
-		ctx.Set("Content-Type", "application/json")
-		ctx.Status(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.Set("Content-Type", "application/json")
+			ctx.Status(statusCode)
+			return nil
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
 		return nil
 	})
 	engine.Delete(toFiberUrl("/e2e/http-method"), func(ctx *fiber.Ctx) error {
@@ -2499,6 +3183,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "Delete")
 		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'Delete' cannot produce a response in any of the accepted media types",
+				"Delete",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -2544,9 +3236,13 @@ func RegisterRoutes(engine *fiber.App) {
 			// json error response extension placeholder
 			return ctx.Status(statusCode).JSON(stdError)
 		}
-		// json response extension placeholder
-		ctx.Set("Content-Type", "application/json")
-		ctx.Status(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.Set("Content-Type", "application/json")
+			ctx.Status(statusCode)
+			return nil
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
 		return nil
 	})
 	engine.Patch(toFiberUrl("/e2e/http-method"), func(ctx *fiber.Ctx) error {
@@ -2570,6 +3266,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "Patch")
 		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'Patch' cannot produce a response in any of the accepted media types",
+				"Patch",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -2615,9 +3319,13 @@ func RegisterRoutes(engine *fiber.App) {
 			// json error response extension placeholder
 			return ctx.Status(statusCode).JSON(stdError)
 		}
-		// json response extension placeholder
-		ctx.Set("Content-Type", "application/json")
-		ctx.Status(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.Set("Content-Type", "application/json")
+			ctx.Status(statusCode)
+			return nil
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
 		return nil
 	})
 	engine.Get(toFiberUrl("/e2e/template-context-1"), func(ctx *fiber.Ctx) error {
@@ -2641,6 +3349,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "TemplateContext1")
 		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'TemplateContext1' cannot produce a response in any of the accepted media types",
+				"TemplateContext1",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -2687,8 +3403,12 @@ func RegisterRoutes(engine *fiber.App) {
 			// json error response extension placeholder
 			return ctx.Status(statusCode).JSON(stdError)
 		}
-		// json response extension placeholder
-		return ctx.Status(statusCode).JSON(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.Get(toFiberUrl("/e2e/template-context-2"), func(ctx *fiber.Ctx) error {
 		// route start routes extension placeholder
@@ -2711,6 +3431,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "TemplateContext2")
 		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'TemplateContext2' cannot produce a response in any of the accepted media types",
+				"TemplateContext2",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -2758,8 +3486,12 @@ func RegisterRoutes(engine *fiber.App) {
 			// json error response extension placeholder
 			return ctx.Status(statusCode).JSON(stdError)
 		}
-		// json response extension placeholder
-		return ctx.Status(statusCode).JSON(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.Post(toFiberUrl("/e2e/form"), func(ctx *fiber.Ctx) error {
 		// route start routes extension placeholder
@@ -2782,6 +3514,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "TestForm")
 		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'TestForm' cannot produce a response in any of the accepted media types",
+				"TestForm",
+			)
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var item1RawPtr *string = nil
@@ -2851,11 +3591,14 @@ func RegisterRoutes(engine *fiber.App) {
 			// json error response extension placeholder
 			return ctx.Status(statusCode).JSON(stdError)
 		}
-		// json response extension placeholder
-		return ctx.Status(statusCode).JSON(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
-	// E2EClassSecController
-	engine.Get(toFiberUrl("/e2e/with-default-class-security"), func(ctx *fiber.Ctx) error {
+	engine.Get(toFiberUrl("/e2e/enum-params/{status}"), func(ctx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ctx,
@@ -2864,9 +3607,9 @@ func RegisterRoutes(engine *fiber.App) {
 					Relation: SecurityListRelationAnd,
 					Checks: []runtime.SecurityCheck{
 						{
-							SchemaName: "securitySchemaName",
+							SchemaName: "securitySchemaName2",
 							Scopes: []string{
-								"class",
+								"config",
 							},
 						},
 					},
@@ -2874,23 +3617,3099 @@ func RegisterRoutes(engine *fiber.App) {
 			},
 		)
 		if authErr != nil {
-			return handleAuthorizationError(ctx, authErr, "WithDefaultClassSecurity")
+			return handleAuthorizationError(ctx, authErr, "EnumParams")
+		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'EnumParams' cannot produce a response in any of the accepted media types",
+				"EnumParams",
+			)
 		}
-		controller := E2EClassSecControllerImport.E2EClassSecController{}
+		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
-		var headerParamRawPtr *string = nil
-		headerParamRaw := ctx.Get("x-test-scopes")
-		isheaderParamExists := len(ctx.Request().Header.Peek("x-test-scopes")) > 0
-		if isheaderParamExists {
-			headerParam := headerParamRaw
-			headerParamRawPtr = &headerParam
+		var statusRawPtr *Param80status.OrderStatus = nil
+		statusRaw := ctx.Params("status")
+		isstatusExists := true // if parameter is in route but not provided, it won't reach this handler
+		if isstatusExists {
+			status := statusRaw
+			statusEnum := Param80status.OrderStatus(status)
+			if !slices.Contains([]Param80status.OrderStatus{"pending", "shipped", "delivered"}, statusEnum) {
+				conversionErr := fmt.Errorf("'%s' is not a valid OrderStatus value", statusRaw)
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'EnumParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"status",
+						"OrderStatus",
+						reflect.TypeOf(statusRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/EnumParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			}
+			statusRawPtr = &statusEnum
 		}
-		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
-			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithDefaultClassSecurity", fieldName)
+		if validatorErr := validatorInstance.Var(statusRawPtr, "required"); validatorErr != nil {
+			fieldName := "status"
+			validationError := wrapValidatorError(validatorErr, "EnumParams", fieldName)
 			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
 		}
-		// Middlewares beforeOperationMiddlewares section
+		var priorityRawPtr *Param81priority.OrderPriority = nil
+		priorityRaw := ctx.Query("priority")
+		ispriorityExists := ctx.Context().QueryArgs().Has("priority")
+		if ispriorityExists {
+			priorityUint64, conversionErr := strconv.Atoi(priorityRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'EnumParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"priority",
+						"OrderPriority",
+						reflect.TypeOf(priorityRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/EnumParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			}
+			priority := int(priorityUint64)
+			priorityEnum := Param81priority.OrderPriority(priority)
+			if !slices.Contains([]Param81priority.OrderPriority{0, 1, 2}, priorityEnum) {
+				conversionErr := fmt.Errorf("'%s' is not a valid OrderPriority value", priorityRaw)
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'EnumParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"priority",
+						"OrderPriority",
+						reflect.TypeOf(priorityRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/EnumParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			}
+			priorityRawPtr = &priorityEnum
+		}
+		if validatorErr := validatorInstance.Var(priorityRawPtr, "required"); validatorErr != nil {
+			fieldName := "priority"
+			validationError := wrapValidatorError(validatorErr, "EnumParams", fieldName)
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		var headerStatusRawPtr *Param82headerStatus.OrderStatus = nil
+		headerStatusRaw := ctx.Get("x-status")
+		isheaderStatusExists := len(ctx.Request().Header.Peek("x-status")) > 0
+		if isheaderStatusExists {
+			headerStatus := headerStatusRaw
+			headerStatusEnum := Param82headerStatus.OrderStatus(headerStatus)
+			if !slices.Contains([]Param82headerStatus.OrderStatus{"pending", "shipped", "delivered"}, headerStatusEnum) {
+				conversionErr := fmt.Errorf("'%s' is not a valid OrderStatus value", headerStatusRaw)
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'EnumParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"headerStatus",
+						"OrderStatus",
+						reflect.TypeOf(headerStatusRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/EnumParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			}
+			headerStatusRawPtr = &headerStatusEnum
+		}
+		if validatorErr := validatorInstance.Var(headerStatusRawPtr, "required"); validatorErr != nil {
+			fieldName := "headerStatus"
+			validationError := wrapValidatorError(validatorErr, "EnumParams", fieldName)
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.EnumParams(*statusRawPtr, *priorityRawPtr, *headerStatusRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Set(key, value)
+		}
+		ctx.Set("x-inject", "true")
+		ctx.Set("x-extended", "EnumParams")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'EnumParams'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/EnumParams",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.Status(statusCode).JSON(stdError)
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
+	})
+	engine.Get(toFiberUrl("/e2e/well-known-params/{id}"), func(ctx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "WellKnownParams")
+		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'WellKnownParams' cannot produce a response in any of the accepted media types",
+				"WellKnownParams",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var idRawPtr *Param85id.UUID = nil
+		idRaw := ctx.Params("id")
+		isidExists := true // if parameter is in route but not provided, it won't reach this handler
+		if isidExists {
+			id, conversionErr := Param85id.Parse(idRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'WellKnownParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"id",
+						"UUID",
+						reflect.TypeOf(idRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/WellKnownParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			}
+			idRawPtr = &id
+		}
+		if validatorErr := validatorInstance.Var(idRawPtr, "required"); validatorErr != nil {
+			fieldName := "id"
+			validationError := wrapValidatorError(validatorErr, "WellKnownParams", fieldName)
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		var atRawPtr *Param86at.Time = nil
+		atRaw := ctx.Query("at")
+		isatExists := ctx.Context().QueryArgs().Has("at")
+		if isatExists {
+			at, conversionErr := Param86at.Parse(Param86at.RFC3339, atRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'WellKnownParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"at",
+						"Time",
+						reflect.TypeOf(atRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/WellKnownParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			}
+			atRawPtr = &at
+		}
+		if validatorErr := validatorInstance.Var(atRawPtr, "required"); validatorErr != nil {
+			fieldName := "at"
+			validationError := wrapValidatorError(validatorErr, "WellKnownParams", fieldName)
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		var timeoutRawPtr *Param87timeout.Duration = nil
+		timeoutRaw := ctx.Query("timeout")
+		istimeoutExists := ctx.Context().QueryArgs().Has("timeout")
+		if istimeoutExists {
+			timeoutInt64, conversionErr := strconv.ParseInt(timeoutRaw, 10, 64)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'WellKnownParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"timeout",
+						"Duration",
+						reflect.TypeOf(timeoutRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/WellKnownParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			}
+			timeout := Param87timeout.Duration(timeoutInt64)
+			timeoutRawPtr = &timeout
+		}
+		if validatorErr := validatorInstance.Var(timeoutRawPtr, "required"); validatorErr != nil {
+			fieldName := "timeout"
+			validationError := wrapValidatorError(validatorErr, "WellKnownParams", fieldName)
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		var dataRawPtr *[]byte = nil
+		dataRaw := ctx.Query("data")
+		isdataExists := ctx.Context().QueryArgs().Has("data")
+		if isdataExists {
+			data, conversionErr := base64.StdEncoding.DecodeString(dataRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'WellKnownParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"data",
+						"[]byte",
+						reflect.TypeOf(dataRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/WellKnownParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			}
+			dataRawPtr = &data
+		}
+		if validatorErr := validatorInstance.Var(dataRawPtr, "required"); validatorErr != nil {
+			fieldName := "data"
+			validationError := wrapValidatorError(validatorErr, "WellKnownParams", fieldName)
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		var rawRawPtr *Param89raw.RawMessage = nil
+		rawRaw := ctx.Query("raw")
+		israwExists := ctx.Context().QueryArgs().Has("raw")
+		if israwExists {
+			raw := Param89raw.RawMessage(rawRaw)
+			if !Param89raw.Valid(raw) {
+				conversionErr := fmt.Errorf("'%s' is not valid JSON", rawRaw)
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'WellKnownParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"raw",
+						"RawMessage",
+						reflect.TypeOf(rawRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/WellKnownParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			}
+			rawRawPtr = &raw
+		}
+		if validatorErr := validatorInstance.Var(rawRawPtr, "required"); validatorErr != nil {
+			fieldName := "raw"
+			validationError := wrapValidatorError(validatorErr, "WellKnownParams", fieldName)
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		var addressRawPtr *Param90address.IP = nil
+		addressRaw := ctx.Get("x-address")
+		isaddressExists := len(ctx.Request().Header.Peek("x-address")) > 0
+		if isaddressExists {
+			address := Param90address.ParseIP(addressRaw)
+			if address == nil {
+				conversionErr := fmt.Errorf("'%s' is not a valid IP address", addressRaw)
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'WellKnownParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"address",
+						"IP",
+						reflect.TypeOf(addressRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/WellKnownParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			}
+			addressRawPtr = &address
+		}
+		if validatorErr := validatorInstance.Var(addressRawPtr, "required"); validatorErr != nil {
+			fieldName := "address"
+			validationError := wrapValidatorError(validatorErr, "WellKnownParams", fieldName)
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		var linkRawPtr *Param91link.URL = nil
+		linkRaw := ctx.Query("link")
+		islinkExists := ctx.Context().QueryArgs().Has("link")
+		if islinkExists {
+			linkUrl, conversionErr := Param91link.ParseRequestURI(linkRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'WellKnownParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"link",
+						"URL",
+						reflect.TypeOf(linkRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/WellKnownParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			}
+			link := *linkUrl
+			linkRawPtr = &link
+		}
+		if validatorErr := validatorInstance.Var(linkRawPtr, "required"); validatorErr != nil {
+			fieldName := "link"
+			validationError := wrapValidatorError(validatorErr, "WellKnownParams", fieldName)
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.WellKnownParams(*idRawPtr, *atRawPtr, *timeoutRawPtr, *dataRawPtr, *rawRawPtr, *addressRawPtr, *linkRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Set(key, value)
+		}
+		ctx.Set("x-inject", "true")
+		ctx.Set("x-extended", "WellKnownParams")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WellKnownParams'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/WellKnownParams",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.Status(statusCode).JSON(stdError)
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
+	})
+	engine.Get(toFiberUrl("/e2e/mapped-params/{amount}"), func(ctx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "MappedParams")
+		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'MappedParams' cannot produce a response in any of the accepted media types",
+				"MappedParams",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var amountRawPtr *Param94amount.Amount = nil
+		amountRaw := ctx.Params("amount")
+		isamountExists := true // if parameter is in route but not provided, it won't reach this handler
+		if isamountExists {
+			amount, conversionErr := ParamParser94amount.ParseAmount(amountRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'MappedParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"amount",
+						"Amount",
+						reflect.TypeOf(amountRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/MappedParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			}
+			amountRawPtr = &amount
+		}
+		if validatorErr := validatorInstance.Var(amountRawPtr, "required"); validatorErr != nil {
+			fieldName := "amount"
+			validationError := wrapValidatorError(validatorErr, "MappedParams", fieldName)
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		var tipRawPtr *Param95tip.Amount = nil
+		tipRaw := ctx.Query("tip")
+		istipExists := ctx.Context().QueryArgs().Has("tip")
+		if istipExists {
+			tip, conversionErr := ParamParser95tip.ParseAmount(tipRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'MappedParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"tip",
+						"Amount",
+						reflect.TypeOf(tipRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/MappedParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			}
+			tipRawPtr = &tip
+		}
+		if validatorErr := validatorInstance.Var(tipRawPtr, "required"); validatorErr != nil {
+			fieldName := "tip"
+			validationError := wrapValidatorError(validatorErr, "MappedParams", fieldName)
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.MappedParams(*amountRawPtr, *tipRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Set(key, value)
+		}
+		ctx.Set("x-inject", "true")
+		ctx.Set("x-extended", "MappedParams")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'MappedParams'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/MappedParams",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.Status(statusCode).JSON(stdError)
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
+	})
+	engine.Post(toFiberUrl("/e2e/generic-envelope"), func(ctx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "GenericEnvelope")
+		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'GenericEnvelope' cannot produce a response in any of the accepted media types",
+				"GenericEnvelope",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var conversionErr error
+		var envelopeRawPtr *Param98envelope.Envelope[Param98envelopeArg0.BodyInfo] = nil
+		requestContentType, isRequestContentTypeSupported := getRequestContentType(ctx.Get("Content-Type"), []string{"application/json"})
+		if !isRequestContentTypeSupported {
+			return handleContentNegotiationError(ctx,
+				http.StatusUnsupportedMediaType,
+				fmt.Sprintf("Operation 'GenericEnvelope' does not accept request bodies of type '%s'", ctx.Get("Content-Type")),
+				"GenericEnvelope",
+			)
+		}
+		conversionErr = bindAndValidateBody(ctx, requestContentType, "required", "", &envelopeRawPtr)
+		if conversionErr != nil {
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: fmt.Sprintf(
+					"A request was made to operation 'GenericEnvelope' but body parameter '%s' did not pass validation of '%s' - %s",
+					"envelope",
+					"Envelope",
+					extractValidationErrorMessage(conversionErr, nil),
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/gleece/validation/error/GenericEnvelope",
+			}
+			// json body validation error response extension placeholder
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.GenericEnvelope(*envelopeRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Set(key, value)
+		}
+		ctx.Set("x-inject", "true")
+		ctx.Set("x-extended", "GenericEnvelope")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'GenericEnvelope'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/GenericEnvelope",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.Status(statusCode).JSON(stdError)
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
+	})
+	engine.Post(toFiberUrl("/e2e/generic-envelope-map"), func(ctx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "GenericEnvelopeMap")
+		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'GenericEnvelopeMap' cannot produce a response in any of the accepted media types",
+				"GenericEnvelopeMap",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var conversionErr error
+		var envelopeRawPtr *Param101envelope.Envelope[map[Param101envelopeArg0Key.Region]Param101envelopeArg0Value.BodyInfo] = nil
+		requestContentType, isRequestContentTypeSupported := getRequestContentType(ctx.Get("Content-Type"), []string{"application/json"})
+		if !isRequestContentTypeSupported {
+			return handleContentNegotiationError(ctx,
+				http.StatusUnsupportedMediaType,
+				fmt.Sprintf("Operation 'GenericEnvelopeMap' does not accept request bodies of type '%s'", ctx.Get("Content-Type")),
+				"GenericEnvelopeMap",
+			)
+		}
+		conversionErr = bindAndValidateBody(ctx, requestContentType, "required", "", &envelopeRawPtr)
+		if conversionErr != nil {
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: fmt.Sprintf(
+					"A request was made to operation 'GenericEnvelopeMap' but body parameter '%s' did not pass validation of '%s' - %s",
+					"envelope",
+					"Envelope",
+					extractValidationErrorMessage(conversionErr, nil),
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/gleece/validation/error/GenericEnvelopeMap",
+			}
+			// json body validation error response extension placeholder
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.GenericEnvelopeMap(*envelopeRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Set(key, value)
+		}
+		ctx.Set("x-inject", "true")
+		ctx.Set("x-extended", "GenericEnvelopeMap")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'GenericEnvelopeMap'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/GenericEnvelopeMap",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.Status(statusCode).JSON(stdError)
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
+	})
+	engine.Get(toFiberUrl("/e2e/slice-params"), func(ctx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "SliceParams")
+		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'SliceParams' cannot produce a response in any of the accepted media types",
+				"SliceParams",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		idsRawValues := []string{}
+		for _, value := range ctx.Context().QueryArgs().PeekMulti("ids") {
+			idsRawValues = append(idsRawValues, string(value))
+		}
+		isidsExists := len(idsRawValues) > 0
+		var idsRawPtr *[]int = nil
+		if isidsExists {
+			ids := []int{}
+			for _, paramValue := range splitParamValues(idsRawValues, "") {
+				var idsItemRawPtr *int = nil
+				idsItemRaw := paramValue
+				isidsItemExists := true
+				if isidsItemExists {
+					idsItemUint64, conversionErr := strconv.Atoi(idsItemRaw)
+					if conversionErr != nil {
+						validationError := runtime.Rfc7807Error{
+							Type: http.StatusText(http.StatusUnprocessableEntity),
+							Detail: fmt.Sprintf(
+								"A request was made to operation 'SliceParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+								"idsItem",
+								"int",
+								reflect.TypeOf(idsItemRaw).String(),
+							),
+							Status:     http.StatusUnprocessableEntity,
+							Instance:   "/gleece/validation/error/SliceParams",
+							Extensions: map[string]string{"error": conversionErr.Error()},
+						}
+						// json validation error response extension placeholder
+						return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+					}
+					idsItem := int(idsItemUint64)
+					idsItemRawPtr = &idsItem
+				}
+				ids = append(ids, *idsItemRawPtr)
+			}
+			idsRawPtr = &ids
+		}
+		if validatorErr := validatorInstance.Var(idsRawPtr, "required"); validatorErr != nil {
+			fieldName := "ids"
+			validationError := wrapValidatorError(validatorErr, "SliceParams", fieldName)
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		tagsRawValues := []string{}
+		for _, value := range ctx.Context().QueryArgs().PeekMulti("tags") {
+			tagsRawValues = append(tagsRawValues, string(value))
+		}
+		istagsExists := len(tagsRawValues) > 0
+		var tagsRawPtr *[]string = nil
+		if istagsExists {
+			tags := []string{}
+			for _, paramValue := range splitParamValues(tagsRawValues, ",") {
+				var tagsItemRawPtr *string = nil
+				tagsItemRaw := paramValue
+				istagsItemExists := true
+				if istagsItemExists {
+					tagsItem := tagsItemRaw
+					tagsItemRawPtr = &tagsItem
+				}
+				tags = append(tags, *tagsItemRawPtr)
+			}
+			tagsRawPtr = &tags
+		}
+		if validatorErr := validatorInstance.Var(tagsRawPtr, "required"); validatorErr != nil {
+			fieldName := "tags"
+			validationError := wrapValidatorError(validatorErr, "SliceParams", fieldName)
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		namesRawValues := []string{}
+		for _, value := range ctx.Context().QueryArgs().PeekMulti("names") {
+			namesRawValues = append(namesRawValues, string(value))
+		}
+		isnamesExists := len(namesRawValues) > 0
+		var namesRawPtr *[]string = nil
+		if isnamesExists {
+			names := []string{}
+			for _, paramValue := range splitParamValues(namesRawValues, "|") {
+				var namesItemRawPtr *string = nil
+				namesItemRaw := paramValue
+				isnamesItemExists := true
+				if isnamesItemExists {
+					namesItem := namesItemRaw
+					namesItemRawPtr = &namesItem
+				}
+				names = append(names, *namesItemRawPtr)
+			}
+			namesRawPtr = &names
+		}
+		if validatorErr := validatorInstance.Var(namesRawPtr, "required"); validatorErr != nil {
+			fieldName := "names"
+			validationError := wrapValidatorError(validatorErr, "SliceParams", fieldName)
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		statusesRawValues := []string{}
+		for _, value := range ctx.Context().QueryArgs().PeekMulti("statuses") {
+			statusesRawValues = append(statusesRawValues, string(value))
+		}
+		isstatusesExists := len(statusesRawValues) > 0
+		var statusesRawPtr *[]Param107statusesItem.OrderStatus = nil
+		if isstatusesExists {
+			statuses := []Param107statusesItem.OrderStatus{}
+			for _, paramValue := range splitParamValues(statusesRawValues, "") {
+				var statusesItemRawPtr *Param107statusesItem.OrderStatus = nil
+				statusesItemRaw := paramValue
+				isstatusesItemExists := true
+				if isstatusesItemExists {
+					statusesItem := statusesItemRaw
+					statusesItemEnum := Param107statusesItem.OrderStatus(statusesItem)
+					if !slices.Contains([]Param107statusesItem.OrderStatus{"pending", "shipped", "delivered"}, statusesItemEnum) {
+						conversionErr := fmt.Errorf("'%s' is not a valid OrderStatus value", statusesItemRaw)
+						validationError := runtime.Rfc7807Error{
+							Type: http.StatusText(http.StatusUnprocessableEntity),
+							Detail: fmt.Sprintf(
+								"A request was made to operation 'SliceParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+								"statusesItem",
+								"OrderStatus",
+								reflect.TypeOf(statusesItemRaw).String(),
+							),
+							Status:     http.StatusUnprocessableEntity,
+							Instance:   "/gleece/validation/error/SliceParams",
+							Extensions: map[string]string{"error": conversionErr.Error()},
+						}
+						// json validation error response extension placeholder
+						return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+					}
+					statusesItemRawPtr = &statusesItemEnum
+				}
+				statuses = append(statuses, *statusesItemRawPtr)
+			}
+			statusesRawPtr = &statuses
+		}
+		if validatorErr := validatorInstance.Var(statusesRawPtr, "required"); validatorErr != nil {
+			fieldName := "statuses"
+			validationError := wrapValidatorError(validatorErr, "SliceParams", fieldName)
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		keysRawValues := []string{}
+		for _, value := range ctx.Context().QueryArgs().PeekMulti("keys") {
+			keysRawValues = append(keysRawValues, string(value))
+		}
+		iskeysExists := len(keysRawValues) > 0
+		var keysRawPtr *[]Param108keysItem.UUID = nil
+		if iskeysExists {
+			keys := []Param108keysItem.UUID{}
+			for _, paramValue := range splitParamValues(keysRawValues, "") {
+				var keysItemRawPtr *Param108keysItem.UUID = nil
+				keysItemRaw := paramValue
+				iskeysItemExists := true
+				if iskeysItemExists {
+					keysItem, conversionErr := Param108keysItem.Parse(keysItemRaw)
+					if conversionErr != nil {
+						validationError := runtime.Rfc7807Error{
+							Type: http.StatusText(http.StatusUnprocessableEntity),
+							Detail: fmt.Sprintf(
+								"A request was made to operation 'SliceParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+								"keysItem",
+								"UUID",
+								reflect.TypeOf(keysItemRaw).String(),
+							),
+							Status:     http.StatusUnprocessableEntity,
+							Instance:   "/gleece/validation/error/SliceParams",
+							Extensions: map[string]string{"error": conversionErr.Error()},
+						}
+						// json validation error response extension placeholder
+						return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+					}
+					keysItemRawPtr = &keysItem
+				}
+				keys = append(keys, *keysItemRawPtr)
+			}
+			keysRawPtr = &keys
+		}
+		if validatorErr := validatorInstance.Var(keysRawPtr, "max=2,required"); validatorErr != nil {
+			fieldName := "keys"
+			validationError := wrapValidatorError(validatorErr, "SliceParams", fieldName)
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		labelsRawValues := []string{}
+		for _, value := range ctx.Request().Header.PeekAll("x-labels") {
+			labelsRawValues = append(labelsRawValues, string(value))
+		}
+		islabelsExists := len(labelsRawValues) > 0
+		var labelsRawPtr *[]string = nil
+		if islabelsExists {
+			labels := []string{}
+			for _, paramValue := range splitParamValues(labelsRawValues, ",") {
+				var labelsItemRawPtr *string = nil
+				labelsItemRaw := paramValue
+				islabelsItemExists := true
+				if islabelsItemExists {
+					labelsItem := labelsItemRaw
+					labelsItemRawPtr = &labelsItem
+				}
+				labels = append(labels, *labelsItemRawPtr)
+			}
+			labelsRawPtr = &labels
+		}
+		if validatorErr := validatorInstance.Var(labelsRawPtr, "required"); validatorErr != nil {
+			fieldName := "labels"
+			validationError := wrapValidatorError(validatorErr, "SliceParams", fieldName)
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.SliceParams(*idsRawPtr, *tagsRawPtr, *namesRawPtr, *statusesRawPtr, *keysRawPtr, *labelsRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Set(key, value)
+		}
+		ctx.Set("x-inject", "true")
+		ctx.Set("x-extended", "SliceParams")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SliceParams'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/SliceParams",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.Status(statusCode).JSON(stdError)
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
+	})
+	engine.Get(toFiberUrl("/e2e/query-object"), func(ctx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "QueryObject")
+		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'QueryObject' cannot produce a response in any of the accepted media types",
+				"QueryObject",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var filterRawPtr = &Param112filter.ListFilter{}
+		var filterPageRawPtr *int = nil
+		filterPageRaw := ctx.Query("page")
+		isfilterPageExists := ctx.Context().QueryArgs().Has("page")
+		if isfilterPageExists {
+			filterPageUint64, conversionErr := strconv.Atoi(filterPageRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'QueryObject' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"filterPage",
+						"int",
+						reflect.TypeOf(filterPageRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/QueryObject",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			}
+			filterPage := int(filterPageUint64)
+			filterPageRawPtr = &filterPage
+		}
+		if filterPageRawPtr != nil {
+			filterRawPtr.Page = *filterPageRawPtr
+		}
+		var filterPageSizeRawPtr *int = nil
+		filterPageSizeRaw := ctx.Query("page_size")
+		isfilterPageSizeExists := ctx.Context().QueryArgs().Has("page_size")
+		if isfilterPageSizeExists {
+			filterPageSizeUint64, conversionErr := strconv.Atoi(filterPageSizeRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'QueryObject' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"filterPageSize",
+						"int",
+						reflect.TypeOf(filterPageSizeRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/QueryObject",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			}
+			filterPageSize := int(filterPageSizeUint64)
+			filterPageSizeRawPtr = &filterPageSize
+		}
+		if filterPageSizeRawPtr != nil {
+			filterRawPtr.PageSize = filterPageSizeRawPtr
+		}
+		filterTagsRawValues := []string{}
+		for _, value := range ctx.Context().QueryArgs().PeekMulti("tag") {
+			filterTagsRawValues = append(filterTagsRawValues, string(value))
+		}
+		isfilterTagsExists := len(filterTagsRawValues) > 0
+		var filterTagsRawPtr *[]string = nil
+		if isfilterTagsExists {
+			filterTags := []string{}
+			for _, paramValue := range splitParamValues(filterTagsRawValues, "") {
+				var filterTagsItemRawPtr *string = nil
+				filterTagsItemRaw := paramValue
+				isfilterTagsItemExists := true
+				if isfilterTagsItemExists {
+					filterTagsItem := filterTagsItemRaw
+					filterTagsItemRawPtr = &filterTagsItem
+				}
+				filterTags = append(filterTags, *filterTagsItemRawPtr)
+			}
+			filterTagsRawPtr = &filterTags
+		}
+		if filterTagsRawPtr != nil {
+			filterRawPtr.Tags = *filterTagsRawPtr
+		}
+		var filterStatusRawPtr *Param116filterStatus.OrderStatus = nil
+		filterStatusRaw := ctx.Query("status")
+		isfilterStatusExists := ctx.Context().QueryArgs().Has("status")
+		if isfilterStatusExists {
+			filterStatus := filterStatusRaw
+			filterStatusEnum := Param116filterStatus.OrderStatus(filterStatus)
+			if !slices.Contains([]Param116filterStatus.OrderStatus{"pending", "shipped", "delivered"}, filterStatusEnum) {
+				conversionErr := fmt.Errorf("'%s' is not a valid OrderStatus value", filterStatusRaw)
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'QueryObject' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"filterStatus",
+						"OrderStatus",
+						reflect.TypeOf(filterStatusRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/QueryObject",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			}
+			filterStatusRawPtr = &filterStatusEnum
+		}
+		if filterStatusRawPtr != nil {
+			filterRawPtr.Status = *filterStatusRawPtr
+		}
+		if validatorErr := validatorInstance.Struct(filterRawPtr); validatorErr != nil {
+			fieldName := "filter"
+			validationError := wrapStructValidatorError(validatorErr, "QueryObject", fieldName)
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		var otherRawPtr *string = nil
+		otherRaw := ctx.Query("other")
+		isotherExists := ctx.Context().QueryArgs().Has("other")
+		if isotherExists {
+			other := otherRaw
+			otherRawPtr = &other
+		}
+		if validatorErr := validatorInstance.Var(otherRawPtr, "required"); validatorErr != nil {
+			fieldName := "other"
+			validationError := wrapValidatorError(validatorErr, "QueryObject", fieldName)
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.QueryObject(*filterRawPtr, *otherRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Set(key, value)
+		}
+		ctx.Set("x-inject", "true")
+		ctx.Set("x-extended", "QueryObject")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'QueryObject'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/QueryObject",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.Status(statusCode).JSON(stdError)
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
+	})
+	engine.Post(toFiberUrl("/e2e/polymorphic-body"), func(ctx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "PolymorphicBody")
+		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'PolymorphicBody' cannot produce a response in any of the accepted media types",
+				"PolymorphicBody",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var conversionErr error
+		var petRawPtr *Param120pet.Pet = nil
+		requestContentType, isRequestContentTypeSupported := getRequestContentType(ctx.Get("Content-Type"), []string{"application/json"})
+		if !isRequestContentTypeSupported {
+			return handleContentNegotiationError(ctx,
+				http.StatusUnsupportedMediaType,
+				fmt.Sprintf("Operation 'PolymorphicBody' does not accept request bodies of type '%s'", ctx.Get("Content-Type")),
+				"PolymorphicBody",
+			)
+		}
+		conversionErr = bindAndValidatePolymorphicBody(
+			ctx,
+			requestContentType,
+			"required",
+			"kind",
+			map[string]func([]byte) (Param120pet.Pet, error){
+				"cat": unmarshalOneOfMember[Param120pet.CatInfo, Param120pet.Pet](false),
+				"dog": unmarshalOneOfMember[Param120pet.DogInfo, Param120pet.Pet](true),
+			},
+			&petRawPtr,
+		)
+		if conversionErr != nil {
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: fmt.Sprintf(
+					"A request was made to operation 'PolymorphicBody' but body parameter '%s' did not pass validation of '%s' - %s",
+					"pet",
+					"Pet",
+					extractValidationErrorMessage(conversionErr, nil),
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/gleece/validation/error/PolymorphicBody",
+			}
+			// json body validation error response extension placeholder
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.PolymorphicBody(*petRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Set(key, value)
+		}
+		ctx.Set("x-inject", "true")
+		ctx.Set("x-extended", "PolymorphicBody")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PolymorphicBody'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/PolymorphicBody",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.Status(statusCode).JSON(stdError)
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
+	})
+	engine.Get(toFiberUrl("/e2e/map-response"), func(ctx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "MapResponse")
+		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'MapResponse' cannot produce a response in any of the accepted media types",
+				"MapResponse",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		namesRawValues := []string{}
+		for _, value := range ctx.Context().QueryArgs().PeekMulti("names") {
+			namesRawValues = append(namesRawValues, string(value))
+		}
+		isnamesExists := len(namesRawValues) > 0
+		var namesRawPtr *[]string = nil
+		if isnamesExists {
+			names := []string{}
+			for _, paramValue := range splitParamValues(namesRawValues, "") {
+				var namesItemRawPtr *string = nil
+				namesItemRaw := paramValue
+				isnamesItemExists := true
+				if isnamesItemExists {
+					namesItem := namesItemRaw
+					namesItemRawPtr = &namesItem
+				}
+				names = append(names, *namesItemRawPtr)
+			}
+			namesRawPtr = &names
+		}
+		if validatorErr := validatorInstance.Var(namesRawPtr, "required"); validatorErr != nil {
+			fieldName := "names"
+			validationError := wrapValidatorError(validatorErr, "MapResponse", fieldName)
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.MapResponse(*namesRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Set(key, value)
+		}
+		ctx.Set("x-inject", "true")
+		ctx.Set("x-extended", "MapResponse")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'MapResponse'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/MapResponse",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.Status(statusCode).JSON(stdError)
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
+	})
+	engine.Get(toFiberUrl("/e2e/named-primitive-params/{customerId}"), func(ctx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "NamedPrimitiveParams")
+		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'NamedPrimitiveParams' cannot produce a response in any of the accepted media types",
+				"NamedPrimitiveParams",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var customerIdRawPtr *Param126customerId.CustomerID = nil
+		customerIdRaw := ctx.Params("customerId")
+		iscustomerIdExists := true // if parameter is in route but not provided, it won't reach this handler
+		if iscustomerIdExists {
+			customerId := customerIdRaw
+			customerIdNamed := Param126customerId.CustomerID(customerId)
+			customerIdRawPtr = &customerIdNamed
+		}
+		if validatorErr := validatorInstance.Var(customerIdRawPtr, "required"); validatorErr != nil {
+			fieldName := "customerId"
+			validationError := wrapValidatorError(validatorErr, "NamedPrimitiveParams", fieldName)
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		var amountRawPtr *Param127amount.Cents = nil
+		amountRaw := ctx.Query("amount")
+		isamountExists := ctx.Context().QueryArgs().Has("amount")
+		if isamountExists {
+			amountUint64, conversionErr := strconv.ParseInt(amountRaw, 10, 64)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'NamedPrimitiveParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"amount",
+						"Cents",
+						reflect.TypeOf(amountRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/NamedPrimitiveParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			}
+			amount := int64(amountUint64)
+			amountNamed := Param127amount.Cents(amount)
+			amountRawPtr = &amountNamed
+		}
+		if validatorErr := validatorInstance.Var(amountRawPtr, "required"); validatorErr != nil {
+			fieldName := "amount"
+			validationError := wrapValidatorError(validatorErr, "NamedPrimitiveParams", fieldName)
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		splitsRawValues := []string{}
+		for _, value := range ctx.Context().QueryArgs().PeekMulti("splits") {
+			splitsRawValues = append(splitsRawValues, string(value))
+		}
+		issplitsExists := len(splitsRawValues) > 0
+		var splitsRawPtr *[]Param128splitsItem.Cents = nil
+		if issplitsExists {
+			splits := []Param128splitsItem.Cents{}
+			for _, paramValue := range splitParamValues(splitsRawValues, "") {
+				var splitsItemRawPtr *Param128splitsItem.Cents = nil
+				splitsItemRaw := paramValue
+				issplitsItemExists := true
+				if issplitsItemExists {
+					splitsItemUint64, conversionErr := strconv.ParseInt(splitsItemRaw, 10, 64)
+					if conversionErr != nil {
+						validationError := runtime.Rfc7807Error{
+							Type: http.StatusText(http.StatusUnprocessableEntity),
+							Detail: fmt.Sprintf(
+								"A request was made to operation 'NamedPrimitiveParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+								"splitsItem",
+								"Cents",
+								reflect.TypeOf(splitsItemRaw).String(),
+							),
+							Status:     http.StatusUnprocessableEntity,
+							Instance:   "/gleece/validation/error/NamedPrimitiveParams",
+							Extensions: map[string]string{"error": conversionErr.Error()},
+						}
+						// json validation error response extension placeholder
+						return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+					}
+					splitsItem := int64(splitsItemUint64)
+					splitsItemNamed := Param128splitsItem.Cents(splitsItem)
+					splitsItemRawPtr = &splitsItemNamed
+				}
+				splits = append(splits, *splitsItemRawPtr)
+			}
+			splitsRawPtr = &splits
+		}
+		if validatorErr := validatorInstance.Var(splitsRawPtr, "required"); validatorErr != nil {
+			fieldName := "splits"
+			validationError := wrapValidatorError(validatorErr, "NamedPrimitiveParams", fieldName)
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		var currencyRawPtr *Param129currency.Currency = nil
+		currencyRaw := ctx.Get("x-currency")
+		iscurrencyExists := len(ctx.Request().Header.Peek("x-currency")) > 0
+		if iscurrencyExists {
+			currency := currencyRaw
+			currencyNamed := Param129currency.Currency(currency)
+			currencyRawPtr = &currencyNamed
+		}
+		if validatorErr := validatorInstance.Var(currencyRawPtr, "required"); validatorErr != nil {
+			fieldName := "currency"
+			validationError := wrapValidatorError(validatorErr, "NamedPrimitiveParams", fieldName)
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.NamedPrimitiveParams(*customerIdRawPtr, *amountRawPtr, *splitsRawPtr, *currencyRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Set(key, value)
+		}
+		ctx.Set("x-inject", "true")
+		ctx.Set("x-extended", "NamedPrimitiveParams")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'NamedPrimitiveParams'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/NamedPrimitiveParams",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.Status(statusCode).JSON(stdError)
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
+	})
+	engine.Post(toFiberUrl("/e2e/body-defaults"), func(ctx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "BodyDefaults")
+		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'BodyDefaults' cannot produce a response in any of the accepted media types",
+				"BodyDefaults",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var conversionErr error
+		var infoRawPtr *Param132info.DefaultsInfo = nil
+		requestContentType, isRequestContentTypeSupported := getRequestContentType(ctx.Get("Content-Type"), []string{"application/json"})
+		if !isRequestContentTypeSupported {
+			return handleContentNegotiationError(ctx,
+				http.StatusUnsupportedMediaType,
+				fmt.Sprintf("Operation 'BodyDefaults' does not accept request bodies of type '%s'", ctx.Get("Content-Type")),
+				"BodyDefaults",
+			)
+		}
+		conversionErr = bindAndValidateBody(ctx, requestContentType, "required", "{\"limit\":20,\"name\":\"guest\",\"notify\":true,\"paging\":{\"page\":1,\"size\":50},\"status\":\"shipped\"}", &infoRawPtr)
+		if conversionErr != nil {
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: fmt.Sprintf(
+					"A request was made to operation 'BodyDefaults' but body parameter '%s' did not pass validation of '%s' - %s",
+					"info",
+					"DefaultsInfo",
+					extractValidationErrorMessage(conversionErr, nil),
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/gleece/validation/error/BodyDefaults",
+			}
+			// json body validation error response extension placeholder
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.BodyDefaults(*infoRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Set(key, value)
+		}
+		ctx.Set("x-inject", "true")
+		ctx.Set("x-extended", "BodyDefaults")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'BodyDefaults'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/BodyDefaults",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.Status(statusCode).JSON(stdError)
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
+	})
+	engine.Post(toFiberUrl("/e2e/param-defaults"), func(ctx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "ParamDefaults")
+		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'ParamDefaults' cannot produce a response in any of the accepted media types",
+				"ParamDefaults",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var limitRawPtr *int = nil
+		limitRaw := ctx.Query("limit")
+		islimitExists := ctx.Context().QueryArgs().Has("limit")
+		if !islimitExists {
+			limitRaw = "20"
+			islimitExists = true
+		}
+		if islimitExists {
+			limitUint64, conversionErr := strconv.Atoi(limitRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'ParamDefaults' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"limit",
+						"int",
+						reflect.TypeOf(limitRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/ParamDefaults",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			}
+			limit := int(limitUint64)
+			limitRawPtr = &limit
+		}
+		if validatorErr := validatorInstance.Var(limitRawPtr, "required"); validatorErr != nil {
+			fieldName := "limit"
+			validationError := wrapValidatorError(validatorErr, "ParamDefaults", fieldName)
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		var notifyRawPtr *bool = nil
+		notifyRaw := ctx.Query("notify")
+		isnotifyExists := ctx.Context().QueryArgs().Has("notify")
+		if !isnotifyExists {
+			notifyRaw = "true"
+			isnotifyExists = true
+		}
+		if isnotifyExists {
+			notify, conversionErr := strconv.ParseBool(notifyRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'ParamDefaults' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"notify",
+						"bool",
+						reflect.TypeOf(notifyRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/ParamDefaults",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			}
+			notifyRawPtr = &notify
+		}
+		var statusRawPtr *Param137status.OrderStatus = nil
+		statusRaw := ctx.Query("status")
+		isstatusExists := ctx.Context().QueryArgs().Has("status")
+		if !isstatusExists {
+			statusRaw = "shipped"
+			isstatusExists = true
+		}
+		if isstatusExists {
+			status := statusRaw
+			statusEnum := Param137status.OrderStatus(status)
+			if !slices.Contains([]Param137status.OrderStatus{"pending", "shipped", "delivered"}, statusEnum) {
+				conversionErr := fmt.Errorf("'%s' is not a valid OrderStatus value", statusRaw)
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'ParamDefaults' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"status",
+						"OrderStatus",
+						reflect.TypeOf(statusRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/ParamDefaults",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			}
+			statusRawPtr = &statusEnum
+		}
+		if validatorErr := validatorInstance.Var(statusRawPtr, "required"); validatorErr != nil {
+			fieldName := "status"
+			validationError := wrapValidatorError(validatorErr, "ParamDefaults", fieldName)
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		var regionRawPtr *string = nil
+		regionRaw := ctx.Get("x-region")
+		isregionExists := len(ctx.Request().Header.Peek("x-region")) > 0
+		if !isregionExists {
+			regionRaw = "eu"
+			isregionExists = true
+		}
+		if isregionExists {
+			region := regionRaw
+			regionRawPtr = &region
+		}
+		if validatorErr := validatorInstance.Var(regionRawPtr, "required"); validatorErr != nil {
+			fieldName := "region"
+			validationError := wrapValidatorError(validatorErr, "ParamDefaults", fieldName)
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		var noteRawPtr *string = nil
+		noteRaw := ctx.FormValue("note")
+		isnoteExists := ctx.Context().PostArgs().Has("note")
+		if !isnoteExists {
+			noteRaw = "none"
+			isnoteExists = true
+		}
+		if isnoteExists {
+			note := noteRaw
+			noteRawPtr = &note
+		}
+		if validatorErr := validatorInstance.Var(noteRawPtr, "required"); validatorErr != nil {
+			fieldName := "note"
+			validationError := wrapValidatorError(validatorErr, "ParamDefaults", fieldName)
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.ParamDefaults(*limitRawPtr, notifyRawPtr, *statusRawPtr, *regionRawPtr, *noteRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Set(key, value)
+		}
+		ctx.Set("x-inject", "true")
+		ctx.Set("x-extended", "ParamDefaults")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'ParamDefaults'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/ParamDefaults",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.Status(statusCode).JSON(stdError)
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
+	})
+	engine.Get(toFiberUrl("/e2e/cookie-params"), func(ctx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "CookieParams")
+		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'CookieParams' cannot produce a response in any of the accepted media types",
+				"CookieParams",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var sessionRawPtr *string = nil
+		sessionRaw := ctx.Cookies("session_id")
+		issessionExists := len(ctx.Request().Header.Cookie("session_id")) > 0
+		if issessionExists {
+			session := sessionRaw
+			sessionRawPtr = &session
+		}
+		if validatorErr := validatorInstance.Var(sessionRawPtr, "required"); validatorErr != nil {
+			fieldName := "session"
+			validationError := wrapValidatorError(validatorErr, "CookieParams", fieldName)
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		var themeRawPtr *string = nil
+		themeRaw := ctx.Cookies("theme")
+		isthemeExists := len(ctx.Request().Header.Cookie("theme")) > 0
+		if isthemeExists {
+			theme := themeRaw
+			themeRawPtr = &theme
+		}
+		var visitsRawPtr *int = nil
+		visitsRaw := ctx.Cookies("visits")
+		isvisitsExists := len(ctx.Request().Header.Cookie("visits")) > 0
+		if isvisitsExists {
+			visitsUint64, conversionErr := strconv.Atoi(visitsRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'CookieParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"visits",
+						"int",
+						reflect.TypeOf(visitsRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/CookieParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			}
+			visits := int(visitsUint64)
+			visitsRawPtr = &visits
+		}
+		if validatorErr := validatorInstance.Var(visitsRawPtr, "gte=0,required"); validatorErr != nil {
+			fieldName := "visits"
+			validationError := wrapValidatorError(validatorErr, "CookieParams", fieldName)
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.CookieParams(*sessionRawPtr, themeRawPtr, *visitsRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Set(key, value)
+		}
+		ctx.Set("x-inject", "true")
+		ctx.Set("x-extended", "CookieParams")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'CookieParams'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/CookieParams",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.Status(statusCode).JSON(stdError)
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
+	})
+	engine.Post(toFiberUrl("/e2e/content-negotiation"), func(ctx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "ContentNegotiation")
+		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'ContentNegotiation' cannot produce a response in any of the accepted media types",
+				"ContentNegotiation",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var conversionErr error
+		var infoRawPtr *Param147info.DefaultsInfo = nil
+		requestContentType, isRequestContentTypeSupported := getRequestContentType(ctx.Get("Content-Type"), []string{"application/json"})
+		if !isRequestContentTypeSupported {
+			return handleContentNegotiationError(ctx,
+				http.StatusUnsupportedMediaType,
+				fmt.Sprintf("Operation 'ContentNegotiation' does not accept request bodies of type '%s'", ctx.Get("Content-Type")),
+				"ContentNegotiation",
+			)
+		}
+		conversionErr = bindAndValidateBody(ctx, requestContentType, "required", "{\"limit\":20,\"name\":\"guest\",\"notify\":true,\"paging\":{\"page\":1,\"size\":50},\"status\":\"shipped\"}", &infoRawPtr)
+		if conversionErr != nil {
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: fmt.Sprintf(
+					"A request was made to operation 'ContentNegotiation' but body parameter '%s' did not pass validation of '%s' - %s",
+					"info",
+					"DefaultsInfo",
+					extractValidationErrorMessage(conversionErr, nil),
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/gleece/validation/error/ContentNegotiation",
+			}
+			// json body validation error response extension placeholder
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.ContentNegotiation(*infoRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Set(key, value)
+		}
+		ctx.Set("x-inject", "true")
+		ctx.Set("x-extended", "ContentNegotiation")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'ContentNegotiation'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/ContentNegotiation",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.Status(statusCode).JSON(stdError)
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
+	})
+	engine.Post(toFiberUrl("/e2e/xml-serialization"), func(ctx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "XmlSerialization")
+		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json", "application/xml"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'XmlSerialization' cannot produce a response in any of the accepted media types",
+				"XmlSerialization",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var conversionErr error
+		var bookRawPtr *Param150book.XmlBook = nil
+		requestContentType, isRequestContentTypeSupported := getRequestContentType(ctx.Get("Content-Type"), []string{"application/xml", "application/json"})
+		if !isRequestContentTypeSupported {
+			return handleContentNegotiationError(ctx,
+				http.StatusUnsupportedMediaType,
+				fmt.Sprintf("Operation 'XmlSerialization' does not accept request bodies of type '%s'", ctx.Get("Content-Type")),
+				"XmlSerialization",
+			)
+		}
+		conversionErr = bindAndValidateBody(ctx, requestContentType, "required", "", &bookRawPtr)
+		if conversionErr != nil {
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: fmt.Sprintf(
+					"A request was made to operation 'XmlSerialization' but body parameter '%s' did not pass validation of '%s' - %s",
+					"book",
+					"XmlBook",
+					extractValidationErrorMessage(conversionErr, nil),
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/gleece/validation/error/XmlSerialization",
+			}
+			// json body validation error response extension placeholder
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.XmlSerialization(*bookRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Set(key, value)
+		}
+		ctx.Set("x-inject", "true")
+		ctx.Set("x-extended", "XmlSerialization")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'XmlSerialization'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/XmlSerialization",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.Status(statusCode).JSON(stdError)
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		if responseContentType == "application/xml" {
+			// xml response extension placeholder
+			return ctx.Status(statusCode).XML(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
+	})
+	engine.Get(toFiberUrl("/e2e/plain-text"), func(ctx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "PlainText")
+		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json", "text/plain"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'PlainText' cannot produce a response in any of the accepted media types",
+				"PlainText",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var nameRawPtr *string = nil
+		nameRaw := ctx.Query("name")
+		isnameExists := ctx.Context().QueryArgs().Has("name")
+		if isnameExists {
+			name := nameRaw
+			nameRawPtr = &name
+		}
+		if validatorErr := validatorInstance.Var(nameRawPtr, "required"); validatorErr != nil {
+			fieldName := "name"
+			validationError := wrapValidatorError(validatorErr, "PlainText", fieldName)
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.PlainText(*nameRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Set(key, value)
+		}
+		ctx.Set("x-inject", "true")
+		ctx.Set("x-extended", "PlainText")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PlainText'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/PlainText",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.Status(statusCode).JSON(stdError)
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		if responseContentType == "text/plain" {
+			// text response extension placeholder
+			ctx.Set("Content-Type", "text/plain; charset=utf-8")
+			return ctx.Status(statusCode).SendString(fmt.Sprint(value))
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
+	})
+	engine.Post(toFiberUrl("/e2e/form-files"), func(ctx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "FormFiles")
+		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'FormFiles' cannot produce a response in any of the accepted media types",
+				"FormFiles",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var titleRawPtr *string = nil
+		titleRaw := ctx.FormValue("title")
+		titleForm, titleFormErr := ctx.MultipartForm()
+		istitleExists := titleFormErr == nil && len(titleForm.Value["title"]) > 0
+		if istitleExists {
+			title := titleRaw
+			titleRawPtr = &title
+		}
+		if validatorErr := validatorInstance.Var(titleRawPtr, "required"); validatorErr != nil {
+			fieldName := "title"
+			validationError := wrapValidatorError(validatorErr, "FormFiles", fieldName)
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		var avatarRawPtr *Param157avatar.FileHeader = nil
+		avatarFiles := getFormFiles(ctx, "avatar")
+		if fileErr := validateFormFiles(avatarFiles, false, 16, []string{"image/*"}); fileErr != nil {
+			validationError := wrapValidatorError(fileErr, "FormFiles", "avatar")
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		if len(avatarFiles) > 0 {
+			avatarRawPtr = avatarFiles[0]
+		}
+		if validatorErr := validatorInstance.Var(avatarRawPtr, "required"); validatorErr != nil {
+			fieldName := "avatar"
+			validationError := wrapValidatorError(validatorErr, "FormFiles", fieldName)
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		var attachmentsRawPtr *[]*Param158attachmentsItem.FileHeader = nil
+		attachmentsFiles := getFormFiles(ctx, "attachment")
+		if fileErr := validateFormFiles(attachmentsFiles, true, 0, []string{"text/plain", "application/pdf"}); fileErr != nil {
+			validationError := wrapValidatorError(fileErr, "FormFiles", "attachments")
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		attachmentsRawPtr = &attachmentsFiles
+		if validatorErr := validatorInstance.Var(attachmentsRawPtr, "required"); validatorErr != nil {
+			fieldName := "attachments"
+			validationError := wrapValidatorError(validatorErr, "FormFiles", fieldName)
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.FormFiles(*titleRawPtr, avatarRawPtr, *attachmentsRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Set(key, value)
+		}
+		ctx.Set("x-inject", "true")
+		ctx.Set("x-extended", "FormFiles")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'FormFiles'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/FormFiles",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.Status(statusCode).JSON(stdError)
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
+	})
+	engine.Get(toFiberUrl("/e2e/stream-report"), func(ctx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "StreamReport")
+		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"text/csv"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'StreamReport' cannot produce a response in any of the accepted media types",
+				"StreamReport",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var nameRawPtr *string = nil
+		nameRaw := ctx.Query("name")
+		isnameExists := ctx.Context().QueryArgs().Has("name")
+		if isnameExists {
+			name := nameRaw
+			nameRawPtr = &name
+		}
+		if validatorErr := validatorInstance.Var(nameRawPtr, "required"); validatorErr != nil {
+			fieldName := "name"
+			validationError := wrapValidatorError(validatorErr, "StreamReport", fieldName)
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.StreamReport(*nameRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Set(key, value)
+		}
+		ctx.Set("x-inject", "true")
+		ctx.Set("x-extended", "StreamReport")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'StreamReport'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/StreamReport",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.Status(statusCode).JSON(stdError)
+		}
+		// stream response extension placeholder
+		// File responses are sent as their own media type, if given, rather than the negotiated one
+		if value.ContentType != "" {
+			responseContentType = value.ContentType
+		}
+		if value.FileName != "" {
+			ctx.Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": value.FileName}))
+		}
+		stream := value.Content
+		ctx.Set("Content-Type", responseContentType)
+		ctx.Status(statusCode)
+		if stream == nil {
+			return nil
+		}
+		// The stream is closed once fully sent if it's an io.Closer
+		return ctx.SendStream(stream)
+	})
+	engine.Get(toFiberUrl("/e2e/download-notes"), func(ctx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "DownloadNotes")
+		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/octet-stream"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'DownloadNotes' cannot produce a response in any of the accepted media types",
+				"DownloadNotes",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.DownloadNotes()
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Set(key, value)
+		}
+		ctx.Set("x-inject", "true")
+		ctx.Set("x-extended", "DownloadNotes")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'DownloadNotes'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/DownloadNotes",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.Status(statusCode).JSON(stdError)
+		}
+		// stream response extension placeholder
+		// File responses are sent as their own media type, if given, rather than the negotiated one
+		if value.ContentType != "" {
+			responseContentType = value.ContentType
+		}
+		if value.FileName != "" {
+			ctx.Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": value.FileName}))
+		}
+		stream := value.Content
+		ctx.Set("Content-Type", responseContentType)
+		ctx.Status(statusCode)
+		if stream == nil {
+			return nil
+		}
+		// The stream is closed once fully sent if it's an io.Closer
+		return ctx.SendStream(stream)
+	})
+	engine.Get(toFiberUrl("/e2e/stream-bytes"), func(ctx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "StreamBytes")
+		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/octet-stream"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'StreamBytes' cannot produce a response in any of the accepted media types",
+				"StreamBytes",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.StreamBytes()
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Set(key, value)
+		}
+		ctx.Set("x-inject", "true")
+		ctx.Set("x-extended", "StreamBytes")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'StreamBytes'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/StreamBytes",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.Status(statusCode).JSON(stdError)
+		}
+		// stream response extension placeholder
+		stream := value
+		ctx.Set("Content-Type", responseContentType)
+		ctx.Status(statusCode)
+		if stream == nil {
+			return nil
+		}
+		// The stream is closed once fully sent if it's an io.Closer
+		return ctx.SendStream(stream)
+	})
+	engine.Get(toFiberUrl("/e2e/event-stream"), func(ctx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "EventStream")
+		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"text/event-stream"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'EventStream' cannot produce a response in any of the accepted media types",
+				"EventStream",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var stepsRawPtr *int = nil
+		stepsRaw := ctx.Query("steps")
+		isstepsExists := ctx.Context().QueryArgs().Has("steps")
+		if isstepsExists {
+			stepsUint64, conversionErr := strconv.Atoi(stepsRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'EventStream' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"steps",
+						"int",
+						reflect.TypeOf(stepsRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/EventStream",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			}
+			steps := int(stepsUint64)
+			stepsRawPtr = &steps
+		}
+		if validatorErr := validatorInstance.Var(stepsRawPtr, "required"); validatorErr != nil {
+			fieldName := "steps"
+			validationError := wrapValidatorError(validatorErr, "EventStream", fieldName)
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.EventStream(*stepsRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Set(key, value)
+		}
+		ctx.Set("x-inject", "true")
+		ctx.Set("x-extended", "EventStream")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'EventStream'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/EventStream",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.Status(statusCode).JSON(stdError)
+		}
+		// event stream response extension placeholder
+		ctx.Set("Content-Type", "text/event-stream")
+		ctx.Set("Cache-Control", "no-cache")
+		ctx.Set("Connection", "keep-alive")
+		ctx.Status(statusCode)
+		// The stream is written once the handler returns, by which point the Fiber context may be reused
+		requestCtx := ctx.Context()
+		requestCtx.SetBodyStreamWriter(func(w *bufio.Writer) {
+			writeEventStream(w, requestCtx, value)
+		})
+		return nil
+	})
+	engine.Get(toFiberUrl("/e2e/event-stream-error"), func(ctx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "EventStreamError")
+		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"text/event-stream"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'EventStreamError' cannot produce a response in any of the accepted media types",
+				"EventStreamError",
+			)
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); continueOperation == false {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.EventStreamError()
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Set(key, value)
+		}
+		ctx.Set("x-inject", "true")
+		ctx.Set("x-extended", "EventStreamError")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); continueOperation == false {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'EventStreamError'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/EventStreamError",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			return ctx.Status(statusCode).JSON(stdError)
+		}
+		// event stream response extension placeholder
+		ctx.Set("Content-Type", "text/event-stream")
+		ctx.Set("Cache-Control", "no-cache")
+		ctx.Set("Connection", "keep-alive")
+		ctx.Status(statusCode)
+		// The stream is written once the handler returns, by which point the Fiber context may be reused
+		requestCtx := ctx.Context()
+		requestCtx.SetBodyStreamWriter(func(w *bufio.Writer) {
+			writeEventStream(w, requestCtx, value)
+		})
+		return nil
+	})
+	// E2EClassSecController
+	engine.Get(toFiberUrl("/e2e/with-default-class-security"), func(ctx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"class",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(ctx, authErr, "WithDefaultClassSecurity")
+		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'WithDefaultClassSecurity' cannot produce a response in any of the accepted media types",
+				"WithDefaultClassSecurity",
+			)
+		}
+		controller := E2EClassSecControllerImport.E2EClassSecController{}
+		controller.InitController(ctx)
+		var headerParamRawPtr *string = nil
+		headerParamRaw := ctx.Get("x-test-scopes")
+		isheaderParamExists := len(ctx.Request().Header.Peek("x-test-scopes")) > 0
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			fieldName := "headerParam"
+			validationError := wrapValidatorError(validatorErr, "WithDefaultClassSecurity", fieldName)
+			return ctx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+		}
+		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			if continueOperation := middleware(ctx); continueOperation == false {
 				return nil
@@ -2933,8 +6752,12 @@ func RegisterRoutes(engine *fiber.App) {
 			// json error response extension placeholder
 			return ctx.Status(statusCode).JSON(stdError)
 		}
-		// json response extension placeholder
-		return ctx.Status(statusCode).JSON(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 	engine.Get(toFiberUrl("/e2e/with-default-override-class-security"), func(ctx *fiber.Ctx) error {
 		// route start routes extension placeholder
@@ -2957,6 +6780,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(ctx, authErr, "WithOverrideClassSecurity")
 		}
+		responseContentType := negotiateContentType(ctx.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			return handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'WithOverrideClassSecurity' cannot produce a response in any of the accepted media types",
+				"WithOverrideClassSecurity",
+			)
+		}
 		controller := E2EClassSecControllerImport.E2EClassSecController{}
 		controller.InitController(ctx)
 		var headerParamRawPtr *string = nil
@@ -3014,7 +6845,11 @@ func RegisterRoutes(engine *fiber.App) {
 			// json error response extension placeholder
 			return ctx.Status(statusCode).JSON(stdError)
 		}
-		// json response extension placeholder
-		return ctx.Status(statusCode).JSON(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			return ctx.Status(statusCode).JSON(value)
+		}
+		// The response content type is negotiated among the produced media types and is always handled above
+		return nil
 	})
 }
@@ -5,7 +5,7 @@ It includes routes and handlers by the Gleece API Routes Generator.
 --
 Authors: Haim Kastner & Yuval Pomerchik
 Generated by: Gleece Routes Generator
-Generated Date: 2025-02-25
+Generated Date: 2026-10-18
 Target Engine: Gin (https://github.com/gin-gonic/gin)
 --
 Usage:
@@ -16,12 +16,20 @@ Repository: https://github.com/gopher-fleece/gleece
 */
 package routes
 import (
+	"context"
+	"encoding/base64"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 	"io"
+	"mime"
+	"mime/multipart"
 	"net/http"
 	"net/textproto"
+	"reflect"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
@@ -33,6 +41,39 @@ import (
 	Param41theBody "github.com/gopher-fleece/gleece/e2e/assets"
 	Response62CustomError "github.com/gopher-fleece/gleece/e2e/assets"
 	Response65CustomError "github.com/gopher-fleece/gleece/e2e/assets"
+	Param80status "github.com/gopher-fleece/gleece/e2e/assets"
+	Param81priority "github.com/gopher-fleece/gleece/e2e/assets"
+	Param82headerStatus "github.com/gopher-fleece/gleece/e2e/assets"
+	Param85id "github.com/google/uuid"
+	Param86at "time"
+	Param87timeout "time"
+	Param89raw "encoding/json"
+	Param90address "net"
+	Param91link "net/url"
+	Param94amount "github.com/gopher-fleece/gleece/e2e/assets/domain"
+	Param95tip "github.com/gopher-fleece/gleece/e2e/assets/domain"
+	ParamParser94amount "github.com/gopher-fleece/gleece/e2e/assets/domain"
+	ParamParser95tip "github.com/gopher-fleece/gleece/e2e/assets/domain"
+	Param98envelopeArg0 "github.com/gopher-fleece/gleece/e2e/assets"
+	Param98envelope "github.com/gopher-fleece/gleece/e2e/assets/domain"
+	Param101envelopeArg0Value "github.com/gopher-fleece/gleece/e2e/assets"
+	Param101envelope "github.com/gopher-fleece/gleece/e2e/assets/domain"
+	Param101envelopeArg0Key "github.com/gopher-fleece/gleece/e2e/assets/domain"
+	Param107statusesItem "github.com/gopher-fleece/gleece/e2e/assets"
+	Param108keysItem "github.com/google/uuid"
+	Param112filter "github.com/gopher-fleece/gleece/e2e/assets"
+	Param116filterStatus "github.com/gopher-fleece/gleece/e2e/assets"
+	Param120pet "github.com/gopher-fleece/gleece/e2e/assets"
+	Param126customerId "github.com/gopher-fleece/gleece/e2e/assets"
+	Param127amount "github.com/gopher-fleece/gleece/e2e/assets"
+	Param128splitsItem "github.com/gopher-fleece/gleece/e2e/assets"
+	Param129currency "github.com/gopher-fleece/gleece/e2e/assets"
+	Param132info "github.com/gopher-fleece/gleece/e2e/assets"
+	Param137status "github.com/gopher-fleece/gleece/e2e/assets"
+	Param147info "github.com/gopher-fleece/gleece/e2e/assets"
+	Param150book "github.com/gopher-fleece/gleece/e2e/assets"
+	Param157avatar "mime/multipart"
+	Param158attachmentsItem "mime/multipart"
 	E2EClassSecControllerImport "github.com/gopher-fleece/gleece/e2e/assets"
 	// import extension placeholder
 )
@@ -77,7 +118,7 @@ func getStatusCode(controller runtime.Controller, hasReturnValue bool, err error
 	}
 	return http.StatusNoContent
 }
-func bindAndValidateBody[TOutput any](ctx *gin.Context, contentType string, validation string, output **TOutput) error {
+func bindAndValidateBody[TOutput any](ctx *gin.Context, contentType string, validation string, defaults string, output **TOutput) error {
 	var err error
 	bodyBytes, err := io.ReadAll(ctx.Request.Body)
 	if err != nil || len(bodyBytes) == 0 {
@@ -87,9 +128,17 @@ func bindAndValidateBody[TOutput any](ctx *gin.Context, contentType string, vali
 		return nil
 	}
 	var deserializedOutput TOutput
+	// Fields absent from the body retain their default values
+	if defaults != "" {
+		if err = json.Unmarshal([]byte(defaults), &deserializedOutput); err != nil {
+			return err
+		}
+	}
 	switch contentType {
 	case "application/json":
 		err = json.Unmarshal(bodyBytes, &deserializedOutput)
+	case "application/xml":
+		err = xml.Unmarshal(bodyBytes, &deserializedOutput)
 	default:
 		return fmt.Errorf("content-type %s is not currently supported by the validation subsystem", contentType)
 	}
@@ -156,6 +205,78 @@ func handleAuthorizationError(ctx *gin.Context, authErr *runtime.SecurityError,
 	}
 	ctx.JSON(statusCode, stdError)
 }
+func handleContentNegotiationError(ctx *gin.Context, statusCode int, detail string, operationId string) {
+	stdError := runtime.Rfc7807Error{
+		Type:     http.StatusText(statusCode),
+		Detail:   detail,
+		Status:   statusCode,
+		Instance: "/gleece/negotiation/error/" + operationId,
+	}
+	ctx.JSON(statusCode, stdError)
+}
+// negotiateContentType returns the media type a response should be sent in, per the request's 'Accept' header.
+// The media type the client prefers is chosen among those the operation produces, with ties broken by the operation's order.
+// Returns an empty string if the client accepts none of them
+func negotiateContentType(acceptHeader string, produces []string) string {
+	if strings.TrimSpace(acceptHeader) == "" {
+		return produces[0]
+	}
+	bestContentType := ""
+	bestQuality := 0.0
+	for _, contentType := range produces {
+		if quality := getAcceptedQuality(acceptHeader, contentType); quality > bestQuality {
+			bestContentType = contentType
+			bestQuality = quality
+		}
+	}
+	return bestContentType
+}
+// getAcceptedQuality returns the quality an 'Accept' header assigns to the given media type,
+// per the most specific of the header's media ranges matching it. Returns 0 if no media range matches
+func getAcceptedQuality(acceptHeader string, contentType string) float64 {
+	mainType, _, _ := strings.Cut(contentType, "/")
+	quality := 0.0
+	bestSpecificity := -1
+	for _, mediaRange := range strings.Split(acceptHeader, ",") {
+		rangeType, params, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+		if err != nil {
+			continue
+		}
+		specificity := -1
+		switch rangeType {
+		case contentType:
+			specificity = 2
+		case mainType + "/*":
+			specificity = 1
+		case "*/*":
+			specificity = 0
+		}
+		if specificity <= bestSpecificity {
+			continue
+		}
+		bestSpecificity = specificity
+		quality = 1.0
+		if rawQuality, hasQuality := params["q"]; hasQuality {
+			if parsedQuality, err := strconv.ParseFloat(rawQuality, 64); err == nil {
+				quality = parsedQuality
+			}
+		}
+	}
+	return quality
+}
+// getRequestContentType returns the media type of a request's body, per its 'Content-Type' header.
+// Requests without a 'Content-Type' header are assumed to be sent in the first media type the operation consumes.
+// Returns false if the body's media type is not one the operation consumes
+func getRequestContentType(contentTypeHeader string, consumes []string) (string, bool) {
+	if strings.TrimSpace(contentTypeHeader) == "" {
+		return consumes[0], true
+	}
+	contentType, _, err := mime.ParseMediaType(contentTypeHeader)
+	if err != nil || !slices.Contains(consumes, contentType) {
+		return "", false
+	}
+	return contentType, true
+}
 func wrapValidatorError(validatorErr error, operationId string, fieldName string) runtime.Rfc7807Error {
 	return runtime.Rfc7807Error{
 		Type: http.StatusText(http.StatusUnprocessableEntity),
@@ -169,6 +290,208 @@ func wrapValidatorError(validatorErr error, operationId string, fieldName string
 		Instance: fmt.Sprintf("/gleece/validation/error/%s", operationId),
 	}
 }
+// wrapStructValidatorError wraps the validation errors of a struct-typed parameter, naming each of the struct's failed fields
+func wrapStructValidatorError(validatorErr error, operationId string, paramName string) runtime.Rfc7807Error {
+	return runtime.Rfc7807Error{
+		Type: http.StatusText(http.StatusUnprocessableEntity),
+		Detail: fmt.Sprintf(
+			"A request was made to operation '%s' but parameter '%s' did not pass validation - %s",
+			operationId,
+			paramName,
+			extractValidationErrorMessage(validatorErr, nil),
+		),
+		Status:   http.StatusUnprocessableEntity,
+		Instance: fmt.Sprintf("/gleece/validation/error/%s", operationId),
+	}
+}
+// bindAndValidatePolymorphicBody binds a body to a polymorphic interface.
+// The body is unmarshalled into the struct identified by the value of its discriminator property, which is then validated
+func bindAndValidatePolymorphicBody[TOutput any](
+	ctx *gin.Context,
+	contentType string,
+	validation string,
+	discriminator string,
+	unmarshallers map[string]func([]byte) (TOutput, error),
+	output **TOutput,
+) error {
+	bodyBytes, err := io.ReadAll(ctx.Request.Body)
+	if err != nil || len(bodyBytes) == 0 {
+		if strings.Contains(validation, "required") {
+			return fmt.Errorf("body is required but was not provided")
+		}
+		return nil
+	}
+	if contentType != "application/json" {
+		return fmt.Errorf("content-type %s is not currently supported by the validation subsystem", contentType)
+	}
+	var properties map[string]json.RawMessage
+	if err := json.Unmarshal(bodyBytes, &properties); err != nil {
+		return err
+	}
+	var discriminatorValue string
+	if err := json.Unmarshal(properties[discriminator], &discriminatorValue); err != nil {
+		return fmt.Errorf("body is missing string discriminator property '%s'", discriminator)
+	}
+	unmarshal, isKnown := unmarshallers[discriminatorValue]
+	if !isKnown {
+		return fmt.Errorf("'%s' is not a valid value for discriminator property '%s'", discriminatorValue, discriminator)
+	}
+	deserializedOutput, err := unmarshal(bodyBytes)
+	if err != nil {
+		return err
+	}
+	if err = validatorInstance.Struct(deserializedOutput); err != nil {
+		return err
+	}
+	*output = &deserializedOutput
+	return nil
+}
+// unmarshalOneOfMember returns a function unmarshalling a body into the polymorphic interface member TMember.
+// Members implementing the interface via a pointer are returned by address
+func unmarshalOneOfMember[TMember any, TOutput any](isByAddress bool) func([]byte) (TOutput, error) {
+	return func(bodyBytes []byte) (TOutput, error) {
+		var member TMember
+		var output TOutput
+		if err := json.Unmarshal(bodyBytes, &member); err != nil {
+			return output, err
+		}
+		if isByAddress {
+			return any(&member).(TOutput), nil
+		}
+		return any(member).(TOutput), nil
+	}
+}
+// splitParamValues returns the individual values of an array parameter.
+// An empty separator indicates each value was sent separately (i.e., 'ids=1&ids=2').
+// Otherwise, values are delimited by the separator (i.e., 'ids=1,2') and empty raw values yield no items
+func splitParamValues(rawValues []string, separator string) []string {
+	if separator == "" {
+		return rawValues
+	}
+	values := []string{}
+	for _, rawValue := range rawValues {
+		if rawValue == "" {
+			continue
+		}
+		for _, value := range strings.Split(rawValue, separator) {
+			values = append(values, strings.TrimSpace(value))
+		}
+	}
+	return values
+}
+// getFormFiles returns the files uploaded under the given name in a multipart form, if any
+func getFormFiles(ctx *gin.Context, name string) []*multipart.FileHeader {
+	form, err := ctx.MultipartForm()
+	if err != nil {
+		return nil
+	}
+	return form.File[name]
+}
+// validateFormFiles verifies the files uploaded for a form file parameter.
+// Parameters bound to a single file may not receive several, and each file must not exceed the parameter's maximum size
+// (if non-zero) and must be of one of its allowed MIME types (if any)
+func validateFormFiles(files []*multipart.FileHeader, allowsMultiple bool, maxSize int64, allowedMimeTypes []string) error {
+	if !allowsMultiple && len(files) > 1 {
+		return fmt.Errorf("expected a single file but got %d", len(files))
+	}
+	for _, file := range files {
+		if maxSize > 0 && file.Size > maxSize {
+			return fmt.Errorf("file '%s' is %d bytes long which exceeds the maximum size of %d bytes", file.Filename, file.Size, maxSize)
+		}
+		fileMimeType := file.Header.Get("Content-Type")
+		if len(allowedMimeTypes) > 0 && !isMimeTypeAllowed(fileMimeType, allowedMimeTypes) {
+			return fmt.Errorf("file '%s' is of type '%s' which is not one of the allowed types %v", file.Filename, fileMimeType, allowedMimeTypes)
+		}
+	}
+	return nil
+}
+// isMimeTypeAllowed returns whether a MIME type matches any of the allowed ones, which may use a wildcard subtype, e.g. 'image/*'
+func isMimeTypeAllowed(mimeType string, allowedMimeTypes []string) bool {
+	mediaType, _, err := mime.ParseMediaType(mimeType)
+	if err != nil {
+		return false
+	}
+	for _, allowed := range allowedMimeTypes {
+		if allowed == mediaType {
+			return true
+		}
+		if mainType, isWildcard := strings.CutSuffix(allowed, "/*"); isWildcard && strings.HasPrefix(mediaType, mainType+"/") {
+			return true
+		}
+	}
+	return false
+}
+// writeStream writes a streamed response body of the given media type, closing the stream afterwards if it's an io.Closer.
+// Files are offered for download under the given file name, if any. A nil stream results in an empty body.
+// Returns the error that interrupted the stream, if any. The status code has been sent by then
+func writeStream(w http.ResponseWriter, statusCode int, contentType string, fileName string, stream io.Reader) error {
+	if closer, isCloser := stream.(io.Closer); isCloser {
+		defer closer.Close()
+	}
+	w.Header().Set("Content-Type", contentType)
+	if fileName != "" {
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileName}))
+	}
+	w.WriteHeader(statusCode)
+	if stream == nil {
+		return nil
+	}
+	_, err := io.Copy(w, stream)
+	return err
+}
+// writeEventStream writes each value received from a channel as a server-sent event carrying its JSON representation,
+// flushing it to the client right away. Writing stops once the channel is closed, a value cannot be serialized
+// or the client disconnects, i.e., the request's context is done. A value that cannot be serialized is reported as an 'error' event.
+//
+// Values sent after writing stops early are discarded so their sender is not blocked forever.
+// Senders should nonetheless stop sending, and close the channel, once the request's context is done
+func writeEventStream[TValue any](w http.ResponseWriter, requestCtx context.Context, statusCode int, events <-chan TValue) {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(statusCode)
+	flusher, canFlush := w.(http.Flusher)
+	if canFlush {
+		flusher.Flush()
+	}
+	if events == nil {
+		return
+	}
+	for {
+		select {
+		case <-requestCtx.Done():
+			go discardEvents(events)
+			return
+		case event, isOpen := <-events:
+			if !isOpen {
+				return
+			}
+			data, err := json.Marshal(event)
+			if err != nil {
+				writeEventStreamError(w, err)
+				if canFlush {
+					flusher.Flush()
+				}
+				go discardEvents(events)
+				return
+			}
+			fmt.Fprintf(w, "data: %s\n\n", data)
+			if canFlush {
+				flusher.Flush()
+			}
+		}
+	}
+}
+// writeEventStreamError writes a server-sent 'error' event describing why an event stream has ended early
+func writeEventStreamError(w io.Writer, err error) {
+	data, _ := json.Marshal(map[string]string{"error": err.Error()})
+	fmt.Fprintf(w, "event: error\ndata: %s\n\n", data)
+}
+// discardEvents receives and discards the values of an event stream that's no longer written, until the channel is closed
+func discardEvents[TValue any](events <-chan TValue) {
+	for range events {
+	}
+}
 // function declarations extension placeholder
 type MiddlewareFunc func(ctx *gin.Context) bool
 type ErrorMiddlewareFunc func(ctx *gin.Context, err error) bool
@@ -218,11 +541,20 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ctx, authErr, "SimpleGet")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'SimpleGet' cannot produce a response in any of the accepted media types",
+				"SimpleGet",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
-			if continueOperation := middleware(ctx); continueOperation == false {
+			if continueOperation := middleware(ctx); !continueOperation {
 				return
 			}
 		}
@@ -239,7 +571,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError == nil {
 			// Middlewares afterOperationSuccessMiddlewares section
 			for _, middleware := range afterOperationSuccessMiddlewares {
-				if continueOperation := middleware(ctx); continueOperation == false {
+				if continueOperation := middleware(ctx); !continueOperation {
 					return
 				}
 			}
@@ -248,7 +580,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
-				if continueOperation := middleware(ctx, opError); continueOperation == false {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
 					return
 				}
 			}
@@ -264,8 +596,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			ctx.JSON(statusCode, stdError)
 			return
 		}
-		// json response extension placeholder
-		ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
 	})
 	engine.GET(toGinUrl("/e2e/simple-get-empty-string"), func(ctx *gin.Context) {
 		// route start routes extension placeholder
@@ -289,11 +623,20 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ctx, authErr, "SimpleGetEmptyString")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'SimpleGetEmptyString' cannot produce a response in any of the accepted media types",
+				"SimpleGetEmptyString",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
-			if continueOperation := middleware(ctx); continueOperation == false {
+			if continueOperation := middleware(ctx); !continueOperation {
 				return
 			}
 		}
@@ -310,7 +653,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError == nil {
 			// Middlewares afterOperationSuccessMiddlewares section
 			for _, middleware := range afterOperationSuccessMiddlewares {
-				if continueOperation := middleware(ctx); continueOperation == false {
+				if continueOperation := middleware(ctx); !continueOperation {
 					return
 				}
 			}
@@ -319,7 +662,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
-				if continueOperation := middleware(ctx, opError); continueOperation == false {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
 					return
 				}
 			}
@@ -335,8 +678,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			ctx.JSON(statusCode, stdError)
 			return
 		}
-		// json response extension placeholder
-		ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
 	})
 	engine.GET(toGinUrl("/e2e/simple-get-ptr-string"), func(ctx *gin.Context) {
 		// route start routes extension placeholder
@@ -360,11 +705,20 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ctx, authErr, "SimpleGetPtrString")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'SimpleGetPtrString' cannot produce a response in any of the accepted media types",
+				"SimpleGetPtrString",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
-			if continueOperation := middleware(ctx); continueOperation == false {
+			if continueOperation := middleware(ctx); !continueOperation {
 				return
 			}
 		}
@@ -381,7 +735,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError == nil {
 			// Middlewares afterOperationSuccessMiddlewares section
 			for _, middleware := range afterOperationSuccessMiddlewares {
-				if continueOperation := middleware(ctx); continueOperation == false {
+				if continueOperation := middleware(ctx); !continueOperation {
 					return
 				}
 			}
@@ -390,7 +744,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
-				if continueOperation := middleware(ctx, opError); continueOperation == false {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
 					return
 				}
 			}
@@ -406,8 +760,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			ctx.JSON(statusCode, stdError)
 			return
 		}
-		// json response extension placeholder
-		ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
 	})
 	engine.GET(toGinUrl("/e2e/simple-get-null-string"), func(ctx *gin.Context) {
 		// route start routes extension placeholder
@@ -431,11 +787,20 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ctx, authErr, "SimpleGetNullString")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'SimpleGetNullString' cannot produce a response in any of the accepted media types",
+				"SimpleGetNullString",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
-			if continueOperation := middleware(ctx); continueOperation == false {
+			if continueOperation := middleware(ctx); !continueOperation {
 				return
 			}
 		}
@@ -452,7 +817,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError == nil {
 			// Middlewares afterOperationSuccessMiddlewares section
 			for _, middleware := range afterOperationSuccessMiddlewares {
-				if continueOperation := middleware(ctx); continueOperation == false {
+				if continueOperation := middleware(ctx); !continueOperation {
 					return
 				}
 			}
@@ -461,7 +826,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
-				if continueOperation := middleware(ctx, opError); continueOperation == false {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
 					return
 				}
 			}
@@ -477,8 +842,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			ctx.JSON(statusCode, stdError)
 			return
 		}
-		// json response extension placeholder
-		ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
 	})
 	engine.GET(toGinUrl("/e2e/simple-get-object"), func(ctx *gin.Context) {
 		// route start routes extension placeholder
@@ -502,11 +869,20 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ctx, authErr, "SimpleGetObject")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'SimpleGetObject' cannot produce a response in any of the accepted media types",
+				"SimpleGetObject",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
-			if continueOperation := middleware(ctx); continueOperation == false {
+			if continueOperation := middleware(ctx); !continueOperation {
 				return
 			}
 		}
@@ -523,7 +899,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError == nil {
 			// Middlewares afterOperationSuccessMiddlewares section
 			for _, middleware := range afterOperationSuccessMiddlewares {
-				if continueOperation := middleware(ctx); continueOperation == false {
+				if continueOperation := middleware(ctx); !continueOperation {
 					return
 				}
 			}
@@ -532,7 +908,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
-				if continueOperation := middleware(ctx, opError); continueOperation == false {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
 					return
 				}
 			}
@@ -548,8 +924,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			ctx.JSON(statusCode, stdError)
 			return
 		}
-		// json response extension placeholder
-		ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
 	})
 	engine.GET(toGinUrl("/e2e/simple-get-object-ptr"), func(ctx *gin.Context) {
 		// route start routes extension placeholder
@@ -573,11 +951,20 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ctx, authErr, "SimpleGetObjectPtr")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'SimpleGetObjectPtr' cannot produce a response in any of the accepted media types",
+				"SimpleGetObjectPtr",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
-			if continueOperation := middleware(ctx); continueOperation == false {
+			if continueOperation := middleware(ctx); !continueOperation {
 				return
 			}
 		}
@@ -594,7 +981,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError == nil {
 			// Middlewares afterOperationSuccessMiddlewares section
 			for _, middleware := range afterOperationSuccessMiddlewares {
-				if continueOperation := middleware(ctx); continueOperation == false {
+				if continueOperation := middleware(ctx); !continueOperation {
 					return
 				}
 			}
@@ -603,7 +990,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
-				if continueOperation := middleware(ctx, opError); continueOperation == false {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
 					return
 				}
 			}
@@ -619,8 +1006,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			ctx.JSON(statusCode, stdError)
 			return
 		}
-		// json response extension placeholder
-		ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
 	})
 	engine.GET(toGinUrl("/e2e/simple-get-object-null"), func(ctx *gin.Context) {
 		// route start routes extension placeholder
@@ -644,11 +1033,20 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ctx, authErr, "SimpleGetObjectNull")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'SimpleGetObjectNull' cannot produce a response in any of the accepted media types",
+				"SimpleGetObjectNull",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
-			if continueOperation := middleware(ctx); continueOperation == false {
+			if continueOperation := middleware(ctx); !continueOperation {
 				return
 			}
 		}
@@ -665,7 +1063,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError == nil {
 			// Middlewares afterOperationSuccessMiddlewares section
 			for _, middleware := range afterOperationSuccessMiddlewares {
-				if continueOperation := middleware(ctx); continueOperation == false {
+				if continueOperation := middleware(ctx); !continueOperation {
 					return
 				}
 			}
@@ -674,7 +1072,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
-				if continueOperation := middleware(ctx, opError); continueOperation == false {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
 					return
 				}
 			}
@@ -690,8 +1088,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			ctx.JSON(statusCode, stdError)
 			return
 		}
-		// json response extension placeholder
-		ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
 	})
 	engine.GET(toGinUrl("/e2e/simple-get-empty"), func(ctx *gin.Context) {
 		// route start routes extension placeholder
@@ -715,6 +1115,15 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ctx, authErr, "SimpleGetEmpty")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'SimpleGetEmpty' cannot produce a response in any of the accepted media types",
+				"SimpleGetEmpty",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var queryParamRawPtr *string = nil
@@ -731,7 +1140,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
-			if continueOperation := middleware(ctx); continueOperation == false {
+			if continueOperation := middleware(ctx); !continueOperation {
 				return
 			}
 		}
@@ -748,7 +1157,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError == nil {
 			// Middlewares afterOperationSuccessMiddlewares section
 			for _, middleware := range afterOperationSuccessMiddlewares {
-				if continueOperation := middleware(ctx); continueOperation == false {
+				if continueOperation := middleware(ctx); !continueOperation {
 					return
 				}
 			}
@@ -757,7 +1166,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
-				if continueOperation := middleware(ctx, opError); continueOperation == false {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
 					return
 				}
 			}
@@ -773,9 +1182,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			ctx.JSON(statusCode, stdError)
 			return
 		}
-		// json response extension placeholder
-		ctx.Header("Content-Type", "application/json")
-		ctx.Status(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.Header("Content-Type", "application/json")
+			ctx.Status(statusCode)
+		}
 	})
 	engine.GET(toGinUrl("/e2e/get-with-all-params/{pathParam}"), func(ctx *gin.Context) {
 		// route start routes extension placeholder
@@ -799,6 +1210,15 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ctx, authErr, "GetWithAllParams")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'GetWithAllParams' cannot produce a response in any of the accepted media types",
+				"GetWithAllParams",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var queryParamRawPtr *string = nil
@@ -840,7 +1260,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
-			if continueOperation := middleware(ctx); continueOperation == false {
+			if continueOperation := middleware(ctx); !continueOperation {
 				return
 			}
 		}
@@ -857,7 +1277,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError == nil {
 			// Middlewares afterOperationSuccessMiddlewares section
 			for _, middleware := range afterOperationSuccessMiddlewares {
-				if continueOperation := middleware(ctx); continueOperation == false {
+				if continueOperation := middleware(ctx); !continueOperation {
 					return
 				}
 			}
@@ -866,7 +1286,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
-				if continueOperation := middleware(ctx, opError); continueOperation == false {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
 					return
 				}
 			}
@@ -882,8 +1302,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			ctx.JSON(statusCode, stdError)
 			return
 		}
-		// json response extension placeholder
-		ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
 	})
 	engine.GET(toGinUrl("/e2e/get-with-all-params-ptr/{pathParam}"), func(ctx *gin.Context) {
 		// route start routes extension placeholder
@@ -907,6 +1329,15 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ctx, authErr, "GetWithAllParamsPtr")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'GetWithAllParamsPtr' cannot produce a response in any of the accepted media types",
+				"GetWithAllParamsPtr",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var queryParamRawPtr *string = nil
@@ -936,7 +1367,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
-			if continueOperation := middleware(ctx); continueOperation == false {
+			if continueOperation := middleware(ctx); !continueOperation {
 				return
 			}
 		}
@@ -953,7 +1384,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError == nil {
 			// Middlewares afterOperationSuccessMiddlewares section
 			for _, middleware := range afterOperationSuccessMiddlewares {
-				if continueOperation := middleware(ctx); continueOperation == false {
+				if continueOperation := middleware(ctx); !continueOperation {
 					return
 				}
 			}
@@ -962,7 +1393,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
-				if continueOperation := middleware(ctx, opError); continueOperation == false {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
 					return
 				}
 			}
@@ -978,8 +1409,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			ctx.JSON(statusCode, stdError)
 			return
 		}
-		// json response extension placeholder
-		ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
 	})
 	engine.GET(toGinUrl("/e2e/get-with-all-params-required-ptr/{pathParam}"), func(ctx *gin.Context) {
 		// route start routes extension placeholder
@@ -1003,6 +1436,15 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ctx, authErr, "GetWithAllParamsRequiredPtr")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'GetWithAllParamsRequiredPtr' cannot produce a response in any of the accepted media types",
+				"GetWithAllParamsRequiredPtr",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var queryParamRawPtr *string = nil
@@ -1044,7 +1486,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
-			if continueOperation := middleware(ctx); continueOperation == false {
+			if continueOperation := middleware(ctx); !continueOperation {
 				return
 			}
 		}
@@ -1061,7 +1503,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError == nil {
 			// Middlewares afterOperationSuccessMiddlewares section
 			for _, middleware := range afterOperationSuccessMiddlewares {
-				if continueOperation := middleware(ctx); continueOperation == false {
+				if continueOperation := middleware(ctx); !continueOperation {
 					return
 				}
 			}
@@ -1070,7 +1512,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
-				if continueOperation := middleware(ctx, opError); continueOperation == false {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
 					return
 				}
 			}
@@ -1086,8 +1528,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			ctx.JSON(statusCode, stdError)
 			return
 		}
-		// json response extension placeholder
-		ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
 	})
 	engine.POST(toGinUrl("/e2e/post-with-all-params-body"), func(ctx *gin.Context) {
 		// route start routes extension placeholder
@@ -1111,6 +1555,15 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ctx, authErr, "PostWithAllParamsWithBody")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'PostWithAllParamsWithBody' cannot produce a response in any of the accepted media types",
+				"PostWithAllParamsWithBody",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var conversionErr error
@@ -1140,7 +1593,16 @@ func RegisterRoutes(engine *gin.Engine) {
 			return
 		}
 		var theBodyRawPtr *Param33theBody.BodyInfo = nil
-		conversionErr = bindAndValidateBody(ctx, "application/json", "required", &theBodyRawPtr)
+		requestContentType, isRequestContentTypeSupported := getRequestContentType(ctx.GetHeader("Content-Type"), []string{"application/json"})
+		if !isRequestContentTypeSupported {
+			handleContentNegotiationError(ctx,
+				http.StatusUnsupportedMediaType,
+				fmt.Sprintf("Operation 'PostWithAllParamsWithBody' does not accept request bodies of type '%s'", ctx.GetHeader("Content-Type")),
+				"PostWithAllParamsWithBody",
+			)
+			return
+		}
+		conversionErr = bindAndValidateBody(ctx, requestContentType, "required", "", &theBodyRawPtr)
 		if conversionErr != nil {
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
@@ -1159,7 +1621,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
-			if continueOperation := middleware(ctx); continueOperation == false {
+			if continueOperation := middleware(ctx); !continueOperation {
 				return
 			}
 		}
@@ -1176,7 +1638,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError == nil {
 			// Middlewares afterOperationSuccessMiddlewares section
 			for _, middleware := range afterOperationSuccessMiddlewares {
-				if continueOperation := middleware(ctx); continueOperation == false {
+				if continueOperation := middleware(ctx); !continueOperation {
 					return
 				}
 			}
@@ -1185,7 +1647,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
-				if continueOperation := middleware(ctx, opError); continueOperation == false {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
 					return
 				}
 			}
@@ -1201,8 +1663,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			ctx.JSON(statusCode, stdError)
 			return
 		}
-		// json response extension placeholder
-		ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
 	})
 	engine.POST(toGinUrl("/e2e/post-with-all-params-body-ptr"), func(ctx *gin.Context) {
 		// route start routes extension placeholder
@@ -1226,6 +1690,15 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ctx, authErr, "PostWithAllParamsWithBodyPtr")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'PostWithAllParamsWithBodyPtr' cannot produce a response in any of the accepted media types",
+				"PostWithAllParamsWithBodyPtr",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var conversionErr error
@@ -1243,7 +1716,16 @@ func RegisterRoutes(engine *gin.Engine) {
 			headerParamRawPtr = &headerParam
 		}
 		var theBodyRawPtr *Param38theBody.BodyInfo = nil
-		conversionErr = bindAndValidateBody(ctx, "application/json", "", &theBodyRawPtr)
+		requestContentType, isRequestContentTypeSupported := getRequestContentType(ctx.GetHeader("Content-Type"), []string{"application/json"})
+		if !isRequestContentTypeSupported {
+			handleContentNegotiationError(ctx,
+				http.StatusUnsupportedMediaType,
+				fmt.Sprintf("Operation 'PostWithAllParamsWithBodyPtr' does not accept request bodies of type '%s'", ctx.GetHeader("Content-Type")),
+				"PostWithAllParamsWithBodyPtr",
+			)
+			return
+		}
+		conversionErr = bindAndValidateBody(ctx, requestContentType, "", "", &theBodyRawPtr)
 		if conversionErr != nil {
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
@@ -1262,7 +1744,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
-			if continueOperation := middleware(ctx); continueOperation == false {
+			if continueOperation := middleware(ctx); !continueOperation {
 				return
 			}
 		}
@@ -1279,7 +1761,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError == nil {
 			// Middlewares afterOperationSuccessMiddlewares section
 			for _, middleware := range afterOperationSuccessMiddlewares {
-				if continueOperation := middleware(ctx); continueOperation == false {
+				if continueOperation := middleware(ctx); !continueOperation {
 					return
 				}
 			}
@@ -1288,7 +1770,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
-				if continueOperation := middleware(ctx, opError); continueOperation == false {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
 					return
 				}
 			}
@@ -1304,8 +1786,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			ctx.JSON(statusCode, stdError)
 			return
 		}
-		// json response extension placeholder
-		ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
 	})
 	engine.POST(toGinUrl("/e2e/post-with-all-params-body-required-ptr"), func(ctx *gin.Context) {
 		// route start routes extension placeholder
@@ -1329,11 +1813,29 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ctx, authErr, "PostWithAllParamsWithBodyRequiredPtr")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'PostWithAllParamsWithBodyRequiredPtr' cannot produce a response in any of the accepted media types",
+				"PostWithAllParamsWithBodyRequiredPtr",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var conversionErr error
 		var theBodyRawPtr *Param41theBody.BodyInfo = nil
-		conversionErr = bindAndValidateBody(ctx, "application/json", "required", &theBodyRawPtr)
+		requestContentType, isRequestContentTypeSupported := getRequestContentType(ctx.GetHeader("Content-Type"), []string{"application/json"})
+		if !isRequestContentTypeSupported {
+			handleContentNegotiationError(ctx,
+				http.StatusUnsupportedMediaType,
+				fmt.Sprintf("Operation 'PostWithAllParamsWithBodyRequiredPtr' does not accept request bodies of type '%s'", ctx.GetHeader("Content-Type")),
+				"PostWithAllParamsWithBodyRequiredPtr",
+			)
+			return
+		}
+		conversionErr = bindAndValidateBody(ctx, requestContentType, "required", "", &theBodyRawPtr)
 		if conversionErr != nil {
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
@@ -1352,7 +1854,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
-			if continueOperation := middleware(ctx); continueOperation == false {
+			if continueOperation := middleware(ctx); !continueOperation {
 				return
 			}
 		}
@@ -1369,7 +1871,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError == nil {
 			// Middlewares afterOperationSuccessMiddlewares section
 			for _, middleware := range afterOperationSuccessMiddlewares {
-				if continueOperation := middleware(ctx); continueOperation == false {
+				if continueOperation := middleware(ctx); !continueOperation {
 					return
 				}
 			}
@@ -1378,7 +1880,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
-				if continueOperation := middleware(ctx, opError); continueOperation == false {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
 					return
 				}
 			}
@@ -1394,8 +1896,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			ctx.JSON(statusCode, stdError)
 			return
 		}
-		// json response extension placeholder
-		ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
 	})
 	engine.GET(toGinUrl("/e2e/get-header-start-with-letter"), func(ctx *gin.Context) {
 		// route start routes extension placeholder
@@ -1419,6 +1923,15 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ctx, authErr, "GetHeaderStartWithLetter")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'GetHeaderStartWithLetter' cannot produce a response in any of the accepted media types",
+				"GetHeaderStartWithLetter",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var headerParamRawPtr *string = nil
@@ -1436,7 +1949,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
-			if continueOperation := middleware(ctx); continueOperation == false {
+			if continueOperation := middleware(ctx); !continueOperation {
 				return
 			}
 		}
@@ -1453,7 +1966,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError == nil {
 			// Middlewares afterOperationSuccessMiddlewares section
 			for _, middleware := range afterOperationSuccessMiddlewares {
-				if continueOperation := middleware(ctx); continueOperation == false {
+				if continueOperation := middleware(ctx); !continueOperation {
 					return
 				}
 			}
@@ -1462,7 +1975,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
-				if continueOperation := middleware(ctx, opError); continueOperation == false {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
 					return
 				}
 			}
@@ -1478,8 +1991,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			ctx.JSON(statusCode, stdError)
 			return
 		}
-		// json response extension placeholder
-		ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
 	})
 	engine.GET(toGinUrl("/e2e/with-default-config-security"), func(ctx *gin.Context) {
 		// route start routes extension placeholder
@@ -1503,6 +2018,15 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ctx, authErr, "WithDefaultConfigSecurity")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'WithDefaultConfigSecurity' cannot produce a response in any of the accepted media types",
+				"WithDefaultConfigSecurity",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var headerParamRawPtr *string = nil
@@ -1520,7 +2044,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
-			if continueOperation := middleware(ctx); continueOperation == false {
+			if continueOperation := middleware(ctx); !continueOperation {
 				return
 			}
 		}
@@ -1537,7 +2061,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError == nil {
 			// Middlewares afterOperationSuccessMiddlewares section
 			for _, middleware := range afterOperationSuccessMiddlewares {
-				if continueOperation := middleware(ctx); continueOperation == false {
+				if continueOperation := middleware(ctx); !continueOperation {
 					return
 				}
 			}
@@ -1546,7 +2070,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
-				if continueOperation := middleware(ctx, opError); continueOperation == false {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
 					return
 				}
 			}
@@ -1562,8 +2086,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			ctx.JSON(statusCode, stdError)
 			return
 		}
-		// json response extension placeholder
-		ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
 	})
 	engine.GET(toGinUrl("/e2e/with-one-security"), func(ctx *gin.Context) {
 		// route start routes extension placeholder
@@ -1587,6 +2113,15 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ctx, authErr, "WithOneSecurity")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'WithOneSecurity' cannot produce a response in any of the accepted media types",
+				"WithOneSecurity",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var headerParamRawPtr *string = nil
@@ -1604,7 +2139,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
-			if continueOperation := middleware(ctx); continueOperation == false {
+			if continueOperation := middleware(ctx); !continueOperation {
 				return
 			}
 		}
@@ -1621,7 +2156,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError == nil {
 			// Middlewares afterOperationSuccessMiddlewares section
 			for _, middleware := range afterOperationSuccessMiddlewares {
-				if continueOperation := middleware(ctx); continueOperation == false {
+				if continueOperation := middleware(ctx); !continueOperation {
 					return
 				}
 			}
@@ -1630,7 +2165,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
-				if continueOperation := middleware(ctx, opError); continueOperation == false {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
 					return
 				}
 			}
@@ -1646,8 +2181,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			ctx.JSON(statusCode, stdError)
 			return
 		}
-		// json response extension placeholder
-		ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
 	})
 	engine.GET(toGinUrl("/e2e/with-two-security"), func(ctx *gin.Context) {
 		// route start routes extension placeholder
@@ -1683,6 +2220,15 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ctx, authErr, "WithTwoSecurity")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'WithTwoSecurity' cannot produce a response in any of the accepted media types",
+				"WithTwoSecurity",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var headerParamRawPtr *string = nil
@@ -1700,7 +2246,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
-			if continueOperation := middleware(ctx); continueOperation == false {
+			if continueOperation := middleware(ctx); !continueOperation {
 				return
 			}
 		}
@@ -1717,7 +2263,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError == nil {
 			// Middlewares afterOperationSuccessMiddlewares section
 			for _, middleware := range afterOperationSuccessMiddlewares {
-				if continueOperation := middleware(ctx); continueOperation == false {
+				if continueOperation := middleware(ctx); !continueOperation {
 					return
 				}
 			}
@@ -1726,7 +2272,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
-				if continueOperation := middleware(ctx, opError); continueOperation == false {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
 					return
 				}
 			}
@@ -1742,8 +2288,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			ctx.JSON(statusCode, stdError)
 			return
 		}
-		// json response extension placeholder
-		ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
 	})
 	engine.GET(toGinUrl("/e2e/with-two-security-same-method"), func(ctx *gin.Context) {
 		// route start routes extension placeholder
@@ -1779,6 +2327,15 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ctx, authErr, "WithTwoSecuritySameMethod")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'WithTwoSecuritySameMethod' cannot produce a response in any of the accepted media types",
+				"WithTwoSecuritySameMethod",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var headerParamRawPtr *string = nil
@@ -1796,7 +2353,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
-			if continueOperation := middleware(ctx); continueOperation == false {
+			if continueOperation := middleware(ctx); !continueOperation {
 				return
 			}
 		}
@@ -1813,7 +2370,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError == nil {
 			// Middlewares afterOperationSuccessMiddlewares section
 			for _, middleware := range afterOperationSuccessMiddlewares {
-				if continueOperation := middleware(ctx); continueOperation == false {
+				if continueOperation := middleware(ctx); !continueOperation {
 					return
 				}
 			}
@@ -1822,7 +2379,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
-				if continueOperation := middleware(ctx, opError); continueOperation == false {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
 					return
 				}
 			}
@@ -1838,8 +2395,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			ctx.JSON(statusCode, stdError)
 			return
 		}
-		// json response extension placeholder
-		ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
 	})
 	engine.GET(toGinUrl("/e2e/default-error"), func(ctx *gin.Context) {
 		// route start routes extension placeholder
@@ -1863,11 +2422,20 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ctx, authErr, "DefaultError")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'DefaultError' cannot produce a response in any of the accepted media types",
+				"DefaultError",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
-			if continueOperation := middleware(ctx); continueOperation == false {
+			if continueOperation := middleware(ctx); !continueOperation {
 				return
 			}
 		}
@@ -1884,7 +2452,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError == nil {
 			// Middlewares afterOperationSuccessMiddlewares section
 			for _, middleware := range afterOperationSuccessMiddlewares {
-				if continueOperation := middleware(ctx); continueOperation == false {
+				if continueOperation := middleware(ctx); !continueOperation {
 					return
 				}
 			}
@@ -1893,7 +2461,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
-				if continueOperation := middleware(ctx, opError); continueOperation == false {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
 					return
 				}
 			}
@@ -1909,9 +2477,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			ctx.JSON(statusCode, stdError)
 			return
 		}
-		// json response extension placeholder
-		ctx.Header("Content-Type", "application/json")
-		ctx.Status(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.Header("Content-Type", "application/json")
+			ctx.Status(statusCode)
+		}
 	})
 	engine.GET(toGinUrl("/e2e/default-error-with-payload"), func(ctx *gin.Context) {
 		// route start routes extension placeholder
@@ -1935,11 +2505,20 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ctx, authErr, "DefaultErrorWithPayload")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'DefaultErrorWithPayload' cannot produce a response in any of the accepted media types",
+				"DefaultErrorWithPayload",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
-			if continueOperation := middleware(ctx); continueOperation == false {
+			if continueOperation := middleware(ctx); !continueOperation {
 				return
 			}
 		}
@@ -1956,7 +2535,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError == nil {
 			// Middlewares afterOperationSuccessMiddlewares section
 			for _, middleware := range afterOperationSuccessMiddlewares {
-				if continueOperation := middleware(ctx); continueOperation == false {
+				if continueOperation := middleware(ctx); !continueOperation {
 					return
 				}
 			}
@@ -1965,7 +2544,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
-				if continueOperation := middleware(ctx, opError); continueOperation == false {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
 					return
 				}
 			}
@@ -1981,8 +2560,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			ctx.JSON(statusCode, stdError)
 			return
 		}
-		// json response extension placeholder
-		ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
 	})
 	engine.GET(toGinUrl("/e2e/custom-error"), func(ctx *gin.Context) {
 		// route start routes extension placeholder
@@ -2006,11 +2587,20 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ctx, authErr, "CustomError")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'CustomError' cannot produce a response in any of the accepted media types",
+				"CustomError",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
-			if continueOperation := middleware(ctx); continueOperation == false {
+			if continueOperation := middleware(ctx); !continueOperation {
 				return
 			}
 		}
@@ -2028,7 +2618,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError == emptyErr {
 			// Middlewares afterOperationSuccessMiddlewares section
 			for _, middleware := range afterOperationSuccessMiddlewares {
-				if continueOperation := middleware(ctx); continueOperation == false {
+				if continueOperation := middleware(ctx); !continueOperation {
 					return
 				}
 			}
@@ -2037,7 +2627,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError != emptyErr {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
-				if continueOperation := middleware(ctx, opError); continueOperation == false {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
 					return
 				}
 			}
@@ -2045,9 +2635,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			ctx.JSON(statusCode, opError)
 			return
 		}
-		// json response extension placeholder
-		ctx.Header("Content-Type", "application/json")
-		ctx.Status(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.Header("Content-Type", "application/json")
+			ctx.Status(statusCode)
+		}
 	})
 	engine.GET(toGinUrl("/e2e/custom-error-ptr"), func(ctx *gin.Context) {
 		// route start routes extension placeholder
@@ -2071,11 +2663,20 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ctx, authErr, "CustomPtrError")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'CustomPtrError' cannot produce a response in any of the accepted media types",
+				"CustomPtrError",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
-			if continueOperation := middleware(ctx); continueOperation == false {
+			if continueOperation := middleware(ctx); !continueOperation {
 				return
 			}
 		}
@@ -2092,7 +2693,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError == nil {
 			// Middlewares afterOperationSuccessMiddlewares section
 			for _, middleware := range afterOperationSuccessMiddlewares {
-				if continueOperation := middleware(ctx); continueOperation == false {
+				if continueOperation := middleware(ctx); !continueOperation {
 					return
 				}
 			}
@@ -2101,7 +2702,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
-				if continueOperation := middleware(ctx, opError); continueOperation == false {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
 					return
 				}
 			}
@@ -2109,9 +2710,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			ctx.JSON(statusCode, opError)
 			return
 		}
-		// json response extension placeholder
-		ctx.Header("Content-Type", "application/json")
-		ctx.Status(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.Header("Content-Type", "application/json")
+			ctx.Status(statusCode)
+		}
 	})
 	engine.GET(toGinUrl("/e2e/503-error-code"), func(ctx *gin.Context) {
 		// route start routes extension placeholder
@@ -2135,11 +2738,20 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ctx, authErr, "Error503")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'Error503' cannot produce a response in any of the accepted media types",
+				"Error503",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
-			if continueOperation := middleware(ctx); continueOperation == false {
+			if continueOperation := middleware(ctx); !continueOperation {
 				return
 			}
 		}
@@ -2156,7 +2768,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError == nil {
 			// Middlewares afterOperationSuccessMiddlewares section
 			for _, middleware := range afterOperationSuccessMiddlewares {
-				if continueOperation := middleware(ctx); continueOperation == false {
+				if continueOperation := middleware(ctx); !continueOperation {
 					return
 				}
 			}
@@ -2165,7 +2777,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
-				if continueOperation := middleware(ctx, opError); continueOperation == false {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
 					return
 				}
 			}
@@ -2181,9 +2793,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			ctx.JSON(statusCode, stdError)
 			return
 		}
-		// json response extension placeholder
-		ctx.Header("Content-Type", "application/json")
-		ctx.Status(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.Header("Content-Type", "application/json")
+			ctx.Status(statusCode)
+		}
 	})
 	engine.GET(toGinUrl("/e2e/custom-error-503"), func(ctx *gin.Context) {
 		// route start routes extension placeholder
@@ -2207,11 +2821,20 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ctx, authErr, "CustomError503")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'CustomError503' cannot produce a response in any of the accepted media types",
+				"CustomError503",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
-			if continueOperation := middleware(ctx); continueOperation == false {
+			if continueOperation := middleware(ctx); !continueOperation {
 				return
 			}
 		}
@@ -2229,7 +2852,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError == emptyErr {
 			// Middlewares afterOperationSuccessMiddlewares section
 			for _, middleware := range afterOperationSuccessMiddlewares {
-				if continueOperation := middleware(ctx); continueOperation == false {
+				if continueOperation := middleware(ctx); !continueOperation {
 					return
 				}
 			}
@@ -2238,7 +2861,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError != emptyErr {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
-				if continueOperation := middleware(ctx, opError); continueOperation == false {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
 					return
 				}
 			}
@@ -2246,9 +2869,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			ctx.JSON(statusCode, opError)
 			return
 		}
-		// json response extension placeholder
-		ctx.Header("Content-Type", "application/json")
-		ctx.Status(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.Header("Content-Type", "application/json")
+			ctx.Status(statusCode)
+		}
 	})
 	engine.GET(toGinUrl("/e2e/context-access"), func(ctx *gin.Context) {
 		// route start routes extension placeholder
@@ -2272,11 +2897,20 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ctx, authErr, "ContextAccess")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'ContextAccess' cannot produce a response in any of the accepted media types",
+				"ContextAccess",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
-			if continueOperation := middleware(ctx); continueOperation == false {
+			if continueOperation := middleware(ctx); !continueOperation {
 				return
 			}
 		}
@@ -2293,7 +2927,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError == nil {
 			// Middlewares afterOperationSuccessMiddlewares section
 			for _, middleware := range afterOperationSuccessMiddlewares {
-				if continueOperation := middleware(ctx); continueOperation == false {
+				if continueOperation := middleware(ctx); !continueOperation {
 					return
 				}
 			}
@@ -2302,7 +2936,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
-				if continueOperation := middleware(ctx, opError); continueOperation == false {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
 					return
 				}
 			}
@@ -2318,9 +2952,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			ctx.JSON(statusCode, stdError)
 			return
 		}
-		// json response extension placeholder
-		ctx.Header("Content-Type", "application/json")
-		ctx.Status(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.Header("Content-Type", "application/json")
+			ctx.Status(statusCode)
+		}
 	})
 	engine.GET(toGinUrl("/e2e/http-method"), func(ctx *gin.Context) {
 		// route start routes extension placeholder
@@ -2344,11 +2980,20 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ctx, authErr, "Get")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'Get' cannot produce a response in any of the accepted media types",
+				"Get",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
-			if continueOperation := middleware(ctx); continueOperation == false {
+			if continueOperation := middleware(ctx); !continueOperation {
 				return
 			}
 		}
@@ -2365,7 +3010,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError == nil {
 			// Middlewares afterOperationSuccessMiddlewares section
 			for _, middleware := range afterOperationSuccessMiddlewares {
-				if continueOperation := middleware(ctx); continueOperation == false {
+				if continueOperation := middleware(ctx); !continueOperation {
 					return
 				}
 			}
@@ -2374,7 +3019,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
-				if continueOperation := middleware(ctx, opError); continueOperation == false {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
 					return
 				}
 			}
@@ -2390,9 +3035,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			ctx.JSON(statusCode, stdError)
 			return
 		}
-		// json response extension placeholder
-		ctx.Header("Content-Type", "application/json")
-		ctx.Status(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.Header("Content-Type", "application/json")
+			ctx.Status(statusCode)
+		}
 	})
 	engine.POST(toGinUrl("/e2e/http-method"), func(ctx *gin.Context) {
 		// route start routes extension placeholder
@@ -2416,11 +3063,20 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ctx, authErr, "Post")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'Post' cannot produce a response in any of the accepted media types",
+				"Post",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
-			if continueOperation := middleware(ctx); continueOperation == false {
+			if continueOperation := middleware(ctx); !continueOperation {
 				return
 			}
 		}
@@ -2437,7 +3093,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError == nil {
 			// Middlewares afterOperationSuccessMiddlewares section
 			for _, middleware := range afterOperationSuccessMiddlewares {
-				if continueOperation := middleware(ctx); continueOperation == false {
+				if continueOperation := middleware(ctx); !continueOperation {
 					return
 				}
 			}
@@ -2446,7 +3102,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
-				if continueOperation := middleware(ctx, opError); continueOperation == false {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
 					return
 				}
 			}
@@ -2462,9 +3118,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			ctx.JSON(statusCode, stdError)
 			return
 		}
-		// json response extension placeholder
-		ctx.Header("Content-Type", "application/json")
-		ctx.Status(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.Header("Content-Type", "application/json")
+			ctx.Status(statusCode)
+		}
 	})
 	engine.PUT(toGinUrl("/e2e/http-method"), func(ctx *gin.Context) {
 		// route start routes extension placeholder
@@ -2488,11 +3146,20 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ctx, authErr, "Put")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'Put' cannot produce a response in any of the accepted media types",
+				"Put",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
-			if continueOperation := middleware(ctx); continueOperation == false {
+			if continueOperation := middleware(ctx); !continueOperation {
 				return
 			}
 		}
@@ -2509,7 +3176,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError == nil {
 			// Middlewares afterOperationSuccessMiddlewares section
 			for _, middleware := range afterOperationSuccessMiddlewares {
-				if continueOperation := middleware(ctx); continueOperation == false {
+				if continueOperation := middleware(ctx); !continueOperation {
 					return
 				}
 			}
@@ -2518,7 +3185,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
-				if continueOperation := middleware(ctx, opError); continueOperation == false {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
 					return
 				}
 			}
@@ -2534,9 +3201,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			ctx.JSON(statusCode, stdError)
 			return
 		}
-		// json response extension placeholder
-		ctx.Header("Content-Type", "application/json")
-		ctx.Status(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.Header("Content-Type", "application/json")
+			ctx.Status(statusCode)
+		}
 	})
 	engine.DELETE(toGinUrl("/e2e/http-method"), func(ctx *gin.Context) {
 		// route start routes extension placeholder
@@ -2560,11 +3229,20 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ctx, authErr, "Delete")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'Delete' cannot produce a response in any of the accepted media types",
+				"Delete",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
-			if continueOperation := middleware(ctx); continueOperation == false {
+			if continueOperation := middleware(ctx); !continueOperation {
 				return
 			}
 		}
@@ -2581,7 +3259,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError == nil {
 			// Middlewares afterOperationSuccessMiddlewares section
 			for _, middleware := range afterOperationSuccessMiddlewares {
-				if continueOperation := middleware(ctx); continueOperation == false {
+				if continueOperation := middleware(ctx); !continueOperation {
 					return
 				}
 			}
@@ -2590,7 +3268,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
-				if continueOperation := middleware(ctx, opError); continueOperation == false {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
 					return
 				}
 			}
@@ -2606,9 +3284,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			ctx.JSON(statusCode, stdError)
 			return
 		}
-		// json response extension placeholder
-		ctx.Header("Content-Type", "application/json")
-		ctx.Status(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.Header("Content-Type", "application/json")
+			ctx.Status(statusCode)
+		}
 	})
 	engine.PATCH(toGinUrl("/e2e/http-method"), func(ctx *gin.Context) {
 		// route start routes extension placeholder
@@ -2632,11 +3312,20 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ctx, authErr, "Patch")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'Patch' cannot produce a response in any of the accepted media types",
+				"Patch",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
-			if continueOperation := middleware(ctx); continueOperation == false {
+			if continueOperation := middleware(ctx); !continueOperation {
 				return
 			}
 		}
@@ -2653,7 +3342,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError == nil {
 			// Middlewares afterOperationSuccessMiddlewares section
 			for _, middleware := range afterOperationSuccessMiddlewares {
-				if continueOperation := middleware(ctx); continueOperation == false {
+				if continueOperation := middleware(ctx); !continueOperation {
 					return
 				}
 			}
@@ -2662,7 +3351,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
-				if continueOperation := middleware(ctx, opError); continueOperation == false {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
 					return
 				}
 			}
@@ -2678,9 +3367,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			ctx.JSON(statusCode, stdError)
 			return
 		}
-		// json response extension placeholder
-		ctx.Header("Content-Type", "application/json")
-		ctx.Status(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.Header("Content-Type", "application/json")
+			ctx.Status(statusCode)
+		}
 	})
 	engine.GET(toGinUrl("/e2e/template-context-1"), func(ctx *gin.Context) {
 		// route start routes extension placeholder
@@ -2704,11 +3395,20 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ctx, authErr, "TemplateContext1")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'TemplateContext1' cannot produce a response in any of the accepted media types",
+				"TemplateContext1",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
-			if continueOperation := middleware(ctx); continueOperation == false {
+			if continueOperation := middleware(ctx); !continueOperation {
 				return
 			}
 		}
@@ -2726,7 +3426,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError == nil {
 			// Middlewares afterOperationSuccessMiddlewares section
 			for _, middleware := range afterOperationSuccessMiddlewares {
-				if continueOperation := middleware(ctx); continueOperation == false {
+				if continueOperation := middleware(ctx); !continueOperation {
 					return
 				}
 			}
@@ -2735,7 +3435,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
-				if continueOperation := middleware(ctx, opError); continueOperation == false {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
 					return
 				}
 			}
@@ -2751,8 +3451,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			ctx.JSON(statusCode, stdError)
 			return
 		}
-		// json response extension placeholder
-		ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
 	})
 	engine.GET(toGinUrl("/e2e/template-context-2"), func(ctx *gin.Context) {
 		// route start routes extension placeholder
@@ -2776,11 +3478,20 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ctx, authErr, "TemplateContext2")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'TemplateContext2' cannot produce a response in any of the accepted media types",
+				"TemplateContext2",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
-			if continueOperation := middleware(ctx); continueOperation == false {
+			if continueOperation := middleware(ctx); !continueOperation {
 				return
 			}
 		}
@@ -2799,7 +3510,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError == nil {
 			// Middlewares afterOperationSuccessMiddlewares section
 			for _, middleware := range afterOperationSuccessMiddlewares {
-				if continueOperation := middleware(ctx); continueOperation == false {
+				if continueOperation := middleware(ctx); !continueOperation {
 					return
 				}
 			}
@@ -2808,7 +3519,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
-				if continueOperation := middleware(ctx, opError); continueOperation == false {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
 					return
 				}
 			}
@@ -2824,8 +3535,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			ctx.JSON(statusCode, stdError)
 			return
 		}
-		// json response extension placeholder
-		ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
 	})
 	engine.POST(toGinUrl("/e2e/form"), func(ctx *gin.Context) {
 		// route start routes extension placeholder
@@ -2849,6 +3562,15 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ctx, authErr, "TestForm")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'TestForm' cannot produce a response in any of the accepted media types",
+				"TestForm",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var item1RawPtr *string = nil
@@ -2877,7 +3599,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
-			if continueOperation := middleware(ctx); continueOperation == false {
+			if continueOperation := middleware(ctx); !continueOperation {
 				return
 			}
 		}
@@ -2894,7 +3616,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError == nil {
 			// Middlewares afterOperationSuccessMiddlewares section
 			for _, middleware := range afterOperationSuccessMiddlewares {
-				if continueOperation := middleware(ctx); continueOperation == false {
+				if continueOperation := middleware(ctx); !continueOperation {
 					return
 				}
 			}
@@ -2903,7 +3625,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
-				if continueOperation := middleware(ctx, opError); continueOperation == false {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
 					return
 				}
 			}
@@ -2919,11 +3641,12 @@ func RegisterRoutes(engine *gin.Engine) {
 			ctx.JSON(statusCode, stdError)
 			return
 		}
-		// json response extension placeholder
-		ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
 	})
-	// E2EClassSecController
-	engine.GET(toGinUrl("/e2e/with-default-class-security"), func(ctx *gin.Context) {
+	engine.GET(toGinUrl("/e2e/enum-params/{status}"), func(ctx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ctx,
@@ -2932,9 +3655,9 @@ func RegisterRoutes(engine *gin.Engine) {
 					Relation: SecurityListRelationAnd,
 					Checks: []runtime.SecurityCheck{
 						{
-							SchemaName: "securitySchemaName",
+							SchemaName: "securitySchemaName2",
 							Scopes: []string{
-								"class",
+								"config",
 							},
 						},
 					},
@@ -2942,17 +3665,3121 @@ func RegisterRoutes(engine *gin.Engine) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(ctx, authErr, "WithDefaultClassSecurity")
+			handleAuthorizationError(ctx, authErr, "EnumParams")
 			return
 		}
-		controller := E2EClassSecControllerImport.E2EClassSecController{}
-		controller.InitController(ctx)
-		var headerParamRawPtr *string = nil
-		headerParamRaw := ctx.GetHeader("x-test-scopes")
-		_, isheaderParamExists := ctx.Request.Header[textproto.CanonicalMIMEHeaderKey("x-test-scopes")]
-		if isheaderParamExists {
-			headerParam := headerParamRaw
-			headerParamRawPtr = &headerParam
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'EnumParams' cannot produce a response in any of the accepted media types",
+				"EnumParams",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var statusRawPtr *Param80status.OrderStatus = nil
+		statusRaw, isstatusExists := ctx.Params.Get("status")
+		if isstatusExists {
+			status := statusRaw
+			statusEnum := Param80status.OrderStatus(status)
+			if !slices.Contains([]Param80status.OrderStatus{"pending", "shipped", "delivered"}, statusEnum) {
+				conversionErr := fmt.Errorf("'%s' is not a valid OrderStatus value", statusRaw)
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'EnumParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"status",
+						"OrderStatus",
+						reflect.TypeOf(statusRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/EnumParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				ctx.JSON(http.StatusUnprocessableEntity, validationError)
+				return
+			}
+			statusRawPtr = &statusEnum
+		}
+		if validatorErr := validatorInstance.Var(statusRawPtr, "required"); validatorErr != nil {
+			fieldName := "status"
+			validationError := wrapValidatorError(validatorErr, "EnumParams", fieldName)
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		var priorityRawPtr *Param81priority.OrderPriority = nil
+		priorityRaw, ispriorityExists := ctx.GetQuery("priority")
+		if ispriorityExists {
+			priorityUint64, conversionErr := strconv.Atoi(priorityRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'EnumParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"priority",
+						"OrderPriority",
+						reflect.TypeOf(priorityRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/EnumParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				ctx.JSON(http.StatusUnprocessableEntity, validationError)
+				return
+			}
+			priority := int(priorityUint64)
+			priorityEnum := Param81priority.OrderPriority(priority)
+			if !slices.Contains([]Param81priority.OrderPriority{0, 1, 2}, priorityEnum) {
+				conversionErr := fmt.Errorf("'%s' is not a valid OrderPriority value", priorityRaw)
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'EnumParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"priority",
+						"OrderPriority",
+						reflect.TypeOf(priorityRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/EnumParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				ctx.JSON(http.StatusUnprocessableEntity, validationError)
+				return
+			}
+			priorityRawPtr = &priorityEnum
+		}
+		if validatorErr := validatorInstance.Var(priorityRawPtr, "required"); validatorErr != nil {
+			fieldName := "priority"
+			validationError := wrapValidatorError(validatorErr, "EnumParams", fieldName)
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		var headerStatusRawPtr *Param82headerStatus.OrderStatus = nil
+		headerStatusRaw := ctx.GetHeader("x-status")
+		_, isheaderStatusExists := ctx.Request.Header[textproto.CanonicalMIMEHeaderKey("x-status")]
+		if isheaderStatusExists {
+			headerStatus := headerStatusRaw
+			headerStatusEnum := Param82headerStatus.OrderStatus(headerStatus)
+			if !slices.Contains([]Param82headerStatus.OrderStatus{"pending", "shipped", "delivered"}, headerStatusEnum) {
+				conversionErr := fmt.Errorf("'%s' is not a valid OrderStatus value", headerStatusRaw)
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'EnumParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"headerStatus",
+						"OrderStatus",
+						reflect.TypeOf(headerStatusRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/EnumParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				ctx.JSON(http.StatusUnprocessableEntity, validationError)
+				return
+			}
+			headerStatusRawPtr = &headerStatusEnum
+		}
+		if validatorErr := validatorInstance.Var(headerStatusRawPtr, "required"); validatorErr != nil {
+			fieldName := "headerStatus"
+			validationError := wrapValidatorError(validatorErr, "EnumParams", fieldName)
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.EnumParams(*statusRawPtr, *priorityRawPtr, *headerStatusRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Header(key, value)
+		}
+		ctx.Header("x-inject", "true")
+		ctx.Header("x-extended", "EnumParams")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); !continueOperation {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'EnumParams'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/EnumParams",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			ctx.JSON(statusCode, stdError)
+			return
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
+	})
+	engine.GET(toGinUrl("/e2e/well-known-params/{id}"), func(ctx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ctx, authErr, "WellKnownParams")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'WellKnownParams' cannot produce a response in any of the accepted media types",
+				"WellKnownParams",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var idRawPtr *Param85id.UUID = nil
+		idRaw, isidExists := ctx.Params.Get("id")
+		if isidExists {
+			id, conversionErr := Param85id.Parse(idRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'WellKnownParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"id",
+						"UUID",
+						reflect.TypeOf(idRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/WellKnownParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				ctx.JSON(http.StatusUnprocessableEntity, validationError)
+				return
+			}
+			idRawPtr = &id
+		}
+		if validatorErr := validatorInstance.Var(idRawPtr, "required"); validatorErr != nil {
+			fieldName := "id"
+			validationError := wrapValidatorError(validatorErr, "WellKnownParams", fieldName)
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		var atRawPtr *Param86at.Time = nil
+		atRaw, isatExists := ctx.GetQuery("at")
+		if isatExists {
+			at, conversionErr := Param86at.Parse(Param86at.RFC3339, atRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'WellKnownParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"at",
+						"Time",
+						reflect.TypeOf(atRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/WellKnownParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				ctx.JSON(http.StatusUnprocessableEntity, validationError)
+				return
+			}
+			atRawPtr = &at
+		}
+		if validatorErr := validatorInstance.Var(atRawPtr, "required"); validatorErr != nil {
+			fieldName := "at"
+			validationError := wrapValidatorError(validatorErr, "WellKnownParams", fieldName)
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		var timeoutRawPtr *Param87timeout.Duration = nil
+		timeoutRaw, istimeoutExists := ctx.GetQuery("timeout")
+		if istimeoutExists {
+			timeoutInt64, conversionErr := strconv.ParseInt(timeoutRaw, 10, 64)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'WellKnownParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"timeout",
+						"Duration",
+						reflect.TypeOf(timeoutRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/WellKnownParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				ctx.JSON(http.StatusUnprocessableEntity, validationError)
+				return
+			}
+			timeout := Param87timeout.Duration(timeoutInt64)
+			timeoutRawPtr = &timeout
+		}
+		if validatorErr := validatorInstance.Var(timeoutRawPtr, "required"); validatorErr != nil {
+			fieldName := "timeout"
+			validationError := wrapValidatorError(validatorErr, "WellKnownParams", fieldName)
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		var dataRawPtr *[]byte = nil
+		dataRaw, isdataExists := ctx.GetQuery("data")
+		if isdataExists {
+			data, conversionErr := base64.StdEncoding.DecodeString(dataRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'WellKnownParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"data",
+						"[]byte",
+						reflect.TypeOf(dataRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/WellKnownParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				ctx.JSON(http.StatusUnprocessableEntity, validationError)
+				return
+			}
+			dataRawPtr = &data
+		}
+		if validatorErr := validatorInstance.Var(dataRawPtr, "required"); validatorErr != nil {
+			fieldName := "data"
+			validationError := wrapValidatorError(validatorErr, "WellKnownParams", fieldName)
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		var rawRawPtr *Param89raw.RawMessage = nil
+		rawRaw, israwExists := ctx.GetQuery("raw")
+		if israwExists {
+			raw := Param89raw.RawMessage(rawRaw)
+			if !Param89raw.Valid(raw) {
+				conversionErr := fmt.Errorf("'%s' is not valid JSON", rawRaw)
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'WellKnownParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"raw",
+						"RawMessage",
+						reflect.TypeOf(rawRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/WellKnownParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				ctx.JSON(http.StatusUnprocessableEntity, validationError)
+				return
+			}
+			rawRawPtr = &raw
+		}
+		if validatorErr := validatorInstance.Var(rawRawPtr, "required"); validatorErr != nil {
+			fieldName := "raw"
+			validationError := wrapValidatorError(validatorErr, "WellKnownParams", fieldName)
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		var addressRawPtr *Param90address.IP = nil
+		addressRaw := ctx.GetHeader("x-address")
+		_, isaddressExists := ctx.Request.Header[textproto.CanonicalMIMEHeaderKey("x-address")]
+		if isaddressExists {
+			address := Param90address.ParseIP(addressRaw)
+			if address == nil {
+				conversionErr := fmt.Errorf("'%s' is not a valid IP address", addressRaw)
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'WellKnownParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"address",
+						"IP",
+						reflect.TypeOf(addressRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/WellKnownParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				ctx.JSON(http.StatusUnprocessableEntity, validationError)
+				return
+			}
+			addressRawPtr = &address
+		}
+		if validatorErr := validatorInstance.Var(addressRawPtr, "required"); validatorErr != nil {
+			fieldName := "address"
+			validationError := wrapValidatorError(validatorErr, "WellKnownParams", fieldName)
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		var linkRawPtr *Param91link.URL = nil
+		linkRaw, islinkExists := ctx.GetQuery("link")
+		if islinkExists {
+			linkUrl, conversionErr := Param91link.ParseRequestURI(linkRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'WellKnownParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"link",
+						"URL",
+						reflect.TypeOf(linkRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/WellKnownParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				ctx.JSON(http.StatusUnprocessableEntity, validationError)
+				return
+			}
+			link := *linkUrl
+			linkRawPtr = &link
+		}
+		if validatorErr := validatorInstance.Var(linkRawPtr, "required"); validatorErr != nil {
+			fieldName := "link"
+			validationError := wrapValidatorError(validatorErr, "WellKnownParams", fieldName)
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.WellKnownParams(*idRawPtr, *atRawPtr, *timeoutRawPtr, *dataRawPtr, *rawRawPtr, *addressRawPtr, *linkRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Header(key, value)
+		}
+		ctx.Header("x-inject", "true")
+		ctx.Header("x-extended", "WellKnownParams")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); !continueOperation {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WellKnownParams'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/WellKnownParams",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			ctx.JSON(statusCode, stdError)
+			return
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
+	})
+	engine.GET(toGinUrl("/e2e/mapped-params/{amount}"), func(ctx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ctx, authErr, "MappedParams")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'MappedParams' cannot produce a response in any of the accepted media types",
+				"MappedParams",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var amountRawPtr *Param94amount.Amount = nil
+		amountRaw, isamountExists := ctx.Params.Get("amount")
+		if isamountExists {
+			amount, conversionErr := ParamParser94amount.ParseAmount(amountRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'MappedParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"amount",
+						"Amount",
+						reflect.TypeOf(amountRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/MappedParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				ctx.JSON(http.StatusUnprocessableEntity, validationError)
+				return
+			}
+			amountRawPtr = &amount
+		}
+		if validatorErr := validatorInstance.Var(amountRawPtr, "required"); validatorErr != nil {
+			fieldName := "amount"
+			validationError := wrapValidatorError(validatorErr, "MappedParams", fieldName)
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		var tipRawPtr *Param95tip.Amount = nil
+		tipRaw, istipExists := ctx.GetQuery("tip")
+		if istipExists {
+			tip, conversionErr := ParamParser95tip.ParseAmount(tipRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'MappedParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"tip",
+						"Amount",
+						reflect.TypeOf(tipRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/MappedParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				ctx.JSON(http.StatusUnprocessableEntity, validationError)
+				return
+			}
+			tipRawPtr = &tip
+		}
+		if validatorErr := validatorInstance.Var(tipRawPtr, "required"); validatorErr != nil {
+			fieldName := "tip"
+			validationError := wrapValidatorError(validatorErr, "MappedParams", fieldName)
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.MappedParams(*amountRawPtr, *tipRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Header(key, value)
+		}
+		ctx.Header("x-inject", "true")
+		ctx.Header("x-extended", "MappedParams")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); !continueOperation {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'MappedParams'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/MappedParams",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			ctx.JSON(statusCode, stdError)
+			return
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
+	})
+	engine.POST(toGinUrl("/e2e/generic-envelope"), func(ctx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ctx, authErr, "GenericEnvelope")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'GenericEnvelope' cannot produce a response in any of the accepted media types",
+				"GenericEnvelope",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var conversionErr error
+		var envelopeRawPtr *Param98envelope.Envelope[Param98envelopeArg0.BodyInfo] = nil
+		requestContentType, isRequestContentTypeSupported := getRequestContentType(ctx.GetHeader("Content-Type"), []string{"application/json"})
+		if !isRequestContentTypeSupported {
+			handleContentNegotiationError(ctx,
+				http.StatusUnsupportedMediaType,
+				fmt.Sprintf("Operation 'GenericEnvelope' does not accept request bodies of type '%s'", ctx.GetHeader("Content-Type")),
+				"GenericEnvelope",
+			)
+			return
+		}
+		conversionErr = bindAndValidateBody(ctx, requestContentType, "required", "", &envelopeRawPtr)
+		if conversionErr != nil {
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: fmt.Sprintf(
+					"A request was made to operation 'GenericEnvelope' but body parameter '%s' did not pass validation of '%s' - %s",
+					"envelope",
+					"Envelope",
+					extractValidationErrorMessage(conversionErr, nil),
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/gleece/validation/error/GenericEnvelope",
+			}
+			// json body validation error response extension placeholder
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.GenericEnvelope(*envelopeRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Header(key, value)
+		}
+		ctx.Header("x-inject", "true")
+		ctx.Header("x-extended", "GenericEnvelope")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); !continueOperation {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'GenericEnvelope'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/GenericEnvelope",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			ctx.JSON(statusCode, stdError)
+			return
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
+	})
+	engine.POST(toGinUrl("/e2e/generic-envelope-map"), func(ctx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ctx, authErr, "GenericEnvelopeMap")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'GenericEnvelopeMap' cannot produce a response in any of the accepted media types",
+				"GenericEnvelopeMap",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var conversionErr error
+		var envelopeRawPtr *Param101envelope.Envelope[map[Param101envelopeArg0Key.Region]Param101envelopeArg0Value.BodyInfo] = nil
+		requestContentType, isRequestContentTypeSupported := getRequestContentType(ctx.GetHeader("Content-Type"), []string{"application/json"})
+		if !isRequestContentTypeSupported {
+			handleContentNegotiationError(ctx,
+				http.StatusUnsupportedMediaType,
+				fmt.Sprintf("Operation 'GenericEnvelopeMap' does not accept request bodies of type '%s'", ctx.GetHeader("Content-Type")),
+				"GenericEnvelopeMap",
+			)
+			return
+		}
+		conversionErr = bindAndValidateBody(ctx, requestContentType, "required", "", &envelopeRawPtr)
+		if conversionErr != nil {
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: fmt.Sprintf(
+					"A request was made to operation 'GenericEnvelopeMap' but body parameter '%s' did not pass validation of '%s' - %s",
+					"envelope",
+					"Envelope",
+					extractValidationErrorMessage(conversionErr, nil),
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/gleece/validation/error/GenericEnvelopeMap",
+			}
+			// json body validation error response extension placeholder
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.GenericEnvelopeMap(*envelopeRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Header(key, value)
+		}
+		ctx.Header("x-inject", "true")
+		ctx.Header("x-extended", "GenericEnvelopeMap")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); !continueOperation {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'GenericEnvelopeMap'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/GenericEnvelopeMap",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			ctx.JSON(statusCode, stdError)
+			return
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
+	})
+	engine.GET(toGinUrl("/e2e/slice-params"), func(ctx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ctx, authErr, "SliceParams")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'SliceParams' cannot produce a response in any of the accepted media types",
+				"SliceParams",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		idsRawValues, isidsExists := ctx.GetQueryArray("ids")
+		var idsRawPtr *[]int = nil
+		if isidsExists {
+			ids := []int{}
+			for _, paramValue := range splitParamValues(idsRawValues, "") {
+				var idsItemRawPtr *int = nil
+				idsItemRaw := paramValue
+				isidsItemExists := true
+				if isidsItemExists {
+					idsItemUint64, conversionErr := strconv.Atoi(idsItemRaw)
+					if conversionErr != nil {
+						validationError := runtime.Rfc7807Error{
+							Type: http.StatusText(http.StatusUnprocessableEntity),
+							Detail: fmt.Sprintf(
+								"A request was made to operation 'SliceParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+								"idsItem",
+								"int",
+								reflect.TypeOf(idsItemRaw).String(),
+							),
+							Status:     http.StatusUnprocessableEntity,
+							Instance:   "/gleece/validation/error/SliceParams",
+							Extensions: map[string]string{"error": conversionErr.Error()},
+						}
+						// json validation error response extension placeholder
+						ctx.JSON(http.StatusUnprocessableEntity, validationError)
+						return
+					}
+					idsItem := int(idsItemUint64)
+					idsItemRawPtr = &idsItem
+				}
+				ids = append(ids, *idsItemRawPtr)
+			}
+			idsRawPtr = &ids
+		}
+		if validatorErr := validatorInstance.Var(idsRawPtr, "required"); validatorErr != nil {
+			fieldName := "ids"
+			validationError := wrapValidatorError(validatorErr, "SliceParams", fieldName)
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		tagsRawValues, istagsExists := ctx.GetQueryArray("tags")
+		var tagsRawPtr *[]string = nil
+		if istagsExists {
+			tags := []string{}
+			for _, paramValue := range splitParamValues(tagsRawValues, ",") {
+				var tagsItemRawPtr *string = nil
+				tagsItemRaw := paramValue
+				istagsItemExists := true
+				if istagsItemExists {
+					tagsItem := tagsItemRaw
+					tagsItemRawPtr = &tagsItem
+				}
+				tags = append(tags, *tagsItemRawPtr)
+			}
+			tagsRawPtr = &tags
+		}
+		if validatorErr := validatorInstance.Var(tagsRawPtr, "required"); validatorErr != nil {
+			fieldName := "tags"
+			validationError := wrapValidatorError(validatorErr, "SliceParams", fieldName)
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		namesRawValues, isnamesExists := ctx.GetQueryArray("names")
+		var namesRawPtr *[]string = nil
+		if isnamesExists {
+			names := []string{}
+			for _, paramValue := range splitParamValues(namesRawValues, "|") {
+				var namesItemRawPtr *string = nil
+				namesItemRaw := paramValue
+				isnamesItemExists := true
+				if isnamesItemExists {
+					namesItem := namesItemRaw
+					namesItemRawPtr = &namesItem
+				}
+				names = append(names, *namesItemRawPtr)
+			}
+			namesRawPtr = &names
+		}
+		if validatorErr := validatorInstance.Var(namesRawPtr, "required"); validatorErr != nil {
+			fieldName := "names"
+			validationError := wrapValidatorError(validatorErr, "SliceParams", fieldName)
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		statusesRawValues, isstatusesExists := ctx.GetQueryArray("statuses")
+		var statusesRawPtr *[]Param107statusesItem.OrderStatus = nil
+		if isstatusesExists {
+			statuses := []Param107statusesItem.OrderStatus{}
+			for _, paramValue := range splitParamValues(statusesRawValues, "") {
+				var statusesItemRawPtr *Param107statusesItem.OrderStatus = nil
+				statusesItemRaw := paramValue
+				isstatusesItemExists := true
+				if isstatusesItemExists {
+					statusesItem := statusesItemRaw
+					statusesItemEnum := Param107statusesItem.OrderStatus(statusesItem)
+					if !slices.Contains([]Param107statusesItem.OrderStatus{"pending", "shipped", "delivered"}, statusesItemEnum) {
+						conversionErr := fmt.Errorf("'%s' is not a valid OrderStatus value", statusesItemRaw)
+						validationError := runtime.Rfc7807Error{
+							Type: http.StatusText(http.StatusUnprocessableEntity),
+							Detail: fmt.Sprintf(
+								"A request was made to operation 'SliceParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+								"statusesItem",
+								"OrderStatus",
+								reflect.TypeOf(statusesItemRaw).String(),
+							),
+							Status:     http.StatusUnprocessableEntity,
+							Instance:   "/gleece/validation/error/SliceParams",
+							Extensions: map[string]string{"error": conversionErr.Error()},
+						}
+						// json validation error response extension placeholder
+						ctx.JSON(http.StatusUnprocessableEntity, validationError)
+						return
+					}
+					statusesItemRawPtr = &statusesItemEnum
+				}
+				statuses = append(statuses, *statusesItemRawPtr)
+			}
+			statusesRawPtr = &statuses
+		}
+		if validatorErr := validatorInstance.Var(statusesRawPtr, "required"); validatorErr != nil {
+			fieldName := "statuses"
+			validationError := wrapValidatorError(validatorErr, "SliceParams", fieldName)
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		keysRawValues, iskeysExists := ctx.GetQueryArray("keys")
+		var keysRawPtr *[]Param108keysItem.UUID = nil
+		if iskeysExists {
+			keys := []Param108keysItem.UUID{}
+			for _, paramValue := range splitParamValues(keysRawValues, "") {
+				var keysItemRawPtr *Param108keysItem.UUID = nil
+				keysItemRaw := paramValue
+				iskeysItemExists := true
+				if iskeysItemExists {
+					keysItem, conversionErr := Param108keysItem.Parse(keysItemRaw)
+					if conversionErr != nil {
+						validationError := runtime.Rfc7807Error{
+							Type: http.StatusText(http.StatusUnprocessableEntity),
+							Detail: fmt.Sprintf(
+								"A request was made to operation 'SliceParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+								"keysItem",
+								"UUID",
+								reflect.TypeOf(keysItemRaw).String(),
+							),
+							Status:     http.StatusUnprocessableEntity,
+							Instance:   "/gleece/validation/error/SliceParams",
+							Extensions: map[string]string{"error": conversionErr.Error()},
+						}
+						// json validation error response extension placeholder
+						ctx.JSON(http.StatusUnprocessableEntity, validationError)
+						return
+					}
+					keysItemRawPtr = &keysItem
+				}
+				keys = append(keys, *keysItemRawPtr)
+			}
+			keysRawPtr = &keys
+		}
+		if validatorErr := validatorInstance.Var(keysRawPtr, "max=2,required"); validatorErr != nil {
+			fieldName := "keys"
+			validationError := wrapValidatorError(validatorErr, "SliceParams", fieldName)
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		labelsRawValues := ctx.Request.Header.Values("x-labels")
+		islabelsExists := len(labelsRawValues) > 0
+		var labelsRawPtr *[]string = nil
+		if islabelsExists {
+			labels := []string{}
+			for _, paramValue := range splitParamValues(labelsRawValues, ",") {
+				var labelsItemRawPtr *string = nil
+				labelsItemRaw := paramValue
+				islabelsItemExists := true
+				if islabelsItemExists {
+					labelsItem := labelsItemRaw
+					labelsItemRawPtr = &labelsItem
+				}
+				labels = append(labels, *labelsItemRawPtr)
+			}
+			labelsRawPtr = &labels
+		}
+		if validatorErr := validatorInstance.Var(labelsRawPtr, "required"); validatorErr != nil {
+			fieldName := "labels"
+			validationError := wrapValidatorError(validatorErr, "SliceParams", fieldName)
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.SliceParams(*idsRawPtr, *tagsRawPtr, *namesRawPtr, *statusesRawPtr, *keysRawPtr, *labelsRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Header(key, value)
+		}
+		ctx.Header("x-inject", "true")
+		ctx.Header("x-extended", "SliceParams")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); !continueOperation {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SliceParams'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/SliceParams",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			ctx.JSON(statusCode, stdError)
+			return
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
+	})
+	engine.GET(toGinUrl("/e2e/query-object"), func(ctx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ctx, authErr, "QueryObject")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'QueryObject' cannot produce a response in any of the accepted media types",
+				"QueryObject",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var filterRawPtr = &Param112filter.ListFilter{}
+		var filterPageRawPtr *int = nil
+		filterPageRaw, isfilterPageExists := ctx.GetQuery("page")
+		if isfilterPageExists {
+			filterPageUint64, conversionErr := strconv.Atoi(filterPageRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'QueryObject' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"filterPage",
+						"int",
+						reflect.TypeOf(filterPageRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/QueryObject",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				ctx.JSON(http.StatusUnprocessableEntity, validationError)
+				return
+			}
+			filterPage := int(filterPageUint64)
+			filterPageRawPtr = &filterPage
+		}
+		if filterPageRawPtr != nil {
+			filterRawPtr.Page = *filterPageRawPtr
+		}
+		var filterPageSizeRawPtr *int = nil
+		filterPageSizeRaw, isfilterPageSizeExists := ctx.GetQuery("page_size")
+		if isfilterPageSizeExists {
+			filterPageSizeUint64, conversionErr := strconv.Atoi(filterPageSizeRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'QueryObject' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"filterPageSize",
+						"int",
+						reflect.TypeOf(filterPageSizeRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/QueryObject",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				ctx.JSON(http.StatusUnprocessableEntity, validationError)
+				return
+			}
+			filterPageSize := int(filterPageSizeUint64)
+			filterPageSizeRawPtr = &filterPageSize
+		}
+		if filterPageSizeRawPtr != nil {
+			filterRawPtr.PageSize = filterPageSizeRawPtr
+		}
+		filterTagsRawValues, isfilterTagsExists := ctx.GetQueryArray("tag")
+		var filterTagsRawPtr *[]string = nil
+		if isfilterTagsExists {
+			filterTags := []string{}
+			for _, paramValue := range splitParamValues(filterTagsRawValues, "") {
+				var filterTagsItemRawPtr *string = nil
+				filterTagsItemRaw := paramValue
+				isfilterTagsItemExists := true
+				if isfilterTagsItemExists {
+					filterTagsItem := filterTagsItemRaw
+					filterTagsItemRawPtr = &filterTagsItem
+				}
+				filterTags = append(filterTags, *filterTagsItemRawPtr)
+			}
+			filterTagsRawPtr = &filterTags
+		}
+		if filterTagsRawPtr != nil {
+			filterRawPtr.Tags = *filterTagsRawPtr
+		}
+		var filterStatusRawPtr *Param116filterStatus.OrderStatus = nil
+		filterStatusRaw, isfilterStatusExists := ctx.GetQuery("status")
+		if isfilterStatusExists {
+			filterStatus := filterStatusRaw
+			filterStatusEnum := Param116filterStatus.OrderStatus(filterStatus)
+			if !slices.Contains([]Param116filterStatus.OrderStatus{"pending", "shipped", "delivered"}, filterStatusEnum) {
+				conversionErr := fmt.Errorf("'%s' is not a valid OrderStatus value", filterStatusRaw)
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'QueryObject' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"filterStatus",
+						"OrderStatus",
+						reflect.TypeOf(filterStatusRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/QueryObject",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				ctx.JSON(http.StatusUnprocessableEntity, validationError)
+				return
+			}
+			filterStatusRawPtr = &filterStatusEnum
+		}
+		if filterStatusRawPtr != nil {
+			filterRawPtr.Status = *filterStatusRawPtr
+		}
+		if validatorErr := validatorInstance.Struct(filterRawPtr); validatorErr != nil {
+			fieldName := "filter"
+			validationError := wrapStructValidatorError(validatorErr, "QueryObject", fieldName)
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		var otherRawPtr *string = nil
+		otherRaw, isotherExists := ctx.GetQuery("other")
+		if isotherExists {
+			other := otherRaw
+			otherRawPtr = &other
+		}
+		if validatorErr := validatorInstance.Var(otherRawPtr, "required"); validatorErr != nil {
+			fieldName := "other"
+			validationError := wrapValidatorError(validatorErr, "QueryObject", fieldName)
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.QueryObject(*filterRawPtr, *otherRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Header(key, value)
+		}
+		ctx.Header("x-inject", "true")
+		ctx.Header("x-extended", "QueryObject")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); !continueOperation {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'QueryObject'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/QueryObject",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			ctx.JSON(statusCode, stdError)
+			return
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
+	})
+	engine.POST(toGinUrl("/e2e/polymorphic-body"), func(ctx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ctx, authErr, "PolymorphicBody")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'PolymorphicBody' cannot produce a response in any of the accepted media types",
+				"PolymorphicBody",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var conversionErr error
+		var petRawPtr *Param120pet.Pet = nil
+		requestContentType, isRequestContentTypeSupported := getRequestContentType(ctx.GetHeader("Content-Type"), []string{"application/json"})
+		if !isRequestContentTypeSupported {
+			handleContentNegotiationError(ctx,
+				http.StatusUnsupportedMediaType,
+				fmt.Sprintf("Operation 'PolymorphicBody' does not accept request bodies of type '%s'", ctx.GetHeader("Content-Type")),
+				"PolymorphicBody",
+			)
+			return
+		}
+		conversionErr = bindAndValidatePolymorphicBody(
+			ctx,
+			requestContentType,
+			"required",
+			"kind",
+			map[string]func([]byte) (Param120pet.Pet, error){
+				"cat": unmarshalOneOfMember[Param120pet.CatInfo, Param120pet.Pet](false),
+				"dog": unmarshalOneOfMember[Param120pet.DogInfo, Param120pet.Pet](true),
+			},
+			&petRawPtr,
+		)
+		if conversionErr != nil {
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: fmt.Sprintf(
+					"A request was made to operation 'PolymorphicBody' but body parameter '%s' did not pass validation of '%s' - %s",
+					"pet",
+					"Pet",
+					extractValidationErrorMessage(conversionErr, nil),
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/gleece/validation/error/PolymorphicBody",
+			}
+			// json body validation error response extension placeholder
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.PolymorphicBody(*petRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Header(key, value)
+		}
+		ctx.Header("x-inject", "true")
+		ctx.Header("x-extended", "PolymorphicBody")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); !continueOperation {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PolymorphicBody'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/PolymorphicBody",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			ctx.JSON(statusCode, stdError)
+			return
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
+	})
+	engine.GET(toGinUrl("/e2e/map-response"), func(ctx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ctx, authErr, "MapResponse")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'MapResponse' cannot produce a response in any of the accepted media types",
+				"MapResponse",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		namesRawValues, isnamesExists := ctx.GetQueryArray("names")
+		var namesRawPtr *[]string = nil
+		if isnamesExists {
+			names := []string{}
+			for _, paramValue := range splitParamValues(namesRawValues, "") {
+				var namesItemRawPtr *string = nil
+				namesItemRaw := paramValue
+				isnamesItemExists := true
+				if isnamesItemExists {
+					namesItem := namesItemRaw
+					namesItemRawPtr = &namesItem
+				}
+				names = append(names, *namesItemRawPtr)
+			}
+			namesRawPtr = &names
+		}
+		if validatorErr := validatorInstance.Var(namesRawPtr, "required"); validatorErr != nil {
+			fieldName := "names"
+			validationError := wrapValidatorError(validatorErr, "MapResponse", fieldName)
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.MapResponse(*namesRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Header(key, value)
+		}
+		ctx.Header("x-inject", "true")
+		ctx.Header("x-extended", "MapResponse")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); !continueOperation {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'MapResponse'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/MapResponse",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			ctx.JSON(statusCode, stdError)
+			return
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
+	})
+	engine.GET(toGinUrl("/e2e/named-primitive-params/{customerId}"), func(ctx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ctx, authErr, "NamedPrimitiveParams")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'NamedPrimitiveParams' cannot produce a response in any of the accepted media types",
+				"NamedPrimitiveParams",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var customerIdRawPtr *Param126customerId.CustomerID = nil
+		customerIdRaw, iscustomerIdExists := ctx.Params.Get("customerId")
+		if iscustomerIdExists {
+			customerId := customerIdRaw
+			customerIdNamed := Param126customerId.CustomerID(customerId)
+			customerIdRawPtr = &customerIdNamed
+		}
+		if validatorErr := validatorInstance.Var(customerIdRawPtr, "required"); validatorErr != nil {
+			fieldName := "customerId"
+			validationError := wrapValidatorError(validatorErr, "NamedPrimitiveParams", fieldName)
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		var amountRawPtr *Param127amount.Cents = nil
+		amountRaw, isamountExists := ctx.GetQuery("amount")
+		if isamountExists {
+			amountUint64, conversionErr := strconv.ParseInt(amountRaw, 10, 64)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'NamedPrimitiveParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"amount",
+						"Cents",
+						reflect.TypeOf(amountRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/NamedPrimitiveParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				ctx.JSON(http.StatusUnprocessableEntity, validationError)
+				return
+			}
+			amount := int64(amountUint64)
+			amountNamed := Param127amount.Cents(amount)
+			amountRawPtr = &amountNamed
+		}
+		if validatorErr := validatorInstance.Var(amountRawPtr, "required"); validatorErr != nil {
+			fieldName := "amount"
+			validationError := wrapValidatorError(validatorErr, "NamedPrimitiveParams", fieldName)
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		splitsRawValues, issplitsExists := ctx.GetQueryArray("splits")
+		var splitsRawPtr *[]Param128splitsItem.Cents = nil
+		if issplitsExists {
+			splits := []Param128splitsItem.Cents{}
+			for _, paramValue := range splitParamValues(splitsRawValues, "") {
+				var splitsItemRawPtr *Param128splitsItem.Cents = nil
+				splitsItemRaw := paramValue
+				issplitsItemExists := true
+				if issplitsItemExists {
+					splitsItemUint64, conversionErr := strconv.ParseInt(splitsItemRaw, 10, 64)
+					if conversionErr != nil {
+						validationError := runtime.Rfc7807Error{
+							Type: http.StatusText(http.StatusUnprocessableEntity),
+							Detail: fmt.Sprintf(
+								"A request was made to operation 'NamedPrimitiveParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+								"splitsItem",
+								"Cents",
+								reflect.TypeOf(splitsItemRaw).String(),
+							),
+							Status:     http.StatusUnprocessableEntity,
+							Instance:   "/gleece/validation/error/NamedPrimitiveParams",
+							Extensions: map[string]string{"error": conversionErr.Error()},
+						}
+						// json validation error response extension placeholder
+						ctx.JSON(http.StatusUnprocessableEntity, validationError)
+						return
+					}
+					splitsItem := int64(splitsItemUint64)
+					splitsItemNamed := Param128splitsItem.Cents(splitsItem)
+					splitsItemRawPtr = &splitsItemNamed
+				}
+				splits = append(splits, *splitsItemRawPtr)
+			}
+			splitsRawPtr = &splits
+		}
+		if validatorErr := validatorInstance.Var(splitsRawPtr, "required"); validatorErr != nil {
+			fieldName := "splits"
+			validationError := wrapValidatorError(validatorErr, "NamedPrimitiveParams", fieldName)
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		var currencyRawPtr *Param129currency.Currency = nil
+		currencyRaw := ctx.GetHeader("x-currency")
+		_, iscurrencyExists := ctx.Request.Header[textproto.CanonicalMIMEHeaderKey("x-currency")]
+		if iscurrencyExists {
+			currency := currencyRaw
+			currencyNamed := Param129currency.Currency(currency)
+			currencyRawPtr = &currencyNamed
+		}
+		if validatorErr := validatorInstance.Var(currencyRawPtr, "required"); validatorErr != nil {
+			fieldName := "currency"
+			validationError := wrapValidatorError(validatorErr, "NamedPrimitiveParams", fieldName)
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.NamedPrimitiveParams(*customerIdRawPtr, *amountRawPtr, *splitsRawPtr, *currencyRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Header(key, value)
+		}
+		ctx.Header("x-inject", "true")
+		ctx.Header("x-extended", "NamedPrimitiveParams")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); !continueOperation {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'NamedPrimitiveParams'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/NamedPrimitiveParams",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			ctx.JSON(statusCode, stdError)
+			return
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
+	})
+	engine.POST(toGinUrl("/e2e/body-defaults"), func(ctx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ctx, authErr, "BodyDefaults")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'BodyDefaults' cannot produce a response in any of the accepted media types",
+				"BodyDefaults",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var conversionErr error
+		var infoRawPtr *Param132info.DefaultsInfo = nil
+		requestContentType, isRequestContentTypeSupported := getRequestContentType(ctx.GetHeader("Content-Type"), []string{"application/json"})
+		if !isRequestContentTypeSupported {
+			handleContentNegotiationError(ctx,
+				http.StatusUnsupportedMediaType,
+				fmt.Sprintf("Operation 'BodyDefaults' does not accept request bodies of type '%s'", ctx.GetHeader("Content-Type")),
+				"BodyDefaults",
+			)
+			return
+		}
+		conversionErr = bindAndValidateBody(ctx, requestContentType, "required", "{\"limit\":20,\"name\":\"guest\",\"notify\":true,\"paging\":{\"page\":1,\"size\":50},\"status\":\"shipped\"}", &infoRawPtr)
+		if conversionErr != nil {
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: fmt.Sprintf(
+					"A request was made to operation 'BodyDefaults' but body parameter '%s' did not pass validation of '%s' - %s",
+					"info",
+					"DefaultsInfo",
+					extractValidationErrorMessage(conversionErr, nil),
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/gleece/validation/error/BodyDefaults",
+			}
+			// json body validation error response extension placeholder
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.BodyDefaults(*infoRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Header(key, value)
+		}
+		ctx.Header("x-inject", "true")
+		ctx.Header("x-extended", "BodyDefaults")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); !continueOperation {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'BodyDefaults'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/BodyDefaults",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			ctx.JSON(statusCode, stdError)
+			return
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
+	})
+	engine.POST(toGinUrl("/e2e/param-defaults"), func(ctx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ctx, authErr, "ParamDefaults")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'ParamDefaults' cannot produce a response in any of the accepted media types",
+				"ParamDefaults",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var limitRawPtr *int = nil
+		limitRaw, islimitExists := ctx.GetQuery("limit")
+		if !islimitExists {
+			limitRaw = "20"
+			islimitExists = true
+		}
+		if islimitExists {
+			limitUint64, conversionErr := strconv.Atoi(limitRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'ParamDefaults' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"limit",
+						"int",
+						reflect.TypeOf(limitRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/ParamDefaults",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				ctx.JSON(http.StatusUnprocessableEntity, validationError)
+				return
+			}
+			limit := int(limitUint64)
+			limitRawPtr = &limit
+		}
+		if validatorErr := validatorInstance.Var(limitRawPtr, "required"); validatorErr != nil {
+			fieldName := "limit"
+			validationError := wrapValidatorError(validatorErr, "ParamDefaults", fieldName)
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		var notifyRawPtr *bool = nil
+		notifyRaw, isnotifyExists := ctx.GetQuery("notify")
+		if !isnotifyExists {
+			notifyRaw = "true"
+			isnotifyExists = true
+		}
+		if isnotifyExists {
+			notify, conversionErr := strconv.ParseBool(notifyRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'ParamDefaults' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"notify",
+						"bool",
+						reflect.TypeOf(notifyRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/ParamDefaults",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				ctx.JSON(http.StatusUnprocessableEntity, validationError)
+				return
+			}
+			notifyRawPtr = &notify
+		}
+		var statusRawPtr *Param137status.OrderStatus = nil
+		statusRaw, isstatusExists := ctx.GetQuery("status")
+		if !isstatusExists {
+			statusRaw = "shipped"
+			isstatusExists = true
+		}
+		if isstatusExists {
+			status := statusRaw
+			statusEnum := Param137status.OrderStatus(status)
+			if !slices.Contains([]Param137status.OrderStatus{"pending", "shipped", "delivered"}, statusEnum) {
+				conversionErr := fmt.Errorf("'%s' is not a valid OrderStatus value", statusRaw)
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'ParamDefaults' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"status",
+						"OrderStatus",
+						reflect.TypeOf(statusRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/ParamDefaults",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				ctx.JSON(http.StatusUnprocessableEntity, validationError)
+				return
+			}
+			statusRawPtr = &statusEnum
+		}
+		if validatorErr := validatorInstance.Var(statusRawPtr, "required"); validatorErr != nil {
+			fieldName := "status"
+			validationError := wrapValidatorError(validatorErr, "ParamDefaults", fieldName)
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		var regionRawPtr *string = nil
+		regionRaw := ctx.GetHeader("x-region")
+		_, isregionExists := ctx.Request.Header[textproto.CanonicalMIMEHeaderKey("x-region")]
+		if !isregionExists {
+			regionRaw = "eu"
+			isregionExists = true
+		}
+		if isregionExists {
+			region := regionRaw
+			regionRawPtr = &region
+		}
+		if validatorErr := validatorInstance.Var(regionRawPtr, "required"); validatorErr != nil {
+			fieldName := "region"
+			validationError := wrapValidatorError(validatorErr, "ParamDefaults", fieldName)
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		var noteRawPtr *string = nil
+		noteRaw, isnoteExists := ctx.GetPostForm("note")
+		if !isnoteExists {
+			noteRaw = "none"
+			isnoteExists = true
+		}
+		if isnoteExists {
+			note := noteRaw
+			noteRawPtr = &note
+		}
+		if validatorErr := validatorInstance.Var(noteRawPtr, "required"); validatorErr != nil {
+			fieldName := "note"
+			validationError := wrapValidatorError(validatorErr, "ParamDefaults", fieldName)
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.ParamDefaults(*limitRawPtr, notifyRawPtr, *statusRawPtr, *regionRawPtr, *noteRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Header(key, value)
+		}
+		ctx.Header("x-inject", "true")
+		ctx.Header("x-extended", "ParamDefaults")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); !continueOperation {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'ParamDefaults'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/ParamDefaults",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			ctx.JSON(statusCode, stdError)
+			return
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
+	})
+	engine.GET(toGinUrl("/e2e/cookie-params"), func(ctx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ctx, authErr, "CookieParams")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'CookieParams' cannot produce a response in any of the accepted media types",
+				"CookieParams",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var sessionRawPtr *string = nil
+		sessionRawCookie, sessionCookieErr := ctx.Request.Cookie("session_id")
+		issessionExists := sessionCookieErr == nil
+		sessionRaw := ""
+		if issessionExists {
+			sessionRaw = sessionRawCookie.Value
+		}
+		if issessionExists {
+			session := sessionRaw
+			sessionRawPtr = &session
+		}
+		if validatorErr := validatorInstance.Var(sessionRawPtr, "required"); validatorErr != nil {
+			fieldName := "session"
+			validationError := wrapValidatorError(validatorErr, "CookieParams", fieldName)
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		var themeRawPtr *string = nil
+		themeRawCookie, themeCookieErr := ctx.Request.Cookie("theme")
+		isthemeExists := themeCookieErr == nil
+		themeRaw := ""
+		if isthemeExists {
+			themeRaw = themeRawCookie.Value
+		}
+		if isthemeExists {
+			theme := themeRaw
+			themeRawPtr = &theme
+		}
+		var visitsRawPtr *int = nil
+		visitsRawCookie, visitsCookieErr := ctx.Request.Cookie("visits")
+		isvisitsExists := visitsCookieErr == nil
+		visitsRaw := ""
+		if isvisitsExists {
+			visitsRaw = visitsRawCookie.Value
+		}
+		if isvisitsExists {
+			visitsUint64, conversionErr := strconv.Atoi(visitsRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'CookieParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"visits",
+						"int",
+						reflect.TypeOf(visitsRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/CookieParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				ctx.JSON(http.StatusUnprocessableEntity, validationError)
+				return
+			}
+			visits := int(visitsUint64)
+			visitsRawPtr = &visits
+		}
+		if validatorErr := validatorInstance.Var(visitsRawPtr, "gte=0,required"); validatorErr != nil {
+			fieldName := "visits"
+			validationError := wrapValidatorError(validatorErr, "CookieParams", fieldName)
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.CookieParams(*sessionRawPtr, themeRawPtr, *visitsRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Header(key, value)
+		}
+		ctx.Header("x-inject", "true")
+		ctx.Header("x-extended", "CookieParams")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); !continueOperation {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'CookieParams'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/CookieParams",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			ctx.JSON(statusCode, stdError)
+			return
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
+	})
+	engine.POST(toGinUrl("/e2e/content-negotiation"), func(ctx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ctx, authErr, "ContentNegotiation")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'ContentNegotiation' cannot produce a response in any of the accepted media types",
+				"ContentNegotiation",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var conversionErr error
+		var infoRawPtr *Param147info.DefaultsInfo = nil
+		requestContentType, isRequestContentTypeSupported := getRequestContentType(ctx.GetHeader("Content-Type"), []string{"application/json"})
+		if !isRequestContentTypeSupported {
+			handleContentNegotiationError(ctx,
+				http.StatusUnsupportedMediaType,
+				fmt.Sprintf("Operation 'ContentNegotiation' does not accept request bodies of type '%s'", ctx.GetHeader("Content-Type")),
+				"ContentNegotiation",
+			)
+			return
+		}
+		conversionErr = bindAndValidateBody(ctx, requestContentType, "required", "{\"limit\":20,\"name\":\"guest\",\"notify\":true,\"paging\":{\"page\":1,\"size\":50},\"status\":\"shipped\"}", &infoRawPtr)
+		if conversionErr != nil {
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: fmt.Sprintf(
+					"A request was made to operation 'ContentNegotiation' but body parameter '%s' did not pass validation of '%s' - %s",
+					"info",
+					"DefaultsInfo",
+					extractValidationErrorMessage(conversionErr, nil),
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/gleece/validation/error/ContentNegotiation",
+			}
+			// json body validation error response extension placeholder
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.ContentNegotiation(*infoRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Header(key, value)
+		}
+		ctx.Header("x-inject", "true")
+		ctx.Header("x-extended", "ContentNegotiation")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); !continueOperation {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'ContentNegotiation'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/ContentNegotiation",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			ctx.JSON(statusCode, stdError)
+			return
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
+	})
+	engine.POST(toGinUrl("/e2e/xml-serialization"), func(ctx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ctx, authErr, "XmlSerialization")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json", "application/xml"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'XmlSerialization' cannot produce a response in any of the accepted media types",
+				"XmlSerialization",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var conversionErr error
+		var bookRawPtr *Param150book.XmlBook = nil
+		requestContentType, isRequestContentTypeSupported := getRequestContentType(ctx.GetHeader("Content-Type"), []string{"application/xml", "application/json"})
+		if !isRequestContentTypeSupported {
+			handleContentNegotiationError(ctx,
+				http.StatusUnsupportedMediaType,
+				fmt.Sprintf("Operation 'XmlSerialization' does not accept request bodies of type '%s'", ctx.GetHeader("Content-Type")),
+				"XmlSerialization",
+			)
+			return
+		}
+		conversionErr = bindAndValidateBody(ctx, requestContentType, "required", "", &bookRawPtr)
+		if conversionErr != nil {
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: fmt.Sprintf(
+					"A request was made to operation 'XmlSerialization' but body parameter '%s' did not pass validation of '%s' - %s",
+					"book",
+					"XmlBook",
+					extractValidationErrorMessage(conversionErr, nil),
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/gleece/validation/error/XmlSerialization",
+			}
+			// json body validation error response extension placeholder
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.XmlSerialization(*bookRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Header(key, value)
+		}
+		ctx.Header("x-inject", "true")
+		ctx.Header("x-extended", "XmlSerialization")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); !continueOperation {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'XmlSerialization'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/XmlSerialization",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			ctx.JSON(statusCode, stdError)
+			return
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
+		if responseContentType == "application/xml" {
+			// xml response extension placeholder
+			ctx.XML(statusCode, value)
+		}
+	})
+	engine.GET(toGinUrl("/e2e/plain-text"), func(ctx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ctx, authErr, "PlainText")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json", "text/plain"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'PlainText' cannot produce a response in any of the accepted media types",
+				"PlainText",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var nameRawPtr *string = nil
+		nameRaw, isnameExists := ctx.GetQuery("name")
+		if isnameExists {
+			name := nameRaw
+			nameRawPtr = &name
+		}
+		if validatorErr := validatorInstance.Var(nameRawPtr, "required"); validatorErr != nil {
+			fieldName := "name"
+			validationError := wrapValidatorError(validatorErr, "PlainText", fieldName)
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.PlainText(*nameRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Header(key, value)
+		}
+		ctx.Header("x-inject", "true")
+		ctx.Header("x-extended", "PlainText")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); !continueOperation {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PlainText'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/PlainText",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			ctx.JSON(statusCode, stdError)
+			return
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
+		if responseContentType == "text/plain" {
+			// text response extension placeholder
+			ctx.Data(statusCode, "text/plain; charset=utf-8", []byte(fmt.Sprint(value)))
+		}
+	})
+	engine.POST(toGinUrl("/e2e/form-files"), func(ctx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ctx, authErr, "FormFiles")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'FormFiles' cannot produce a response in any of the accepted media types",
+				"FormFiles",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var titleRawPtr *string = nil
+		titleRaw, istitleExists := ctx.GetPostForm("title")
+		if istitleExists {
+			title := titleRaw
+			titleRawPtr = &title
+		}
+		if validatorErr := validatorInstance.Var(titleRawPtr, "required"); validatorErr != nil {
+			fieldName := "title"
+			validationError := wrapValidatorError(validatorErr, "FormFiles", fieldName)
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		var avatarRawPtr *Param157avatar.FileHeader = nil
+		avatarFiles := getFormFiles(ctx, "avatar")
+		if fileErr := validateFormFiles(avatarFiles, false, 16, []string{"image/*"}); fileErr != nil {
+			validationError := wrapValidatorError(fileErr, "FormFiles", "avatar")
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		if len(avatarFiles) > 0 {
+			avatarRawPtr = avatarFiles[0]
+		}
+		if validatorErr := validatorInstance.Var(avatarRawPtr, "required"); validatorErr != nil {
+			fieldName := "avatar"
+			validationError := wrapValidatorError(validatorErr, "FormFiles", fieldName)
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		var attachmentsRawPtr *[]*Param158attachmentsItem.FileHeader = nil
+		attachmentsFiles := getFormFiles(ctx, "attachment")
+		if fileErr := validateFormFiles(attachmentsFiles, true, 0, []string{"text/plain", "application/pdf"}); fileErr != nil {
+			validationError := wrapValidatorError(fileErr, "FormFiles", "attachments")
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		attachmentsRawPtr = &attachmentsFiles
+		if validatorErr := validatorInstance.Var(attachmentsRawPtr, "required"); validatorErr != nil {
+			fieldName := "attachments"
+			validationError := wrapValidatorError(validatorErr, "FormFiles", fieldName)
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.FormFiles(*titleRawPtr, avatarRawPtr, *attachmentsRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Header(key, value)
+		}
+		ctx.Header("x-inject", "true")
+		ctx.Header("x-extended", "FormFiles")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); !continueOperation {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'FormFiles'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/FormFiles",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			ctx.JSON(statusCode, stdError)
+			return
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
+	})
+	engine.GET(toGinUrl("/e2e/stream-report"), func(ctx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ctx, authErr, "StreamReport")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"text/csv"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'StreamReport' cannot produce a response in any of the accepted media types",
+				"StreamReport",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var nameRawPtr *string = nil
+		nameRaw, isnameExists := ctx.GetQuery("name")
+		if isnameExists {
+			name := nameRaw
+			nameRawPtr = &name
+		}
+		if validatorErr := validatorInstance.Var(nameRawPtr, "required"); validatorErr != nil {
+			fieldName := "name"
+			validationError := wrapValidatorError(validatorErr, "StreamReport", fieldName)
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.StreamReport(*nameRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Header(key, value)
+		}
+		ctx.Header("x-inject", "true")
+		ctx.Header("x-extended", "StreamReport")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); !continueOperation {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'StreamReport'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/StreamReport",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			ctx.JSON(statusCode, stdError)
+			return
+		}
+		// stream response extension placeholder
+		// File responses are sent as their own media type, if given, rather than the negotiated one
+		if value.ContentType != "" {
+			responseContentType = value.ContentType
+		}
+		streamErr := writeStream(ctx.Writer, statusCode, responseContentType, value.FileName, value.Content)
+		if streamErr != nil {
+			// The response is already under way - the error is left for gin's middlewares to report
+			ctx.Error(streamErr)
+		}
+	})
+	engine.GET(toGinUrl("/e2e/download-notes"), func(ctx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ctx, authErr, "DownloadNotes")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/octet-stream"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'DownloadNotes' cannot produce a response in any of the accepted media types",
+				"DownloadNotes",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.DownloadNotes()
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Header(key, value)
+		}
+		ctx.Header("x-inject", "true")
+		ctx.Header("x-extended", "DownloadNotes")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); !continueOperation {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'DownloadNotes'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/DownloadNotes",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			ctx.JSON(statusCode, stdError)
+			return
+		}
+		// stream response extension placeholder
+		// File responses are sent as their own media type, if given, rather than the negotiated one
+		if value.ContentType != "" {
+			responseContentType = value.ContentType
+		}
+		streamErr := writeStream(ctx.Writer, statusCode, responseContentType, value.FileName, value.Content)
+		if streamErr != nil {
+			// The response is already under way - the error is left for gin's middlewares to report
+			ctx.Error(streamErr)
+		}
+	})
+	engine.GET(toGinUrl("/e2e/stream-bytes"), func(ctx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ctx, authErr, "StreamBytes")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/octet-stream"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'StreamBytes' cannot produce a response in any of the accepted media types",
+				"StreamBytes",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.StreamBytes()
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Header(key, value)
+		}
+		ctx.Header("x-inject", "true")
+		ctx.Header("x-extended", "StreamBytes")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); !continueOperation {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'StreamBytes'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/StreamBytes",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			ctx.JSON(statusCode, stdError)
+			return
+		}
+		// stream response extension placeholder
+		streamErr := writeStream(ctx.Writer, statusCode, responseContentType, "", value)
+		if streamErr != nil {
+			// The response is already under way - the error is left for gin's middlewares to report
+			ctx.Error(streamErr)
+		}
+	})
+	engine.GET(toGinUrl("/e2e/event-stream"), func(ctx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ctx, authErr, "EventStream")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"text/event-stream"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'EventStream' cannot produce a response in any of the accepted media types",
+				"EventStream",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var stepsRawPtr *int = nil
+		stepsRaw, isstepsExists := ctx.GetQuery("steps")
+		if isstepsExists {
+			stepsUint64, conversionErr := strconv.Atoi(stepsRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'EventStream' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"steps",
+						"int",
+						reflect.TypeOf(stepsRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/EventStream",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				ctx.JSON(http.StatusUnprocessableEntity, validationError)
+				return
+			}
+			steps := int(stepsUint64)
+			stepsRawPtr = &steps
+		}
+		if validatorErr := validatorInstance.Var(stepsRawPtr, "required"); validatorErr != nil {
+			fieldName := "steps"
+			validationError := wrapValidatorError(validatorErr, "EventStream", fieldName)
+			ctx.JSON(http.StatusUnprocessableEntity, validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.EventStream(*stepsRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Header(key, value)
+		}
+		ctx.Header("x-inject", "true")
+		ctx.Header("x-extended", "EventStream")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); !continueOperation {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'EventStream'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/EventStream",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			ctx.JSON(statusCode, stdError)
+			return
+		}
+		// event stream response extension placeholder
+		writeEventStream(ctx.Writer, ctx.Request.Context(), statusCode, value)
+	})
+	engine.GET(toGinUrl("/e2e/event-stream-error"), func(ctx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ctx, authErr, "EventStreamError")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"text/event-stream"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'EventStreamError' cannot produce a response in any of the accepted media types",
+				"EventStreamError",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(ctx); !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.EventStreamError()
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			ctx.Header(key, value)
+		}
+		ctx.Header("x-inject", "true")
+		ctx.Header("x-extended", "EventStreamError")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(ctx); !continueOperation {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'EventStreamError'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/EventStreamError",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			ctx.JSON(statusCode, stdError)
+			return
+		}
+		// event stream response extension placeholder
+		writeEventStream(ctx.Writer, ctx.Request.Context(), statusCode, value)
+	})
+	// E2EClassSecController
+	engine.GET(toGinUrl("/e2e/with-default-class-security"), func(ctx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"class",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ctx, authErr, "WithDefaultClassSecurity")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'WithDefaultClassSecurity' cannot produce a response in any of the accepted media types",
+				"WithDefaultClassSecurity",
+			)
+			return
+		}
+		controller := E2EClassSecControllerImport.E2EClassSecController{}
+		controller.InitController(ctx)
+		var headerParamRawPtr *string = nil
+		headerParamRaw := ctx.GetHeader("x-test-scopes")
+		_, isheaderParamExists := ctx.Request.Header[textproto.CanonicalMIMEHeaderKey("x-test-scopes")]
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
 		}
 		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
 			fieldName := "headerParam"
@@ -2962,7 +6789,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
-			if continueOperation := middleware(ctx); continueOperation == false {
+			if continueOperation := middleware(ctx); !continueOperation {
 				return
 			}
 		}
@@ -2979,7 +6806,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError == nil {
 			// Middlewares afterOperationSuccessMiddlewares section
 			for _, middleware := range afterOperationSuccessMiddlewares {
-				if continueOperation := middleware(ctx); continueOperation == false {
+				if continueOperation := middleware(ctx); !continueOperation {
 					return
 				}
 			}
@@ -2988,7 +6815,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
-				if continueOperation := middleware(ctx, opError); continueOperation == false {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
 					return
 				}
 			}
@@ -3004,8 +6831,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			ctx.JSON(statusCode, stdError)
 			return
 		}
-		// json response extension placeholder
-		ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
 	})
 	engine.GET(toGinUrl("/e2e/with-default-override-class-security"), func(ctx *gin.Context) {
 		// route start routes extension placeholder
@@ -3029,6 +6858,15 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ctx, authErr, "WithOverrideClassSecurity")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.GetHeader("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(ctx,
+				http.StatusNotAcceptable,
+				"Operation 'WithOverrideClassSecurity' cannot produce a response in any of the accepted media types",
+				"WithOverrideClassSecurity",
+			)
+			return
+		}
 		controller := E2EClassSecControllerImport.E2EClassSecController{}
 		controller.InitController(ctx)
 		var headerParamRawPtr *string = nil
@@ -3046,7 +6884,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
-			if continueOperation := middleware(ctx); continueOperation == false {
+			if continueOperation := middleware(ctx); !continueOperation {
 				return
 			}
 		}
@@ -3063,7 +6901,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError == nil {
 			// Middlewares afterOperationSuccessMiddlewares section
 			for _, middleware := range afterOperationSuccessMiddlewares {
-				if continueOperation := middleware(ctx); continueOperation == false {
+				if continueOperation := middleware(ctx); !continueOperation {
 					return
 				}
 			}
@@ -3072,7 +6910,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
-				if continueOperation := middleware(ctx, opError); continueOperation == false {
+				if continueOperation := middleware(ctx, opError); !continueOperation {
 					return
 				}
 			}
@@ -3088,7 +6926,9 @@ func RegisterRoutes(engine *gin.Engine) {
 			ctx.JSON(statusCode, stdError)
 			return
 		}
-		// json response extension placeholder
-		ctx.JSON(statusCode, value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			ctx.JSON(statusCode, value)
+		}
 	})
 }
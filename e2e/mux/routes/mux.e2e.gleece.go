@@ -5,7 +5,7 @@ It includes routes and handlers by the Gleece API Routes Generator.
 --
 Authors: Haim Kastner & Yuval Pomerchik
 Generated by: Gleece Routes Generator
-Generated Date: 2025-02-25
+Generated Date: 2026-10-18
 Target Engine: Gorilla Mux (https://github.com/gorilla/mux)
 --
 Usage:
@@ -16,11 +16,19 @@ Repository: https://github.com/gopher-fleece/gleece
 */
 package routes
 import (
+	"context"
+	"encoding/base64"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 	"io"
+	"mime"
+	"mime/multipart"
 	"net/http"
+	"reflect"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 	"github.com/go-playground/validator/v10"
 	RequestAuth "github.com/gopher-fleece/gleece/e2e/mux/auth"
@@ -32,6 +40,39 @@ import (
 	Param41theBody "github.com/gopher-fleece/gleece/e2e/assets"
 	Response62CustomError "github.com/gopher-fleece/gleece/e2e/assets"
 	Response65CustomError "github.com/gopher-fleece/gleece/e2e/assets"
+	Param80status "github.com/gopher-fleece/gleece/e2e/assets"
+	Param81priority "github.com/gopher-fleece/gleece/e2e/assets"
+	Param82headerStatus "github.com/gopher-fleece/gleece/e2e/assets"
+	Param85id "github.com/google/uuid"
+	Param86at "time"
+	Param87timeout "time"
+	Param89raw "encoding/json"
+	Param90address "net"
+	Param91link "net/url"
+	Param94amount "github.com/gopher-fleece/gleece/e2e/assets/domain"
+	Param95tip "github.com/gopher-fleece/gleece/e2e/assets/domain"
+	ParamParser94amount "github.com/gopher-fleece/gleece/e2e/assets/domain"
+	ParamParser95tip "github.com/gopher-fleece/gleece/e2e/assets/domain"
+	Param98envelopeArg0 "github.com/gopher-fleece/gleece/e2e/assets"
+	Param98envelope "github.com/gopher-fleece/gleece/e2e/assets/domain"
+	Param101envelopeArg0Value "github.com/gopher-fleece/gleece/e2e/assets"
+	Param101envelope "github.com/gopher-fleece/gleece/e2e/assets/domain"
+	Param101envelopeArg0Key "github.com/gopher-fleece/gleece/e2e/assets/domain"
+	Param107statusesItem "github.com/gopher-fleece/gleece/e2e/assets"
+	Param108keysItem "github.com/google/uuid"
+	Param112filter "github.com/gopher-fleece/gleece/e2e/assets"
+	Param116filterStatus "github.com/gopher-fleece/gleece/e2e/assets"
+	Param120pet "github.com/gopher-fleece/gleece/e2e/assets"
+	Param126customerId "github.com/gopher-fleece/gleece/e2e/assets"
+	Param127amount "github.com/gopher-fleece/gleece/e2e/assets"
+	Param128splitsItem "github.com/gopher-fleece/gleece/e2e/assets"
+	Param129currency "github.com/gopher-fleece/gleece/e2e/assets"
+	Param132info "github.com/gopher-fleece/gleece/e2e/assets"
+	Param137status "github.com/gopher-fleece/gleece/e2e/assets"
+	Param147info "github.com/gopher-fleece/gleece/e2e/assets"
+	Param150book "github.com/gopher-fleece/gleece/e2e/assets"
+	Param157avatar "mime/multipart"
+	Param158attachmentsItem "mime/multipart"
 	E2EClassSecControllerImport "github.com/gopher-fleece/gleece/e2e/assets"
 	// import extension placeholder
 )
@@ -41,6 +82,8 @@ type SecurityListRelation string
 const (
 	SecurityListRelationAnd SecurityListRelation = "AND"
 )
+// The amount of memory multipart forms are parsed into, matching net/http's default. Larger files are stored on disk
+const multipartFormMaxMemory = 32 << 20
 type SecurityCheckList struct {
 	Checks   []runtime.SecurityCheck
 	Relation SecurityListRelation
@@ -76,7 +119,7 @@ func getStatusCode(controller runtime.Controller, hasReturnValue bool, err error
 	}
 	return http.StatusNoContent
 }
-func bindAndValidateBody[TOutput any](ctx *http.Request, contentType string, validation string, output **TOutput) error {
+func bindAndValidateBody[TOutput any](ctx *http.Request, contentType string, validation string, defaults string, output **TOutput) error {
 	var err error
 	bodyBytes, err := io.ReadAll(ctx.Body)
 	if err != nil || len(bodyBytes) == 0 {
@@ -86,9 +129,17 @@ func bindAndValidateBody[TOutput any](ctx *http.Request, contentType string, val
 		return nil
 	}
 	var deserializedOutput TOutput
+	// Fields absent from the body retain their default values
+	if defaults != "" {
+		if err = json.Unmarshal([]byte(defaults), &deserializedOutput); err != nil {
+			return err
+		}
+	}
 	switch contentType {
 	case "application/json":
 		err = json.Unmarshal(bodyBytes, &deserializedOutput)
+	case "application/xml":
+		err = xml.Unmarshal(bodyBytes, &deserializedOutput)
 	default:
 		return fmt.Errorf("content-type %s is not currently supported by the validation subsystem", contentType)
 	}
@@ -149,6 +200,80 @@ func handleAuthorizationError(w http.ResponseWriter, authErr *runtime.SecurityEr
 	w.WriteHeader(statusCode)
 	json.NewEncoder(w).Encode(stdError)
 }
+func handleContentNegotiationError(w http.ResponseWriter, statusCode int, detail string, operationId string) {
+	stdError := runtime.Rfc7807Error{
+		Type:     http.StatusText(statusCode),
+		Detail:   detail,
+		Status:   statusCode,
+		Instance: "/gleece/negotiation/error/" + operationId,
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(stdError)
+}
+// negotiateContentType returns the media type a response should be sent in, per the request's 'Accept' header.
+// The media type the client prefers is chosen among those the operation produces, with ties broken by the operation's order.
+// Returns an empty string if the client accepts none of them
+func negotiateContentType(acceptHeader string, produces []string) string {
+	if strings.TrimSpace(acceptHeader) == "" {
+		return produces[0]
+	}
+	bestContentType := ""
+	bestQuality := 0.0
+	for _, contentType := range produces {
+		if quality := getAcceptedQuality(acceptHeader, contentType); quality > bestQuality {
+			bestContentType = contentType
+			bestQuality = quality
+		}
+	}
+	return bestContentType
+}
+// getAcceptedQuality returns the quality an 'Accept' header assigns to the given media type,
+// per the most specific of the header's media ranges matching it. Returns 0 if no media range matches
+func getAcceptedQuality(acceptHeader string, contentType string) float64 {
+	mainType, _, _ := strings.Cut(contentType, "/")
+	quality := 0.0
+	bestSpecificity := -1
+	for _, mediaRange := range strings.Split(acceptHeader, ",") {
+		rangeType, params, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+		if err != nil {
+			continue
+		}
+		specificity := -1
+		switch rangeType {
+		case contentType:
+			specificity = 2
+		case mainType + "/*":
+			specificity = 1
+		case "*/*":
+			specificity = 0
+		}
+		if specificity <= bestSpecificity {
+			continue
+		}
+		bestSpecificity = specificity
+		quality = 1.0
+		if rawQuality, hasQuality := params["q"]; hasQuality {
+			if parsedQuality, err := strconv.ParseFloat(rawQuality, 64); err == nil {
+				quality = parsedQuality
+			}
+		}
+	}
+	return quality
+}
+// getRequestContentType returns the media type of a request's body, per its 'Content-Type' header.
+// Requests without a 'Content-Type' header are assumed to be sent in the first media type the operation consumes.
+// Returns false if the body's media type is not one the operation consumes
+func getRequestContentType(contentTypeHeader string, consumes []string) (string, bool) {
+	if strings.TrimSpace(contentTypeHeader) == "" {
+		return consumes[0], true
+	}
+	contentType, _, err := mime.ParseMediaType(contentTypeHeader)
+	if err != nil || !slices.Contains(consumes, contentType) {
+		return "", false
+	}
+	return contentType, true
+}
 func wrapValidatorError(validatorErr error, operationId string, fieldName string) runtime.Rfc7807Error {
 	return runtime.Rfc7807Error{
 		Type: http.StatusText(http.StatusUnprocessableEntity),
@@ -162,6 +287,207 @@ func wrapValidatorError(validatorErr error, operationId string, fieldName string
 		Instance: fmt.Sprintf("/gleece/validation/error/%s", operationId),
 	}
 }
+// wrapStructValidatorError wraps the validation errors of a struct-typed parameter, naming each of the struct's failed fields
+func wrapStructValidatorError(validatorErr error, operationId string, paramName string) runtime.Rfc7807Error {
+	return runtime.Rfc7807Error{
+		Type: http.StatusText(http.StatusUnprocessableEntity),
+		Detail: fmt.Sprintf(
+			"A request was made to operation '%s' but parameter '%s' did not pass validation - %s",
+			operationId,
+			paramName,
+			extractValidationErrorMessage(validatorErr, nil),
+		),
+		Status:   http.StatusUnprocessableEntity,
+		Instance: fmt.Sprintf("/gleece/validation/error/%s", operationId),
+	}
+}
+// bindAndValidatePolymorphicBody binds a body to a polymorphic interface.
+// The body is unmarshalled into the struct identified by the value of its discriminator property, which is then validated
+func bindAndValidatePolymorphicBody[TOutput any](
+	ctx *http.Request,
+	contentType string,
+	validation string,
+	discriminator string,
+	unmarshallers map[string]func([]byte) (TOutput, error),
+	output **TOutput,
+) error {
+	bodyBytes, err := io.ReadAll(ctx.Body)
+	if err != nil || len(bodyBytes) == 0 {
+		if strings.Contains(validation, "required") {
+			return fmt.Errorf("body is required but was not provided")
+		}
+		return nil
+	}
+	if contentType != "application/json" {
+		return fmt.Errorf("content-type %s is not currently supported by the validation subsystem", contentType)
+	}
+	var properties map[string]json.RawMessage
+	if err := json.Unmarshal(bodyBytes, &properties); err != nil {
+		return err
+	}
+	var discriminatorValue string
+	if err := json.Unmarshal(properties[discriminator], &discriminatorValue); err != nil {
+		return fmt.Errorf("body is missing string discriminator property '%s'", discriminator)
+	}
+	unmarshal, isKnown := unmarshallers[discriminatorValue]
+	if !isKnown {
+		return fmt.Errorf("'%s' is not a valid value for discriminator property '%s'", discriminatorValue, discriminator)
+	}
+	deserializedOutput, err := unmarshal(bodyBytes)
+	if err != nil {
+		return err
+	}
+	if err = validatorInstance.Struct(deserializedOutput); err != nil {
+		return err
+	}
+	*output = &deserializedOutput
+	return nil
+}
+// unmarshalOneOfMember returns a function unmarshalling a body into the polymorphic interface member TMember.
+// Members implementing the interface via a pointer are returned by address
+func unmarshalOneOfMember[TMember any, TOutput any](isByAddress bool) func([]byte) (TOutput, error) {
+	return func(bodyBytes []byte) (TOutput, error) {
+		var member TMember
+		var output TOutput
+		if err := json.Unmarshal(bodyBytes, &member); err != nil {
+			return output, err
+		}
+		if isByAddress {
+			return any(&member).(TOutput), nil
+		}
+		return any(member).(TOutput), nil
+	}
+}
+// splitParamValues returns the individual values of an array parameter.
+// An empty separator indicates each value was sent separately (i.e., 'ids=1&ids=2').
+// Otherwise, values are delimited by the separator (i.e., 'ids=1,2') and empty raw values yield no items
+func splitParamValues(rawValues []string, separator string) []string {
+	if separator == "" {
+		return rawValues
+	}
+	values := []string{}
+	for _, rawValue := range rawValues {
+		if rawValue == "" {
+			continue
+		}
+		for _, value := range strings.Split(rawValue, separator) {
+			values = append(values, strings.TrimSpace(value))
+		}
+	}
+	return values
+}
+// getFormFiles returns the files uploaded under the given name in a multipart form, if any
+func getFormFiles(ctx *http.Request, name string) []*multipart.FileHeader {
+	if err := ctx.ParseMultipartForm(multipartFormMaxMemory); err != nil {
+		return nil
+	}
+	return ctx.MultipartForm.File[name]
+}
+// validateFormFiles verifies the files uploaded for a form file parameter.
+// Parameters bound to a single file may not receive several, and each file must not exceed the parameter's maximum size
+// (if non-zero) and must be of one of its allowed MIME types (if any)
+func validateFormFiles(files []*multipart.FileHeader, allowsMultiple bool, maxSize int64, allowedMimeTypes []string) error {
+	if !allowsMultiple && len(files) > 1 {
+		return fmt.Errorf("expected a single file but got %d", len(files))
+	}
+	for _, file := range files {
+		if maxSize > 0 && file.Size > maxSize {
+			return fmt.Errorf("file '%s' is %d bytes long which exceeds the maximum size of %d bytes", file.Filename, file.Size, maxSize)
+		}
+		fileMimeType := file.Header.Get("Content-Type")
+		if len(allowedMimeTypes) > 0 && !isMimeTypeAllowed(fileMimeType, allowedMimeTypes) {
+			return fmt.Errorf("file '%s' is of type '%s' which is not one of the allowed types %v", file.Filename, fileMimeType, allowedMimeTypes)
+		}
+	}
+	return nil
+}
+// isMimeTypeAllowed returns whether a MIME type matches any of the allowed ones, which may use a wildcard subtype, e.g. 'image/*'
+func isMimeTypeAllowed(mimeType string, allowedMimeTypes []string) bool {
+	mediaType, _, err := mime.ParseMediaType(mimeType)
+	if err != nil {
+		return false
+	}
+	for _, allowed := range allowedMimeTypes {
+		if allowed == mediaType {
+			return true
+		}
+		if mainType, isWildcard := strings.CutSuffix(allowed, "/*"); isWildcard && strings.HasPrefix(mediaType, mainType+"/") {
+			return true
+		}
+	}
+	return false
+}
+// writeStream writes a streamed response body of the given media type, closing the stream afterwards if it's an io.Closer.
+// Files are offered for download under the given file name, if any. A nil stream results in an empty body.
+// Returns the error that interrupted the stream, if any. The status code has been sent by then
+func writeStream(w http.ResponseWriter, statusCode int, contentType string, fileName string, stream io.Reader) error {
+	if closer, isCloser := stream.(io.Closer); isCloser {
+		defer closer.Close()
+	}
+	w.Header().Set("Content-Type", contentType)
+	if fileName != "" {
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileName}))
+	}
+	w.WriteHeader(statusCode)
+	if stream == nil {
+		return nil
+	}
+	_, err := io.Copy(w, stream)
+	return err
+}
+// writeEventStream writes each value received from a channel as a server-sent event carrying its JSON representation,
+// flushing it to the client right away. Writing stops once the channel is closed, a value cannot be serialized
+// or the client disconnects, i.e., the request's context is done. A value that cannot be serialized is reported as an 'error' event.
+//
+// Values sent after writing stops early are discarded so their sender is not blocked forever.
+// Senders should nonetheless stop sending, and close the channel, once the request's context is done
+func writeEventStream[TValue any](w http.ResponseWriter, requestCtx context.Context, statusCode int, events <-chan TValue) {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(statusCode)
+	flusher, canFlush := w.(http.Flusher)
+	if canFlush {
+		flusher.Flush()
+	}
+	if events == nil {
+		return
+	}
+	for {
+		select {
+		case <-requestCtx.Done():
+			go discardEvents(events)
+			return
+		case event, isOpen := <-events:
+			if !isOpen {
+				return
+			}
+			data, err := json.Marshal(event)
+			if err != nil {
+				writeEventStreamError(w, err)
+				if canFlush {
+					flusher.Flush()
+				}
+				go discardEvents(events)
+				return
+			}
+			fmt.Fprintf(w, "data: %s\n\n", data)
+			if canFlush {
+				flusher.Flush()
+			}
+		}
+	}
+}
+// writeEventStreamError writes a server-sent 'error' event describing why an event stream has ended early
+func writeEventStreamError(w io.Writer, err error) {
+	data, _ := json.Marshal(map[string]string{"error": err.Error()})
+	fmt.Fprintf(w, "event: error\ndata: %s\n\n", data)
+}
+// discardEvents receives and discards the values of an event stream that's no longer written, until the channel is closed
+func discardEvents[TValue any](events <-chan TValue) {
+	for range events {
+	}
+}
 // function declarations extension placeholder
 type MiddlewareFunc func(w http.ResponseWriter, r *http.Request) bool
 type ErrorMiddlewareFunc func(w http.ResponseWriter, r *http.Request, err error) bool
@@ -211,6 +537,15 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "SimpleGet")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'SimpleGet' cannot produce a response in any of the accepted media types",
+				"SimpleGet",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -258,10 +593,12 @@ func RegisterRoutes(engine *mux.Router) {
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		// json response extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
 	}).Methods("GET")
 	engine.HandleFunc(toMuxUrl("/e2e/simple-get-empty-string"), func(w http.ResponseWriter, ctx *http.Request) {
 		// route start routes extension placeholder
@@ -285,6 +622,15 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "SimpleGetEmptyString")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'SimpleGetEmptyString' cannot produce a response in any of the accepted media types",
+				"SimpleGetEmptyString",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -332,10 +678,12 @@ func RegisterRoutes(engine *mux.Router) {
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		// json response extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
 	}).Methods("GET")
 	engine.HandleFunc(toMuxUrl("/e2e/simple-get-ptr-string"), func(w http.ResponseWriter, ctx *http.Request) {
 		// route start routes extension placeholder
@@ -359,6 +707,15 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "SimpleGetPtrString")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'SimpleGetPtrString' cannot produce a response in any of the accepted media types",
+				"SimpleGetPtrString",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -406,10 +763,12 @@ func RegisterRoutes(engine *mux.Router) {
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		// json response extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
 	}).Methods("GET")
 	engine.HandleFunc(toMuxUrl("/e2e/simple-get-null-string"), func(w http.ResponseWriter, ctx *http.Request) {
 		// route start routes extension placeholder
@@ -433,6 +792,15 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "SimpleGetNullString")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'SimpleGetNullString' cannot produce a response in any of the accepted media types",
+				"SimpleGetNullString",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -480,10 +848,12 @@ func RegisterRoutes(engine *mux.Router) {
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		// json response extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
 	}).Methods("GET")
 	engine.HandleFunc(toMuxUrl("/e2e/simple-get-object"), func(w http.ResponseWriter, ctx *http.Request) {
 		// route start routes extension placeholder
@@ -507,6 +877,15 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "SimpleGetObject")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'SimpleGetObject' cannot produce a response in any of the accepted media types",
+				"SimpleGetObject",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -554,10 +933,12 @@ func RegisterRoutes(engine *mux.Router) {
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		// json response extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
 	}).Methods("GET")
 	engine.HandleFunc(toMuxUrl("/e2e/simple-get-object-ptr"), func(w http.ResponseWriter, ctx *http.Request) {
 		// route start routes extension placeholder
@@ -581,6 +962,15 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "SimpleGetObjectPtr")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'SimpleGetObjectPtr' cannot produce a response in any of the accepted media types",
+				"SimpleGetObjectPtr",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -628,10 +1018,12 @@ func RegisterRoutes(engine *mux.Router) {
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		// json response extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
 	}).Methods("GET")
 	engine.HandleFunc(toMuxUrl("/e2e/simple-get-object-null"), func(w http.ResponseWriter, ctx *http.Request) {
 		// route start routes extension placeholder
@@ -655,6 +1047,15 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "SimpleGetObjectNull")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'SimpleGetObjectNull' cannot produce a response in any of the accepted media types",
+				"SimpleGetObjectNull",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -702,10 +1103,12 @@ func RegisterRoutes(engine *mux.Router) {
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		// json response extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
 	}).Methods("GET")
 	engine.HandleFunc(toMuxUrl("/e2e/simple-get-empty"), func(w http.ResponseWriter, ctx *http.Request) {
 		// route start routes extension placeholder
@@ -729,6 +1132,15 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "SimpleGetEmpty")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'SimpleGetEmpty' cannot produce a response in any of the accepted media types",
+				"SimpleGetEmpty",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var queryParamRawPtr *string = nil
@@ -790,8 +1202,10 @@ func RegisterRoutes(engine *mux.Router) {
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		// json response extension placeholder
-		w.WriteHeader(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.WriteHeader(statusCode)
+		}
 	}).Methods("GET")
 	engine.HandleFunc(toMuxUrl("/e2e/get-with-all-params/{pathParam}"), func(w http.ResponseWriter, ctx *http.Request) {
 		// route start routes extension placeholder
@@ -815,6 +1229,15 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "GetWithAllParams")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'GetWithAllParams' cannot produce a response in any of the accepted media types",
+				"GetWithAllParams",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var queryParamRawPtr *string = nil
@@ -909,10 +1332,12 @@ func RegisterRoutes(engine *mux.Router) {
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		// json response extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
 	}).Methods("GET")
 	engine.HandleFunc(toMuxUrl("/e2e/get-with-all-params-ptr/{pathParam}"), func(w http.ResponseWriter, ctx *http.Request) {
 		// route start routes extension placeholder
@@ -936,6 +1361,15 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "GetWithAllParamsPtr")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'GetWithAllParamsPtr' cannot produce a response in any of the accepted media types",
+				"GetWithAllParamsPtr",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var queryParamRawPtr *string = nil
@@ -1016,10 +1450,12 @@ func RegisterRoutes(engine *mux.Router) {
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		// json response extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
 	}).Methods("GET")
 	engine.HandleFunc(toMuxUrl("/e2e/get-with-all-params-required-ptr/{pathParam}"), func(w http.ResponseWriter, ctx *http.Request) {
 		// route start routes extension placeholder
@@ -1043,6 +1479,15 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "GetWithAllParamsRequiredPtr")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'GetWithAllParamsRequiredPtr' cannot produce a response in any of the accepted media types",
+				"GetWithAllParamsRequiredPtr",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var queryParamRawPtr *string = nil
@@ -1137,10 +1582,12 @@ func RegisterRoutes(engine *mux.Router) {
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		// json response extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
 	}).Methods("GET")
 	engine.HandleFunc(toMuxUrl("/e2e/post-with-all-params-body"), func(w http.ResponseWriter, ctx *http.Request) {
 		// route start routes extension placeholder
@@ -1164,6 +1611,15 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "PostWithAllParamsWithBody")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'PostWithAllParamsWithBody' cannot produce a response in any of the accepted media types",
+				"PostWithAllParamsWithBody",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var conversionErr error
@@ -1201,7 +1657,16 @@ func RegisterRoutes(engine *mux.Router) {
 			return
 		}
 		var theBodyRawPtr *Param33theBody.BodyInfo = nil
-		conversionErr = bindAndValidateBody(ctx, "application/json", "required", &theBodyRawPtr)
+		requestContentType, isRequestContentTypeSupported := getRequestContentType(ctx.Header.Get("Content-Type"), []string{"application/json"})
+		if !isRequestContentTypeSupported {
+			handleContentNegotiationError(w,
+				http.StatusUnsupportedMediaType,
+				fmt.Sprintf("Operation 'PostWithAllParamsWithBody' does not accept request bodies of type '%s'", ctx.Header.Get("Content-Type")),
+				"PostWithAllParamsWithBody",
+			)
+			return
+		}
+		conversionErr = bindAndValidateBody(ctx, requestContentType, "required", "", &theBodyRawPtr)
 		if conversionErr != nil {
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
@@ -1264,10 +1729,12 @@ func RegisterRoutes(engine *mux.Router) {
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		// json response extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
 	}).Methods("POST")
 	engine.HandleFunc(toMuxUrl("/e2e/post-with-all-params-body-ptr"), func(w http.ResponseWriter, ctx *http.Request) {
 		// route start routes extension placeholder
@@ -1291,6 +1758,15 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "PostWithAllParamsWithBodyPtr")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'PostWithAllParamsWithBodyPtr' cannot produce a response in any of the accepted media types",
+				"PostWithAllParamsWithBodyPtr",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var conversionErr error
@@ -1314,7 +1790,16 @@ func RegisterRoutes(engine *mux.Router) {
 			headerParamRawPtr = &headerParam
 		}
 		var theBodyRawPtr *Param38theBody.BodyInfo = nil
-		conversionErr = bindAndValidateBody(ctx, "application/json", "", &theBodyRawPtr)
+		requestContentType, isRequestContentTypeSupported := getRequestContentType(ctx.Header.Get("Content-Type"), []string{"application/json"})
+		if !isRequestContentTypeSupported {
+			handleContentNegotiationError(w,
+				http.StatusUnsupportedMediaType,
+				fmt.Sprintf("Operation 'PostWithAllParamsWithBodyPtr' does not accept request bodies of type '%s'", ctx.Header.Get("Content-Type")),
+				"PostWithAllParamsWithBodyPtr",
+			)
+			return
+		}
+		conversionErr = bindAndValidateBody(ctx, requestContentType, "", "", &theBodyRawPtr)
 		if conversionErr != nil {
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
@@ -1377,10 +1862,12 @@ func RegisterRoutes(engine *mux.Router) {
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		// json response extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
 	}).Methods("POST")
 	engine.HandleFunc(toMuxUrl("/e2e/post-with-all-params-body-required-ptr"), func(w http.ResponseWriter, ctx *http.Request) {
 		// route start routes extension placeholder
@@ -1404,11 +1891,29 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "PostWithAllParamsWithBodyRequiredPtr")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'PostWithAllParamsWithBodyRequiredPtr' cannot produce a response in any of the accepted media types",
+				"PostWithAllParamsWithBodyRequiredPtr",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var conversionErr error
 		var theBodyRawPtr *Param41theBody.BodyInfo = nil
-		conversionErr = bindAndValidateBody(ctx, "application/json", "required", &theBodyRawPtr)
+		requestContentType, isRequestContentTypeSupported := getRequestContentType(ctx.Header.Get("Content-Type"), []string{"application/json"})
+		if !isRequestContentTypeSupported {
+			handleContentNegotiationError(w,
+				http.StatusUnsupportedMediaType,
+				fmt.Sprintf("Operation 'PostWithAllParamsWithBodyRequiredPtr' does not accept request bodies of type '%s'", ctx.Header.Get("Content-Type")),
+				"PostWithAllParamsWithBodyRequiredPtr",
+			)
+			return
+		}
+		conversionErr = bindAndValidateBody(ctx, requestContentType, "required", "", &theBodyRawPtr)
 		if conversionErr != nil {
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
@@ -1471,10 +1976,12 @@ func RegisterRoutes(engine *mux.Router) {
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		// json response extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
 	}).Methods("POST")
 	engine.HandleFunc(toMuxUrl("/e2e/get-header-start-with-letter"), func(w http.ResponseWriter, ctx *http.Request) {
 		// route start routes extension placeholder
@@ -1498,6 +2005,15 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "GetHeaderStartWithLetter")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'GetHeaderStartWithLetter' cannot produce a response in any of the accepted media types",
+				"GetHeaderStartWithLetter",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var headerParamRawPtr *string = nil
@@ -1564,10 +2080,12 @@ func RegisterRoutes(engine *mux.Router) {
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		// json response extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
 	}).Methods("GET")
 	engine.HandleFunc(toMuxUrl("/e2e/with-default-config-security"), func(w http.ResponseWriter, ctx *http.Request) {
 		// route start routes extension placeholder
@@ -1591,6 +2109,15 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "WithDefaultConfigSecurity")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'WithDefaultConfigSecurity' cannot produce a response in any of the accepted media types",
+				"WithDefaultConfigSecurity",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var headerParamRawPtr *string = nil
@@ -1657,10 +2184,12 @@ func RegisterRoutes(engine *mux.Router) {
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		// json response extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
 	}).Methods("GET")
 	engine.HandleFunc(toMuxUrl("/e2e/with-one-security"), func(w http.ResponseWriter, ctx *http.Request) {
 		// route start routes extension placeholder
@@ -1684,6 +2213,15 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "WithOneSecurity")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'WithOneSecurity' cannot produce a response in any of the accepted media types",
+				"WithOneSecurity",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var headerParamRawPtr *string = nil
@@ -1750,10 +2288,12 @@ func RegisterRoutes(engine *mux.Router) {
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		// json response extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
 	}).Methods("GET")
 	engine.HandleFunc(toMuxUrl("/e2e/with-two-security"), func(w http.ResponseWriter, ctx *http.Request) {
 		// route start routes extension placeholder
@@ -1789,6 +2329,15 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "WithTwoSecurity")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'WithTwoSecurity' cannot produce a response in any of the accepted media types",
+				"WithTwoSecurity",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var headerParamRawPtr *string = nil
@@ -1855,10 +2404,12 @@ func RegisterRoutes(engine *mux.Router) {
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		// json response extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
 	}).Methods("GET")
 	engine.HandleFunc(toMuxUrl("/e2e/with-two-security-same-method"), func(w http.ResponseWriter, ctx *http.Request) {
 		// route start routes extension placeholder
@@ -1894,6 +2445,15 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "WithTwoSecuritySameMethod")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'WithTwoSecuritySameMethod' cannot produce a response in any of the accepted media types",
+				"WithTwoSecuritySameMethod",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		var headerParamRawPtr *string = nil
@@ -1960,10 +2520,12 @@ func RegisterRoutes(engine *mux.Router) {
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		// json response extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
 	}).Methods("GET")
 	engine.HandleFunc(toMuxUrl("/e2e/default-error"), func(w http.ResponseWriter, ctx *http.Request) {
 		// route start routes extension placeholder
@@ -1987,6 +2549,15 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "DefaultError")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'DefaultError' cannot produce a response in any of the accepted media types",
+				"DefaultError",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -2034,8 +2605,10 @@ func RegisterRoutes(engine *mux.Router) {
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		// json response extension placeholder
-		w.WriteHeader(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.WriteHeader(statusCode)
+		}
 	}).Methods("GET")
 	engine.HandleFunc(toMuxUrl("/e2e/default-error-with-payload"), func(w http.ResponseWriter, ctx *http.Request) {
 		// route start routes extension placeholder
@@ -2059,6 +2632,15 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "DefaultErrorWithPayload")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'DefaultErrorWithPayload' cannot produce a response in any of the accepted media types",
+				"DefaultErrorWithPayload",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -2106,10 +2688,12 @@ func RegisterRoutes(engine *mux.Router) {
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		// json response extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
 	}).Methods("GET")
 	engine.HandleFunc(toMuxUrl("/e2e/custom-error"), func(w http.ResponseWriter, ctx *http.Request) {
 		// route start routes extension placeholder
@@ -2133,6 +2717,15 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "CustomError")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'CustomError' cannot produce a response in any of the accepted media types",
+				"CustomError",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -2173,8 +2766,10 @@ func RegisterRoutes(engine *mux.Router) {
 			json.NewEncoder(w).Encode(opError)
 			return
 		}
-		// json response extension placeholder
-		w.WriteHeader(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.WriteHeader(statusCode)
+		}
 	}).Methods("GET")
 	engine.HandleFunc(toMuxUrl("/e2e/custom-error-ptr"), func(w http.ResponseWriter, ctx *http.Request) {
 		// route start routes extension placeholder
@@ -2198,6 +2793,15 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "CustomPtrError")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'CustomPtrError' cannot produce a response in any of the accepted media types",
+				"CustomPtrError",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -2237,8 +2841,10 @@ func RegisterRoutes(engine *mux.Router) {
 			json.NewEncoder(w).Encode(opError)
 			return
 		}
-		// json response extension placeholder
-		w.WriteHeader(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.WriteHeader(statusCode)
+		}
 	}).Methods("GET")
 	engine.HandleFunc(toMuxUrl("/e2e/503-error-code"), func(w http.ResponseWriter, ctx *http.Request) {
 		// route start routes extension placeholder
@@ -2262,6 +2868,15 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "Error503")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'Error503' cannot produce a response in any of the accepted media types",
+				"Error503",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -2309,8 +2924,10 @@ func RegisterRoutes(engine *mux.Router) {
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		// json response extension placeholder
-		w.WriteHeader(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.WriteHeader(statusCode)
+		}
 	}).Methods("GET")
 	engine.HandleFunc(toMuxUrl("/e2e/custom-error-503"), func(w http.ResponseWriter, ctx *http.Request) {
 		// route start routes extension placeholder
@@ -2334,6 +2951,15 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "CustomError503")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'CustomError503' cannot produce a response in any of the accepted media types",
+				"CustomError503",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -2374,8 +3000,10 @@ func RegisterRoutes(engine *mux.Router) {
 			json.NewEncoder(w).Encode(opError)
 			return
 		}
-		// json response extension placeholder
-		w.WriteHeader(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.WriteHeader(statusCode)
+		}
 	}).Methods("GET")
 	engine.HandleFunc(toMuxUrl("/e2e/context-access"), func(w http.ResponseWriter, ctx *http.Request) {
 		// route start routes extension placeholder
@@ -2399,7 +3027,16 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "ContextAccess")
 			return
 		}
-		controller := E2EControllerImport.E2EController{}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'ContextAccess' cannot produce a response in any of the accepted media types",
+				"ContextAccess",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -2446,8 +3083,10 @@ func RegisterRoutes(engine *mux.Router) {
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		// json response extension placeholder
-		w.WriteHeader(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.WriteHeader(statusCode)
+		}
 	}).Methods("GET")
 	engine.HandleFunc(toMuxUrl("/e2e/http-method"), func(w http.ResponseWriter, ctx *http.Request) {
 		// route start routes extension placeholder
@@ -2471,6 +3110,15 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "Get")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'Get' cannot produce a response in any of the accepted media types",
+				"Get",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -2518,8 +3166,10 @@ func RegisterRoutes(engine *mux.Router) {
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		// json response extension placeholder
-		w.WriteHeader(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.WriteHeader(statusCode)
+		}
 	}).Methods("GET")
 	engine.HandleFunc(toMuxUrl("/e2e/http-method"), func(w http.ResponseWriter, ctx *http.Request) {
 		// route start routes extension placeholder
@@ -2543,6 +3193,15 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "Post")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'Post' cannot produce a response in any of the accepted media types",
+				"Post",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -2590,8 +3249,10 @@ func RegisterRoutes(engine *mux.Router) {
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		// json response extension placeholder
-		w.WriteHeader(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.WriteHeader(statusCode)
+		}
 	}).Methods("POST")
 	engine.HandleFunc(toMuxUrl("/e2e/http-method"), func(w http.ResponseWriter, ctx *http.Request) {
 		// route start routes extension placeholder
@@ -2615,6 +3276,15 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "Put")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'Put' cannot produce a response in any of the accepted media types",
+				"Put",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -2662,8 +3332,10 @@ func RegisterRoutes(engine *mux.Router) {
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		// json response extension placeholder
-		w.WriteHeader(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.WriteHeader(statusCode)
+		}
 	}).Methods("PUT")
 	engine.HandleFunc(toMuxUrl("/e2e/http-method"), func(w http.ResponseWriter, ctx *http.Request) {
 		// route start routes extension placeholder
@@ -2687,6 +3359,15 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "Delete")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'Delete' cannot produce a response in any of the accepted media types",
+				"Delete",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -2734,8 +3415,10 @@ func RegisterRoutes(engine *mux.Router) {
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		// json response extension placeholder
-		w.WriteHeader(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.WriteHeader(statusCode)
+		}
 	}).Methods("DELETE")
 	engine.HandleFunc(toMuxUrl("/e2e/http-method"), func(w http.ResponseWriter, ctx *http.Request) {
 		// route start routes extension placeholder
@@ -2759,6 +3442,15 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "Patch")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'Patch' cannot produce a response in any of the accepted media types",
+				"Patch",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -2806,8 +3498,10 @@ func RegisterRoutes(engine *mux.Router) {
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		// json response extension placeholder
-		w.WriteHeader(statusCode)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.WriteHeader(statusCode)
+		}
 	}).Methods("PATCH")
 	engine.HandleFunc(toMuxUrl("/e2e/template-context-1"), func(w http.ResponseWriter, ctx *http.Request) {
 		// route start routes extension placeholder
@@ -2831,6 +3525,15 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "TemplateContext1")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'TemplateContext1' cannot produce a response in any of the accepted media types",
+				"TemplateContext1",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -2879,10 +3582,12 @@ func RegisterRoutes(engine *mux.Router) {
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		// json response extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
 	}).Methods("GET")
 	engine.HandleFunc(toMuxUrl("/e2e/template-context-2"), func(w http.ResponseWriter, ctx *http.Request) {
 		// route start routes extension placeholder
@@ -2906,6 +3611,15 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "TemplateContext2")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'TemplateContext2' cannot produce a response in any of the accepted media types",
+				"TemplateContext2",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		// Middlewares beforeOperationMiddlewares section
@@ -2955,10 +3669,12 @@ func RegisterRoutes(engine *mux.Router) {
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		// json response extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
 	}).Methods("GET")
 	engine.HandleFunc(toMuxUrl("/e2e/form"), func(w http.ResponseWriter, ctx *http.Request) {
 		// route start routes extension placeholder
@@ -2982,6 +3698,15 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "TestForm")
 			return
 		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'TestForm' cannot produce a response in any of the accepted media types",
+				"TestForm",
+			)
+			return
+		}
 		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
 		ctx.ParseForm()
@@ -3065,13 +3790,14 @@ func RegisterRoutes(engine *mux.Router) {
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		// json response extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
 	}).Methods("POST")
-	// E2EClassSecController
-	engine.HandleFunc(toMuxUrl("/e2e/with-default-class-security"), func(w http.ResponseWriter, ctx *http.Request) {
+	engine.HandleFunc(toMuxUrl("/e2e/enum-params/{status}"), func(w http.ResponseWriter, ctx *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ctx,
@@ -3080,9 +3806,9 @@ func RegisterRoutes(engine *mux.Router) {
 					Relation: SecurityListRelationAnd,
 					Checks: []runtime.SecurityCheck{
 						{
-							SchemaName: "securitySchemaName",
+							SchemaName: "securitySchemaName2",
 							Scopes: []string{
-								"class",
+								"config",
 							},
 						},
 					},
@@ -3090,26 +3816,142 @@ func RegisterRoutes(engine *mux.Router) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(w, authErr, "WithDefaultClassSecurity")
+			handleAuthorizationError(w, authErr, "EnumParams")
 			return
 		}
-		controller := E2EClassSecControllerImport.E2EClassSecController{}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'EnumParams' cannot produce a response in any of the accepted media types",
+				"EnumParams",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
-		var headerParamRawPtr *string = nil
-		headerParamRaw := ctx.Header.Get("x-test-scopes")
-		_, isheaderParamExists := ctx.Header["x-test-scopes"]
-		if !isheaderParamExists {
-			// In echo, the ctx..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
-			headerValues := ctx.Header.Values("x-test-scopes")
-			isheaderParamExists = len(headerValues) > 0
+		statusvars := mux.Vars(ctx)
+		var statusRawPtr *Param80status.OrderStatus = nil
+		statusRaw, isstatusExists := statusvars["status"]
+		if isstatusExists {
+			status := statusRaw
+			statusEnum := Param80status.OrderStatus(status)
+			if !slices.Contains([]Param80status.OrderStatus{"pending", "shipped", "delivered"}, statusEnum) {
+				conversionErr := fmt.Errorf("'%s' is not a valid OrderStatus value", statusRaw)
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'EnumParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"status",
+						"OrderStatus",
+						reflect.TypeOf(statusRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/EnumParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(validationError)
+				return
+			}
+			statusRawPtr = &statusEnum
 		}
-		if isheaderParamExists {
-			headerParam := headerParamRaw
-			headerParamRawPtr = &headerParam
+		if validatorErr := validatorInstance.Var(statusRawPtr, "required"); validatorErr != nil {
+			fieldName := "status"
+			validationError := wrapValidatorError(validatorErr, "EnumParams", fieldName)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
 		}
-		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
-			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithDefaultClassSecurity", fieldName)
+		var priorityRawPtr *Param81priority.OrderPriority = nil
+		priorityRaw := ctx.URL.Query().Get("priority")
+		ispriorityExists := ctx.URL.Query().Has("priority")
+		if ispriorityExists {
+			priorityUint64, conversionErr := strconv.Atoi(priorityRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'EnumParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"priority",
+						"OrderPriority",
+						reflect.TypeOf(priorityRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/EnumParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(validationError)
+				return
+			}
+			priority := int(priorityUint64)
+			priorityEnum := Param81priority.OrderPriority(priority)
+			if !slices.Contains([]Param81priority.OrderPriority{0, 1, 2}, priorityEnum) {
+				conversionErr := fmt.Errorf("'%s' is not a valid OrderPriority value", priorityRaw)
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'EnumParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"priority",
+						"OrderPriority",
+						reflect.TypeOf(priorityRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/EnumParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(validationError)
+				return
+			}
+			priorityRawPtr = &priorityEnum
+		}
+		if validatorErr := validatorInstance.Var(priorityRawPtr, "required"); validatorErr != nil {
+			fieldName := "priority"
+			validationError := wrapValidatorError(validatorErr, "EnumParams", fieldName)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		var headerStatusRawPtr *Param82headerStatus.OrderStatus = nil
+		headerStatusRaw := ctx.Header.Get("x-status")
+		_, isheaderStatusExists := ctx.Header["x-status"]
+		if !isheaderStatusExists {
+			// In echo, the ctx..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := ctx.Header.Values("x-status")
+			isheaderStatusExists = len(headerValues) > 0
+		}
+		if isheaderStatusExists {
+			headerStatus := headerStatusRaw
+			headerStatusEnum := Param82headerStatus.OrderStatus(headerStatus)
+			if !slices.Contains([]Param82headerStatus.OrderStatus{"pending", "shipped", "delivered"}, headerStatusEnum) {
+				conversionErr := fmt.Errorf("'%s' is not a valid OrderStatus value", headerStatusRaw)
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'EnumParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"headerStatus",
+						"OrderStatus",
+						reflect.TypeOf(headerStatusRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/EnumParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(validationError)
+				return
+			}
+			headerStatusRawPtr = &headerStatusEnum
+		}
+		if validatorErr := validatorInstance.Var(headerStatusRawPtr, "required"); validatorErr != nil {
+			fieldName := "headerStatus"
+			validationError := wrapValidatorError(validatorErr, "EnumParams", fieldName)
 			w.WriteHeader(http.StatusUnprocessableEntity)
 			json.NewEncoder(w).Encode(validationError)
 			return
@@ -3122,13 +3964,13 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		// before operation routes extension placeholder
-		value, opError := controller.WithDefaultClassSecurity(*headerParamRawPtr)
+		value, opError := controller.EnumParams(*statusRawPtr, *priorityRawPtr, *headerStatusRawPtr)
 		// after operation routes extension placeholder
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
-		w.Header().Set("x-extended", "WithDefaultClassSecurity")
+		w.Header().Set("x-extended", "EnumParams")
 		statusCode := getStatusCode(&controller, true, opError)
 		if opError == nil {
 			// Middlewares afterOperationSuccessMiddlewares section
@@ -3149,9 +3991,9 @@ func RegisterRoutes(engine *mux.Router) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'WithDefaultClassSecurity'",
+				Detail:     "Encountered an error during operation 'EnumParams'",
 				Status:     statusCode,
-				Instance:   "/gleece/controller/error/WithDefaultClassSecurity",
+				Instance:   "/gleece/controller/error/EnumParams",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
 			// json error response extension placeholder
@@ -3159,12 +4001,14 @@ func RegisterRoutes(engine *mux.Router) {
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		// json response extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
 	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/with-default-override-class-security"), func(w http.ResponseWriter, ctx *http.Request) {
+	engine.HandleFunc(toMuxUrl("/e2e/well-known-params/{id}"), func(w http.ResponseWriter, ctx *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ctx,
@@ -3173,9 +4017,9 @@ func RegisterRoutes(engine *mux.Router) {
 					Relation: SecurityListRelationAnd,
 					Checks: []runtime.SecurityCheck{
 						{
-							SchemaName: "securitySchemaName",
+							SchemaName: "securitySchemaName2",
 							Scopes: []string{
-								"method",
+								"config",
 							},
 						},
 					},
@@ -3183,26 +4027,249 @@ func RegisterRoutes(engine *mux.Router) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(w, authErr, "WithOverrideClassSecurity")
+			handleAuthorizationError(w, authErr, "WellKnownParams")
 			return
 		}
-		controller := E2EClassSecControllerImport.E2EClassSecController{}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'WellKnownParams' cannot produce a response in any of the accepted media types",
+				"WellKnownParams",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
 		controller.InitController(ctx)
-		var headerParamRawPtr *string = nil
-		headerParamRaw := ctx.Header.Get("x-test-scopes")
-		_, isheaderParamExists := ctx.Header["x-test-scopes"]
-		if !isheaderParamExists {
+		idvars := mux.Vars(ctx)
+		var idRawPtr *Param85id.UUID = nil
+		idRaw, isidExists := idvars["id"]
+		if isidExists {
+			id, conversionErr := Param85id.Parse(idRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'WellKnownParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"id",
+						"UUID",
+						reflect.TypeOf(idRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/WellKnownParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(validationError)
+				return
+			}
+			idRawPtr = &id
+		}
+		if validatorErr := validatorInstance.Var(idRawPtr, "required"); validatorErr != nil {
+			fieldName := "id"
+			validationError := wrapValidatorError(validatorErr, "WellKnownParams", fieldName)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		var atRawPtr *Param86at.Time = nil
+		atRaw := ctx.URL.Query().Get("at")
+		isatExists := ctx.URL.Query().Has("at")
+		if isatExists {
+			at, conversionErr := Param86at.Parse(Param86at.RFC3339, atRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'WellKnownParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"at",
+						"Time",
+						reflect.TypeOf(atRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/WellKnownParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(validationError)
+				return
+			}
+			atRawPtr = &at
+		}
+		if validatorErr := validatorInstance.Var(atRawPtr, "required"); validatorErr != nil {
+			fieldName := "at"
+			validationError := wrapValidatorError(validatorErr, "WellKnownParams", fieldName)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		var timeoutRawPtr *Param87timeout.Duration = nil
+		timeoutRaw := ctx.URL.Query().Get("timeout")
+		istimeoutExists := ctx.URL.Query().Has("timeout")
+		if istimeoutExists {
+			timeoutInt64, conversionErr := strconv.ParseInt(timeoutRaw, 10, 64)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'WellKnownParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"timeout",
+						"Duration",
+						reflect.TypeOf(timeoutRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/WellKnownParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(validationError)
+				return
+			}
+			timeout := Param87timeout.Duration(timeoutInt64)
+			timeoutRawPtr = &timeout
+		}
+		if validatorErr := validatorInstance.Var(timeoutRawPtr, "required"); validatorErr != nil {
+			fieldName := "timeout"
+			validationError := wrapValidatorError(validatorErr, "WellKnownParams", fieldName)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		var dataRawPtr *[]byte = nil
+		dataRaw := ctx.URL.Query().Get("data")
+		isdataExists := ctx.URL.Query().Has("data")
+		if isdataExists {
+			data, conversionErr := base64.StdEncoding.DecodeString(dataRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'WellKnownParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"data",
+						"[]byte",
+						reflect.TypeOf(dataRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/WellKnownParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(validationError)
+				return
+			}
+			dataRawPtr = &data
+		}
+		if validatorErr := validatorInstance.Var(dataRawPtr, "required"); validatorErr != nil {
+			fieldName := "data"
+			validationError := wrapValidatorError(validatorErr, "WellKnownParams", fieldName)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		var rawRawPtr *Param89raw.RawMessage = nil
+		rawRaw := ctx.URL.Query().Get("raw")
+		israwExists := ctx.URL.Query().Has("raw")
+		if israwExists {
+			raw := Param89raw.RawMessage(rawRaw)
+			if !Param89raw.Valid(raw) {
+				conversionErr := fmt.Errorf("'%s' is not valid JSON", rawRaw)
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'WellKnownParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"raw",
+						"RawMessage",
+						reflect.TypeOf(rawRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/WellKnownParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(validationError)
+				return
+			}
+			rawRawPtr = &raw
+		}
+		if validatorErr := validatorInstance.Var(rawRawPtr, "required"); validatorErr != nil {
+			fieldName := "raw"
+			validationError := wrapValidatorError(validatorErr, "WellKnownParams", fieldName)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		var addressRawPtr *Param90address.IP = nil
+		addressRaw := ctx.Header.Get("x-address")
+		_, isaddressExists := ctx.Header["x-address"]
+		if !isaddressExists {
 			// In echo, the ctx..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
-			headerValues := ctx.Header.Values("x-test-scopes")
-			isheaderParamExists = len(headerValues) > 0
+			headerValues := ctx.Header.Values("x-address")
+			isaddressExists = len(headerValues) > 0
+		}
+		if isaddressExists {
+			address := Param90address.ParseIP(addressRaw)
+			if address == nil {
+				conversionErr := fmt.Errorf("'%s' is not a valid IP address", addressRaw)
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'WellKnownParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"address",
+						"IP",
+						reflect.TypeOf(addressRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/WellKnownParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(validationError)
+				return
+			}
+			addressRawPtr = &address
 		}
-		if isheaderParamExists {
-			headerParam := headerParamRaw
-			headerParamRawPtr = &headerParam
+		if validatorErr := validatorInstance.Var(addressRawPtr, "required"); validatorErr != nil {
+			fieldName := "address"
+			validationError := wrapValidatorError(validatorErr, "WellKnownParams", fieldName)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
 		}
-		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
-			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithOverrideClassSecurity", fieldName)
+		var linkRawPtr *Param91link.URL = nil
+		linkRaw := ctx.URL.Query().Get("link")
+		islinkExists := ctx.URL.Query().Has("link")
+		if islinkExists {
+			linkUrl, conversionErr := Param91link.ParseRequestURI(linkRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'WellKnownParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"link",
+						"URL",
+						reflect.TypeOf(linkRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/WellKnownParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(validationError)
+				return
+			}
+			link := *linkUrl
+			linkRawPtr = &link
+		}
+		if validatorErr := validatorInstance.Var(linkRawPtr, "required"); validatorErr != nil {
+			fieldName := "link"
+			validationError := wrapValidatorError(validatorErr, "WellKnownParams", fieldName)
 			w.WriteHeader(http.StatusUnprocessableEntity)
 			json.NewEncoder(w).Encode(validationError)
 			return
@@ -3215,13 +4282,13 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		// before operation routes extension placeholder
-		value, opError := controller.WithOverrideClassSecurity(*headerParamRawPtr)
+		value, opError := controller.WellKnownParams(*idRawPtr, *atRawPtr, *timeoutRawPtr, *dataRawPtr, *rawRawPtr, *addressRawPtr, *linkRawPtr)
 		// after operation routes extension placeholder
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
-		w.Header().Set("x-extended", "WithOverrideClassSecurity")
+		w.Header().Set("x-extended", "WellKnownParams")
 		statusCode := getStatusCode(&controller, true, opError)
 		if opError == nil {
 			// Middlewares afterOperationSuccessMiddlewares section
@@ -3242,9 +4309,9 @@ func RegisterRoutes(engine *mux.Router) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'WithOverrideClassSecurity'",
+				Detail:     "Encountered an error during operation 'WellKnownParams'",
 				Status:     statusCode,
-				Instance:   "/gleece/controller/error/WithOverrideClassSecurity",
+				Instance:   "/gleece/controller/error/WellKnownParams",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
 			// json error response extension placeholder
@@ -3252,9 +4319,2968 @@ func RegisterRoutes(engine *mux.Router) {
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		// json response extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
+	}).Methods("GET")
+	engine.HandleFunc(toMuxUrl("/e2e/mapped-params/{amount}"), func(w http.ResponseWriter, ctx *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "MappedParams")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'MappedParams' cannot produce a response in any of the accepted media types",
+				"MappedParams",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		amountvars := mux.Vars(ctx)
+		var amountRawPtr *Param94amount.Amount = nil
+		amountRaw, isamountExists := amountvars["amount"]
+		if isamountExists {
+			amount, conversionErr := ParamParser94amount.ParseAmount(amountRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'MappedParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"amount",
+						"Amount",
+						reflect.TypeOf(amountRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/MappedParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(validationError)
+				return
+			}
+			amountRawPtr = &amount
+		}
+		if validatorErr := validatorInstance.Var(amountRawPtr, "required"); validatorErr != nil {
+			fieldName := "amount"
+			validationError := wrapValidatorError(validatorErr, "MappedParams", fieldName)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		var tipRawPtr *Param95tip.Amount = nil
+		tipRaw := ctx.URL.Query().Get("tip")
+		istipExists := ctx.URL.Query().Has("tip")
+		if istipExists {
+			tip, conversionErr := ParamParser95tip.ParseAmount(tipRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'MappedParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"tip",
+						"Amount",
+						reflect.TypeOf(tipRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/MappedParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(validationError)
+				return
+			}
+			tipRawPtr = &tip
+		}
+		if validatorErr := validatorInstance.Var(tipRawPtr, "required"); validatorErr != nil {
+			fieldName := "tip"
+			validationError := wrapValidatorError(validatorErr, "MappedParams", fieldName)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(w, ctx); continueOperation == false {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.MappedParams(*amountRawPtr, *tipRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-extended", "MappedParams")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(w, ctx); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(w, ctx, opError); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'MappedParams'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/MappedParams",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
+	}).Methods("GET")
+	engine.HandleFunc(toMuxUrl("/e2e/generic-envelope"), func(w http.ResponseWriter, ctx *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "GenericEnvelope")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'GenericEnvelope' cannot produce a response in any of the accepted media types",
+				"GenericEnvelope",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var conversionErr error
+		var envelopeRawPtr *Param98envelope.Envelope[Param98envelopeArg0.BodyInfo] = nil
+		requestContentType, isRequestContentTypeSupported := getRequestContentType(ctx.Header.Get("Content-Type"), []string{"application/json"})
+		if !isRequestContentTypeSupported {
+			handleContentNegotiationError(w,
+				http.StatusUnsupportedMediaType,
+				fmt.Sprintf("Operation 'GenericEnvelope' does not accept request bodies of type '%s'", ctx.Header.Get("Content-Type")),
+				"GenericEnvelope",
+			)
+			return
+		}
+		conversionErr = bindAndValidateBody(ctx, requestContentType, "required", "", &envelopeRawPtr)
+		if conversionErr != nil {
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: fmt.Sprintf(
+					"A request was made to operation 'GenericEnvelope' but body parameter '%s' did not pass validation of '%s' - %s",
+					"envelope",
+					"Envelope",
+					extractValidationErrorMessage(conversionErr, nil),
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/gleece/validation/error/GenericEnvelope",
+			}
+			// json body validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(w, ctx); continueOperation == false {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.GenericEnvelope(*envelopeRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-extended", "GenericEnvelope")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(w, ctx); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(w, ctx, opError); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'GenericEnvelope'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/GenericEnvelope",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
+	}).Methods("POST")
+	engine.HandleFunc(toMuxUrl("/e2e/generic-envelope-map"), func(w http.ResponseWriter, ctx *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "GenericEnvelopeMap")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'GenericEnvelopeMap' cannot produce a response in any of the accepted media types",
+				"GenericEnvelopeMap",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var conversionErr error
+		var envelopeRawPtr *Param101envelope.Envelope[map[Param101envelopeArg0Key.Region]Param101envelopeArg0Value.BodyInfo] = nil
+		requestContentType, isRequestContentTypeSupported := getRequestContentType(ctx.Header.Get("Content-Type"), []string{"application/json"})
+		if !isRequestContentTypeSupported {
+			handleContentNegotiationError(w,
+				http.StatusUnsupportedMediaType,
+				fmt.Sprintf("Operation 'GenericEnvelopeMap' does not accept request bodies of type '%s'", ctx.Header.Get("Content-Type")),
+				"GenericEnvelopeMap",
+			)
+			return
+		}
+		conversionErr = bindAndValidateBody(ctx, requestContentType, "required", "", &envelopeRawPtr)
+		if conversionErr != nil {
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: fmt.Sprintf(
+					"A request was made to operation 'GenericEnvelopeMap' but body parameter '%s' did not pass validation of '%s' - %s",
+					"envelope",
+					"Envelope",
+					extractValidationErrorMessage(conversionErr, nil),
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/gleece/validation/error/GenericEnvelopeMap",
+			}
+			// json body validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(w, ctx); continueOperation == false {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.GenericEnvelopeMap(*envelopeRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-extended", "GenericEnvelopeMap")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(w, ctx); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(w, ctx, opError); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'GenericEnvelopeMap'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/GenericEnvelopeMap",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
+	}).Methods("POST")
+	engine.HandleFunc(toMuxUrl("/e2e/slice-params"), func(w http.ResponseWriter, ctx *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "SliceParams")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'SliceParams' cannot produce a response in any of the accepted media types",
+				"SliceParams",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		idsRawValues, isidsExists := ctx.URL.Query()["ids"]
+		var idsRawPtr *[]int = nil
+		if isidsExists {
+			ids := []int{}
+			for _, paramValue := range splitParamValues(idsRawValues, "") {
+				var idsItemRawPtr *int = nil
+				idsItemRaw := paramValue
+				isidsItemExists := true
+				if isidsItemExists {
+					idsItemUint64, conversionErr := strconv.Atoi(idsItemRaw)
+					if conversionErr != nil {
+						validationError := runtime.Rfc7807Error{
+							Type: http.StatusText(http.StatusUnprocessableEntity),
+							Detail: fmt.Sprintf(
+								"A request was made to operation 'SliceParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+								"idsItem",
+								"int",
+								reflect.TypeOf(idsItemRaw).String(),
+							),
+							Status:     http.StatusUnprocessableEntity,
+							Instance:   "/gleece/validation/error/SliceParams",
+							Extensions: map[string]string{"error": conversionErr.Error()},
+						}
+						// json validation error response extension placeholder
+						w.WriteHeader(http.StatusUnprocessableEntity)
+						json.NewEncoder(w).Encode(validationError)
+						return
+					}
+					idsItem := int(idsItemUint64)
+					idsItemRawPtr = &idsItem
+				}
+				ids = append(ids, *idsItemRawPtr)
+			}
+			idsRawPtr = &ids
+		}
+		if validatorErr := validatorInstance.Var(idsRawPtr, "required"); validatorErr != nil {
+			fieldName := "ids"
+			validationError := wrapValidatorError(validatorErr, "SliceParams", fieldName)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		tagsRawValues, istagsExists := ctx.URL.Query()["tags"]
+		var tagsRawPtr *[]string = nil
+		if istagsExists {
+			tags := []string{}
+			for _, paramValue := range splitParamValues(tagsRawValues, ",") {
+				var tagsItemRawPtr *string = nil
+				tagsItemRaw := paramValue
+				istagsItemExists := true
+				if istagsItemExists {
+					tagsItem := tagsItemRaw
+					tagsItemRawPtr = &tagsItem
+				}
+				tags = append(tags, *tagsItemRawPtr)
+			}
+			tagsRawPtr = &tags
+		}
+		if validatorErr := validatorInstance.Var(tagsRawPtr, "required"); validatorErr != nil {
+			fieldName := "tags"
+			validationError := wrapValidatorError(validatorErr, "SliceParams", fieldName)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		namesRawValues, isnamesExists := ctx.URL.Query()["names"]
+		var namesRawPtr *[]string = nil
+		if isnamesExists {
+			names := []string{}
+			for _, paramValue := range splitParamValues(namesRawValues, "|") {
+				var namesItemRawPtr *string = nil
+				namesItemRaw := paramValue
+				isnamesItemExists := true
+				if isnamesItemExists {
+					namesItem := namesItemRaw
+					namesItemRawPtr = &namesItem
+				}
+				names = append(names, *namesItemRawPtr)
+			}
+			namesRawPtr = &names
+		}
+		if validatorErr := validatorInstance.Var(namesRawPtr, "required"); validatorErr != nil {
+			fieldName := "names"
+			validationError := wrapValidatorError(validatorErr, "SliceParams", fieldName)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		statusesRawValues, isstatusesExists := ctx.URL.Query()["statuses"]
+		var statusesRawPtr *[]Param107statusesItem.OrderStatus = nil
+		if isstatusesExists {
+			statuses := []Param107statusesItem.OrderStatus{}
+			for _, paramValue := range splitParamValues(statusesRawValues, "") {
+				var statusesItemRawPtr *Param107statusesItem.OrderStatus = nil
+				statusesItemRaw := paramValue
+				isstatusesItemExists := true
+				if isstatusesItemExists {
+					statusesItem := statusesItemRaw
+					statusesItemEnum := Param107statusesItem.OrderStatus(statusesItem)
+					if !slices.Contains([]Param107statusesItem.OrderStatus{"pending", "shipped", "delivered"}, statusesItemEnum) {
+						conversionErr := fmt.Errorf("'%s' is not a valid OrderStatus value", statusesItemRaw)
+						validationError := runtime.Rfc7807Error{
+							Type: http.StatusText(http.StatusUnprocessableEntity),
+							Detail: fmt.Sprintf(
+								"A request was made to operation 'SliceParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+								"statusesItem",
+								"OrderStatus",
+								reflect.TypeOf(statusesItemRaw).String(),
+							),
+							Status:     http.StatusUnprocessableEntity,
+							Instance:   "/gleece/validation/error/SliceParams",
+							Extensions: map[string]string{"error": conversionErr.Error()},
+						}
+						// json validation error response extension placeholder
+						w.WriteHeader(http.StatusUnprocessableEntity)
+						json.NewEncoder(w).Encode(validationError)
+						return
+					}
+					statusesItemRawPtr = &statusesItemEnum
+				}
+				statuses = append(statuses, *statusesItemRawPtr)
+			}
+			statusesRawPtr = &statuses
+		}
+		if validatorErr := validatorInstance.Var(statusesRawPtr, "required"); validatorErr != nil {
+			fieldName := "statuses"
+			validationError := wrapValidatorError(validatorErr, "SliceParams", fieldName)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		keysRawValues, iskeysExists := ctx.URL.Query()["keys"]
+		var keysRawPtr *[]Param108keysItem.UUID = nil
+		if iskeysExists {
+			keys := []Param108keysItem.UUID{}
+			for _, paramValue := range splitParamValues(keysRawValues, "") {
+				var keysItemRawPtr *Param108keysItem.UUID = nil
+				keysItemRaw := paramValue
+				iskeysItemExists := true
+				if iskeysItemExists {
+					keysItem, conversionErr := Param108keysItem.Parse(keysItemRaw)
+					if conversionErr != nil {
+						validationError := runtime.Rfc7807Error{
+							Type: http.StatusText(http.StatusUnprocessableEntity),
+							Detail: fmt.Sprintf(
+								"A request was made to operation 'SliceParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+								"keysItem",
+								"UUID",
+								reflect.TypeOf(keysItemRaw).String(),
+							),
+							Status:     http.StatusUnprocessableEntity,
+							Instance:   "/gleece/validation/error/SliceParams",
+							Extensions: map[string]string{"error": conversionErr.Error()},
+						}
+						// json validation error response extension placeholder
+						w.WriteHeader(http.StatusUnprocessableEntity)
+						json.NewEncoder(w).Encode(validationError)
+						return
+					}
+					keysItemRawPtr = &keysItem
+				}
+				keys = append(keys, *keysItemRawPtr)
+			}
+			keysRawPtr = &keys
+		}
+		if validatorErr := validatorInstance.Var(keysRawPtr, "max=2,required"); validatorErr != nil {
+			fieldName := "keys"
+			validationError := wrapValidatorError(validatorErr, "SliceParams", fieldName)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		labelsRawValues := ctx.Header.Values("x-labels")
+		islabelsExists := len(labelsRawValues) > 0
+		var labelsRawPtr *[]string = nil
+		if islabelsExists {
+			labels := []string{}
+			for _, paramValue := range splitParamValues(labelsRawValues, ",") {
+				var labelsItemRawPtr *string = nil
+				labelsItemRaw := paramValue
+				islabelsItemExists := true
+				if islabelsItemExists {
+					labelsItem := labelsItemRaw
+					labelsItemRawPtr = &labelsItem
+				}
+				labels = append(labels, *labelsItemRawPtr)
+			}
+			labelsRawPtr = &labels
+		}
+		if validatorErr := validatorInstance.Var(labelsRawPtr, "required"); validatorErr != nil {
+			fieldName := "labels"
+			validationError := wrapValidatorError(validatorErr, "SliceParams", fieldName)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(w, ctx); continueOperation == false {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.SliceParams(*idsRawPtr, *tagsRawPtr, *namesRawPtr, *statusesRawPtr, *keysRawPtr, *labelsRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-extended", "SliceParams")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(w, ctx); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(w, ctx, opError); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SliceParams'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/SliceParams",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
+	}).Methods("GET")
+	engine.HandleFunc(toMuxUrl("/e2e/query-object"), func(w http.ResponseWriter, ctx *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "QueryObject")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'QueryObject' cannot produce a response in any of the accepted media types",
+				"QueryObject",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var filterRawPtr = &Param112filter.ListFilter{}
+		var filterPageRawPtr *int = nil
+		filterPageRaw := ctx.URL.Query().Get("page")
+		isfilterPageExists := ctx.URL.Query().Has("page")
+		if isfilterPageExists {
+			filterPageUint64, conversionErr := strconv.Atoi(filterPageRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'QueryObject' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"filterPage",
+						"int",
+						reflect.TypeOf(filterPageRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/QueryObject",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(validationError)
+				return
+			}
+			filterPage := int(filterPageUint64)
+			filterPageRawPtr = &filterPage
+		}
+		if filterPageRawPtr != nil {
+			filterRawPtr.Page = *filterPageRawPtr
+		}
+		var filterPageSizeRawPtr *int = nil
+		filterPageSizeRaw := ctx.URL.Query().Get("page_size")
+		isfilterPageSizeExists := ctx.URL.Query().Has("page_size")
+		if isfilterPageSizeExists {
+			filterPageSizeUint64, conversionErr := strconv.Atoi(filterPageSizeRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'QueryObject' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"filterPageSize",
+						"int",
+						reflect.TypeOf(filterPageSizeRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/QueryObject",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(validationError)
+				return
+			}
+			filterPageSize := int(filterPageSizeUint64)
+			filterPageSizeRawPtr = &filterPageSize
+		}
+		if filterPageSizeRawPtr != nil {
+			filterRawPtr.PageSize = filterPageSizeRawPtr
+		}
+		filterTagsRawValues, isfilterTagsExists := ctx.URL.Query()["tag"]
+		var filterTagsRawPtr *[]string = nil
+		if isfilterTagsExists {
+			filterTags := []string{}
+			for _, paramValue := range splitParamValues(filterTagsRawValues, "") {
+				var filterTagsItemRawPtr *string = nil
+				filterTagsItemRaw := paramValue
+				isfilterTagsItemExists := true
+				if isfilterTagsItemExists {
+					filterTagsItem := filterTagsItemRaw
+					filterTagsItemRawPtr = &filterTagsItem
+				}
+				filterTags = append(filterTags, *filterTagsItemRawPtr)
+			}
+			filterTagsRawPtr = &filterTags
+		}
+		if filterTagsRawPtr != nil {
+			filterRawPtr.Tags = *filterTagsRawPtr
+		}
+		var filterStatusRawPtr *Param116filterStatus.OrderStatus = nil
+		filterStatusRaw := ctx.URL.Query().Get("status")
+		isfilterStatusExists := ctx.URL.Query().Has("status")
+		if isfilterStatusExists {
+			filterStatus := filterStatusRaw
+			filterStatusEnum := Param116filterStatus.OrderStatus(filterStatus)
+			if !slices.Contains([]Param116filterStatus.OrderStatus{"pending", "shipped", "delivered"}, filterStatusEnum) {
+				conversionErr := fmt.Errorf("'%s' is not a valid OrderStatus value", filterStatusRaw)
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'QueryObject' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"filterStatus",
+						"OrderStatus",
+						reflect.TypeOf(filterStatusRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/QueryObject",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(validationError)
+				return
+			}
+			filterStatusRawPtr = &filterStatusEnum
+		}
+		if filterStatusRawPtr != nil {
+			filterRawPtr.Status = *filterStatusRawPtr
+		}
+		if validatorErr := validatorInstance.Struct(filterRawPtr); validatorErr != nil {
+			fieldName := "filter"
+			validationError := wrapStructValidatorError(validatorErr, "QueryObject", fieldName)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		var otherRawPtr *string = nil
+		otherRaw := ctx.URL.Query().Get("other")
+		isotherExists := ctx.URL.Query().Has("other")
+		if isotherExists {
+			other := otherRaw
+			otherRawPtr = &other
+		}
+		if validatorErr := validatorInstance.Var(otherRawPtr, "required"); validatorErr != nil {
+			fieldName := "other"
+			validationError := wrapValidatorError(validatorErr, "QueryObject", fieldName)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(w, ctx); continueOperation == false {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.QueryObject(*filterRawPtr, *otherRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-extended", "QueryObject")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(w, ctx); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(w, ctx, opError); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'QueryObject'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/QueryObject",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
+	}).Methods("GET")
+	engine.HandleFunc(toMuxUrl("/e2e/polymorphic-body"), func(w http.ResponseWriter, ctx *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "PolymorphicBody")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'PolymorphicBody' cannot produce a response in any of the accepted media types",
+				"PolymorphicBody",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var conversionErr error
+		var petRawPtr *Param120pet.Pet = nil
+		requestContentType, isRequestContentTypeSupported := getRequestContentType(ctx.Header.Get("Content-Type"), []string{"application/json"})
+		if !isRequestContentTypeSupported {
+			handleContentNegotiationError(w,
+				http.StatusUnsupportedMediaType,
+				fmt.Sprintf("Operation 'PolymorphicBody' does not accept request bodies of type '%s'", ctx.Header.Get("Content-Type")),
+				"PolymorphicBody",
+			)
+			return
+		}
+		conversionErr = bindAndValidatePolymorphicBody(
+			ctx,
+			requestContentType,
+			"required",
+			"kind",
+			map[string]func([]byte) (Param120pet.Pet, error){
+				"cat": unmarshalOneOfMember[Param120pet.CatInfo, Param120pet.Pet](false),
+				"dog": unmarshalOneOfMember[Param120pet.DogInfo, Param120pet.Pet](true),
+			},
+			&petRawPtr,
+		)
+		if conversionErr != nil {
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: fmt.Sprintf(
+					"A request was made to operation 'PolymorphicBody' but body parameter '%s' did not pass validation of '%s' - %s",
+					"pet",
+					"Pet",
+					extractValidationErrorMessage(conversionErr, nil),
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/gleece/validation/error/PolymorphicBody",
+			}
+			// json body validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(w, ctx); continueOperation == false {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.PolymorphicBody(*petRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-extended", "PolymorphicBody")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(w, ctx); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(w, ctx, opError); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PolymorphicBody'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/PolymorphicBody",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
+	}).Methods("POST")
+	engine.HandleFunc(toMuxUrl("/e2e/map-response"), func(w http.ResponseWriter, ctx *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "MapResponse")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'MapResponse' cannot produce a response in any of the accepted media types",
+				"MapResponse",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		namesRawValues, isnamesExists := ctx.URL.Query()["names"]
+		var namesRawPtr *[]string = nil
+		if isnamesExists {
+			names := []string{}
+			for _, paramValue := range splitParamValues(namesRawValues, "") {
+				var namesItemRawPtr *string = nil
+				namesItemRaw := paramValue
+				isnamesItemExists := true
+				if isnamesItemExists {
+					namesItem := namesItemRaw
+					namesItemRawPtr = &namesItem
+				}
+				names = append(names, *namesItemRawPtr)
+			}
+			namesRawPtr = &names
+		}
+		if validatorErr := validatorInstance.Var(namesRawPtr, "required"); validatorErr != nil {
+			fieldName := "names"
+			validationError := wrapValidatorError(validatorErr, "MapResponse", fieldName)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(w, ctx); continueOperation == false {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.MapResponse(*namesRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-extended", "MapResponse")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(w, ctx); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(w, ctx, opError); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'MapResponse'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/MapResponse",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
+	}).Methods("GET")
+	engine.HandleFunc(toMuxUrl("/e2e/named-primitive-params/{customerId}"), func(w http.ResponseWriter, ctx *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "NamedPrimitiveParams")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'NamedPrimitiveParams' cannot produce a response in any of the accepted media types",
+				"NamedPrimitiveParams",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		customerIdvars := mux.Vars(ctx)
+		var customerIdRawPtr *Param126customerId.CustomerID = nil
+		customerIdRaw, iscustomerIdExists := customerIdvars["customerId"]
+		if iscustomerIdExists {
+			customerId := customerIdRaw
+			customerIdNamed := Param126customerId.CustomerID(customerId)
+			customerIdRawPtr = &customerIdNamed
+		}
+		if validatorErr := validatorInstance.Var(customerIdRawPtr, "required"); validatorErr != nil {
+			fieldName := "customerId"
+			validationError := wrapValidatorError(validatorErr, "NamedPrimitiveParams", fieldName)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		var amountRawPtr *Param127amount.Cents = nil
+		amountRaw := ctx.URL.Query().Get("amount")
+		isamountExists := ctx.URL.Query().Has("amount")
+		if isamountExists {
+			amountUint64, conversionErr := strconv.ParseInt(amountRaw, 10, 64)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'NamedPrimitiveParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"amount",
+						"Cents",
+						reflect.TypeOf(amountRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/NamedPrimitiveParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(validationError)
+				return
+			}
+			amount := int64(amountUint64)
+			amountNamed := Param127amount.Cents(amount)
+			amountRawPtr = &amountNamed
+		}
+		if validatorErr := validatorInstance.Var(amountRawPtr, "required"); validatorErr != nil {
+			fieldName := "amount"
+			validationError := wrapValidatorError(validatorErr, "NamedPrimitiveParams", fieldName)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		splitsRawValues, issplitsExists := ctx.URL.Query()["splits"]
+		var splitsRawPtr *[]Param128splitsItem.Cents = nil
+		if issplitsExists {
+			splits := []Param128splitsItem.Cents{}
+			for _, paramValue := range splitParamValues(splitsRawValues, "") {
+				var splitsItemRawPtr *Param128splitsItem.Cents = nil
+				splitsItemRaw := paramValue
+				issplitsItemExists := true
+				if issplitsItemExists {
+					splitsItemUint64, conversionErr := strconv.ParseInt(splitsItemRaw, 10, 64)
+					if conversionErr != nil {
+						validationError := runtime.Rfc7807Error{
+							Type: http.StatusText(http.StatusUnprocessableEntity),
+							Detail: fmt.Sprintf(
+								"A request was made to operation 'NamedPrimitiveParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+								"splitsItem",
+								"Cents",
+								reflect.TypeOf(splitsItemRaw).String(),
+							),
+							Status:     http.StatusUnprocessableEntity,
+							Instance:   "/gleece/validation/error/NamedPrimitiveParams",
+							Extensions: map[string]string{"error": conversionErr.Error()},
+						}
+						// json validation error response extension placeholder
+						w.WriteHeader(http.StatusUnprocessableEntity)
+						json.NewEncoder(w).Encode(validationError)
+						return
+					}
+					splitsItem := int64(splitsItemUint64)
+					splitsItemNamed := Param128splitsItem.Cents(splitsItem)
+					splitsItemRawPtr = &splitsItemNamed
+				}
+				splits = append(splits, *splitsItemRawPtr)
+			}
+			splitsRawPtr = &splits
+		}
+		if validatorErr := validatorInstance.Var(splitsRawPtr, "required"); validatorErr != nil {
+			fieldName := "splits"
+			validationError := wrapValidatorError(validatorErr, "NamedPrimitiveParams", fieldName)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		var currencyRawPtr *Param129currency.Currency = nil
+		currencyRaw := ctx.Header.Get("x-currency")
+		_, iscurrencyExists := ctx.Header["x-currency"]
+		if !iscurrencyExists {
+			// In echo, the ctx..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := ctx.Header.Values("x-currency")
+			iscurrencyExists = len(headerValues) > 0
+		}
+		if iscurrencyExists {
+			currency := currencyRaw
+			currencyNamed := Param129currency.Currency(currency)
+			currencyRawPtr = &currencyNamed
+		}
+		if validatorErr := validatorInstance.Var(currencyRawPtr, "required"); validatorErr != nil {
+			fieldName := "currency"
+			validationError := wrapValidatorError(validatorErr, "NamedPrimitiveParams", fieldName)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(w, ctx); continueOperation == false {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.NamedPrimitiveParams(*customerIdRawPtr, *amountRawPtr, *splitsRawPtr, *currencyRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-extended", "NamedPrimitiveParams")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(w, ctx); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(w, ctx, opError); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'NamedPrimitiveParams'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/NamedPrimitiveParams",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
+	}).Methods("GET")
+	engine.HandleFunc(toMuxUrl("/e2e/body-defaults"), func(w http.ResponseWriter, ctx *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "BodyDefaults")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'BodyDefaults' cannot produce a response in any of the accepted media types",
+				"BodyDefaults",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var conversionErr error
+		var infoRawPtr *Param132info.DefaultsInfo = nil
+		requestContentType, isRequestContentTypeSupported := getRequestContentType(ctx.Header.Get("Content-Type"), []string{"application/json"})
+		if !isRequestContentTypeSupported {
+			handleContentNegotiationError(w,
+				http.StatusUnsupportedMediaType,
+				fmt.Sprintf("Operation 'BodyDefaults' does not accept request bodies of type '%s'", ctx.Header.Get("Content-Type")),
+				"BodyDefaults",
+			)
+			return
+		}
+		conversionErr = bindAndValidateBody(ctx, requestContentType, "required", "{\"limit\":20,\"name\":\"guest\",\"notify\":true,\"paging\":{\"page\":1,\"size\":50},\"status\":\"shipped\"}", &infoRawPtr)
+		if conversionErr != nil {
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: fmt.Sprintf(
+					"A request was made to operation 'BodyDefaults' but body parameter '%s' did not pass validation of '%s' - %s",
+					"info",
+					"DefaultsInfo",
+					extractValidationErrorMessage(conversionErr, nil),
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/gleece/validation/error/BodyDefaults",
+			}
+			// json body validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(w, ctx); continueOperation == false {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.BodyDefaults(*infoRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-extended", "BodyDefaults")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(w, ctx); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(w, ctx, opError); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'BodyDefaults'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/BodyDefaults",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
+	}).Methods("POST")
+	engine.HandleFunc(toMuxUrl("/e2e/param-defaults"), func(w http.ResponseWriter, ctx *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "ParamDefaults")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'ParamDefaults' cannot produce a response in any of the accepted media types",
+				"ParamDefaults",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var limitRawPtr *int = nil
+		limitRaw := ctx.URL.Query().Get("limit")
+		islimitExists := ctx.URL.Query().Has("limit")
+		if !islimitExists {
+			limitRaw = "20"
+			islimitExists = true
+		}
+		if islimitExists {
+			limitUint64, conversionErr := strconv.Atoi(limitRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'ParamDefaults' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"limit",
+						"int",
+						reflect.TypeOf(limitRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/ParamDefaults",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(validationError)
+				return
+			}
+			limit := int(limitUint64)
+			limitRawPtr = &limit
+		}
+		if validatorErr := validatorInstance.Var(limitRawPtr, "required"); validatorErr != nil {
+			fieldName := "limit"
+			validationError := wrapValidatorError(validatorErr, "ParamDefaults", fieldName)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		var notifyRawPtr *bool = nil
+		notifyRaw := ctx.URL.Query().Get("notify")
+		isnotifyExists := ctx.URL.Query().Has("notify")
+		if !isnotifyExists {
+			notifyRaw = "true"
+			isnotifyExists = true
+		}
+		if isnotifyExists {
+			notify, conversionErr := strconv.ParseBool(notifyRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'ParamDefaults' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"notify",
+						"bool",
+						reflect.TypeOf(notifyRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/ParamDefaults",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(validationError)
+				return
+			}
+			notifyRawPtr = &notify
+		}
+		var statusRawPtr *Param137status.OrderStatus = nil
+		statusRaw := ctx.URL.Query().Get("status")
+		isstatusExists := ctx.URL.Query().Has("status")
+		if !isstatusExists {
+			statusRaw = "shipped"
+			isstatusExists = true
+		}
+		if isstatusExists {
+			status := statusRaw
+			statusEnum := Param137status.OrderStatus(status)
+			if !slices.Contains([]Param137status.OrderStatus{"pending", "shipped", "delivered"}, statusEnum) {
+				conversionErr := fmt.Errorf("'%s' is not a valid OrderStatus value", statusRaw)
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'ParamDefaults' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"status",
+						"OrderStatus",
+						reflect.TypeOf(statusRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/ParamDefaults",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(validationError)
+				return
+			}
+			statusRawPtr = &statusEnum
+		}
+		if validatorErr := validatorInstance.Var(statusRawPtr, "required"); validatorErr != nil {
+			fieldName := "status"
+			validationError := wrapValidatorError(validatorErr, "ParamDefaults", fieldName)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		var regionRawPtr *string = nil
+		regionRaw := ctx.Header.Get("x-region")
+		_, isregionExists := ctx.Header["x-region"]
+		if !isregionExists {
+			// In echo, the ctx..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := ctx.Header.Values("x-region")
+			isregionExists = len(headerValues) > 0
+		}
+		if !isregionExists {
+			regionRaw = "eu"
+			isregionExists = true
+		}
+		if isregionExists {
+			region := regionRaw
+			regionRawPtr = &region
+		}
+		if validatorErr := validatorInstance.Var(regionRawPtr, "required"); validatorErr != nil {
+			fieldName := "region"
+			validationError := wrapValidatorError(validatorErr, "ParamDefaults", fieldName)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		ctx.ParseForm()
+		var noteRawPtr *string = nil
+		noteRawArr, isnoteExists := ctx.PostForm["note"]
+		noteRaw := ""
+		if isnoteExists {
+			noteRaw = noteRawArr[0] // Get first value since form values are slices
+		}
+		if !isnoteExists {
+			noteRaw = "none"
+			isnoteExists = true
+		}
+		if isnoteExists {
+			note := noteRaw
+			noteRawPtr = &note
+		}
+		if validatorErr := validatorInstance.Var(noteRawPtr, "required"); validatorErr != nil {
+			fieldName := "note"
+			validationError := wrapValidatorError(validatorErr, "ParamDefaults", fieldName)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(w, ctx); continueOperation == false {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.ParamDefaults(*limitRawPtr, notifyRawPtr, *statusRawPtr, *regionRawPtr, *noteRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-extended", "ParamDefaults")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(w, ctx); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(w, ctx, opError); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'ParamDefaults'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/ParamDefaults",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
+	}).Methods("POST")
+	engine.HandleFunc(toMuxUrl("/e2e/cookie-params"), func(w http.ResponseWriter, ctx *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "CookieParams")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'CookieParams' cannot produce a response in any of the accepted media types",
+				"CookieParams",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var sessionRawPtr *string = nil
+		sessionRawCookie, sessionCookieErr := ctx.Cookie("session_id")
+		issessionExists := sessionCookieErr == nil
+		sessionRaw := ""
+		if issessionExists {
+			sessionRaw = sessionRawCookie.Value
+		}
+		if issessionExists {
+			session := sessionRaw
+			sessionRawPtr = &session
+		}
+		if validatorErr := validatorInstance.Var(sessionRawPtr, "required"); validatorErr != nil {
+			fieldName := "session"
+			validationError := wrapValidatorError(validatorErr, "CookieParams", fieldName)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		var themeRawPtr *string = nil
+		themeRawCookie, themeCookieErr := ctx.Cookie("theme")
+		isthemeExists := themeCookieErr == nil
+		themeRaw := ""
+		if isthemeExists {
+			themeRaw = themeRawCookie.Value
+		}
+		if isthemeExists {
+			theme := themeRaw
+			themeRawPtr = &theme
+		}
+		var visitsRawPtr *int = nil
+		visitsRawCookie, visitsCookieErr := ctx.Cookie("visits")
+		isvisitsExists := visitsCookieErr == nil
+		visitsRaw := ""
+		if isvisitsExists {
+			visitsRaw = visitsRawCookie.Value
+		}
+		if isvisitsExists {
+			visitsUint64, conversionErr := strconv.Atoi(visitsRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'CookieParams' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"visits",
+						"int",
+						reflect.TypeOf(visitsRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/CookieParams",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(validationError)
+				return
+			}
+			visits := int(visitsUint64)
+			visitsRawPtr = &visits
+		}
+		if validatorErr := validatorInstance.Var(visitsRawPtr, "gte=0,required"); validatorErr != nil {
+			fieldName := "visits"
+			validationError := wrapValidatorError(validatorErr, "CookieParams", fieldName)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(w, ctx); continueOperation == false {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.CookieParams(*sessionRawPtr, themeRawPtr, *visitsRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-extended", "CookieParams")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(w, ctx); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(w, ctx, opError); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'CookieParams'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/CookieParams",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
+	}).Methods("GET")
+	engine.HandleFunc(toMuxUrl("/e2e/content-negotiation"), func(w http.ResponseWriter, ctx *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "ContentNegotiation")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'ContentNegotiation' cannot produce a response in any of the accepted media types",
+				"ContentNegotiation",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var conversionErr error
+		var infoRawPtr *Param147info.DefaultsInfo = nil
+		requestContentType, isRequestContentTypeSupported := getRequestContentType(ctx.Header.Get("Content-Type"), []string{"application/json"})
+		if !isRequestContentTypeSupported {
+			handleContentNegotiationError(w,
+				http.StatusUnsupportedMediaType,
+				fmt.Sprintf("Operation 'ContentNegotiation' does not accept request bodies of type '%s'", ctx.Header.Get("Content-Type")),
+				"ContentNegotiation",
+			)
+			return
+		}
+		conversionErr = bindAndValidateBody(ctx, requestContentType, "required", "{\"limit\":20,\"name\":\"guest\",\"notify\":true,\"paging\":{\"page\":1,\"size\":50},\"status\":\"shipped\"}", &infoRawPtr)
+		if conversionErr != nil {
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: fmt.Sprintf(
+					"A request was made to operation 'ContentNegotiation' but body parameter '%s' did not pass validation of '%s' - %s",
+					"info",
+					"DefaultsInfo",
+					extractValidationErrorMessage(conversionErr, nil),
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/gleece/validation/error/ContentNegotiation",
+			}
+			// json body validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(w, ctx); continueOperation == false {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.ContentNegotiation(*infoRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-extended", "ContentNegotiation")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(w, ctx); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(w, ctx, opError); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'ContentNegotiation'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/ContentNegotiation",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
+	}).Methods("POST")
+	engine.HandleFunc(toMuxUrl("/e2e/xml-serialization"), func(w http.ResponseWriter, ctx *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "XmlSerialization")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json", "application/xml"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'XmlSerialization' cannot produce a response in any of the accepted media types",
+				"XmlSerialization",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var conversionErr error
+		var bookRawPtr *Param150book.XmlBook = nil
+		requestContentType, isRequestContentTypeSupported := getRequestContentType(ctx.Header.Get("Content-Type"), []string{"application/xml", "application/json"})
+		if !isRequestContentTypeSupported {
+			handleContentNegotiationError(w,
+				http.StatusUnsupportedMediaType,
+				fmt.Sprintf("Operation 'XmlSerialization' does not accept request bodies of type '%s'", ctx.Header.Get("Content-Type")),
+				"XmlSerialization",
+			)
+			return
+		}
+		conversionErr = bindAndValidateBody(ctx, requestContentType, "required", "", &bookRawPtr)
+		if conversionErr != nil {
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: fmt.Sprintf(
+					"A request was made to operation 'XmlSerialization' but body parameter '%s' did not pass validation of '%s' - %s",
+					"book",
+					"XmlBook",
+					extractValidationErrorMessage(conversionErr, nil),
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/gleece/validation/error/XmlSerialization",
+			}
+			// json body validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(w, ctx); continueOperation == false {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.XmlSerialization(*bookRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-extended", "XmlSerialization")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(w, ctx); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(w, ctx, opError); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'XmlSerialization'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/XmlSerialization",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
+		if responseContentType == "application/xml" {
+			// xml response extension placeholder
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(statusCode)
+			xml.NewEncoder(w).Encode(value)
+		}
+	}).Methods("POST")
+	engine.HandleFunc(toMuxUrl("/e2e/plain-text"), func(w http.ResponseWriter, ctx *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "PlainText")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json", "text/plain"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'PlainText' cannot produce a response in any of the accepted media types",
+				"PlainText",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var nameRawPtr *string = nil
+		nameRaw := ctx.URL.Query().Get("name")
+		isnameExists := ctx.URL.Query().Has("name")
+		if isnameExists {
+			name := nameRaw
+			nameRawPtr = &name
+		}
+		if validatorErr := validatorInstance.Var(nameRawPtr, "required"); validatorErr != nil {
+			fieldName := "name"
+			validationError := wrapValidatorError(validatorErr, "PlainText", fieldName)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(w, ctx); continueOperation == false {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.PlainText(*nameRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-extended", "PlainText")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(w, ctx); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(w, ctx, opError); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PlainText'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/PlainText",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
+		if responseContentType == "text/plain" {
+			// text response extension placeholder
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			w.WriteHeader(statusCode)
+			io.WriteString(w, fmt.Sprint(value))
+		}
+	}).Methods("GET")
+	engine.HandleFunc(toMuxUrl("/e2e/form-files"), func(w http.ResponseWriter, ctx *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "FormFiles")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'FormFiles' cannot produce a response in any of the accepted media types",
+				"FormFiles",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		ctx.ParseMultipartForm(multipartFormMaxMemory)
+		var titleRawPtr *string = nil
+		titleRawArr, istitleExists := ctx.PostForm["title"]
+		titleRaw := ""
+		if istitleExists {
+			titleRaw = titleRawArr[0] // Get first value since form values are slices
+		}
+		if istitleExists {
+			title := titleRaw
+			titleRawPtr = &title
+		}
+		if validatorErr := validatorInstance.Var(titleRawPtr, "required"); validatorErr != nil {
+			fieldName := "title"
+			validationError := wrapValidatorError(validatorErr, "FormFiles", fieldName)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		var avatarRawPtr *Param157avatar.FileHeader = nil
+		avatarFiles := getFormFiles(ctx, "avatar")
+		if fileErr := validateFormFiles(avatarFiles, false, 16, []string{"image/*"}); fileErr != nil {
+			validationError := wrapValidatorError(fileErr, "FormFiles", "avatar")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		if len(avatarFiles) > 0 {
+			avatarRawPtr = avatarFiles[0]
+		}
+		if validatorErr := validatorInstance.Var(avatarRawPtr, "required"); validatorErr != nil {
+			fieldName := "avatar"
+			validationError := wrapValidatorError(validatorErr, "FormFiles", fieldName)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		var attachmentsRawPtr *[]*Param158attachmentsItem.FileHeader = nil
+		attachmentsFiles := getFormFiles(ctx, "attachment")
+		if fileErr := validateFormFiles(attachmentsFiles, true, 0, []string{"text/plain", "application/pdf"}); fileErr != nil {
+			validationError := wrapValidatorError(fileErr, "FormFiles", "attachments")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		attachmentsRawPtr = &attachmentsFiles
+		if validatorErr := validatorInstance.Var(attachmentsRawPtr, "required"); validatorErr != nil {
+			fieldName := "attachments"
+			validationError := wrapValidatorError(validatorErr, "FormFiles", fieldName)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(w, ctx); continueOperation == false {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.FormFiles(*titleRawPtr, avatarRawPtr, *attachmentsRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-extended", "FormFiles")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(w, ctx); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(w, ctx, opError); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'FormFiles'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/FormFiles",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
+	}).Methods("POST")
+	engine.HandleFunc(toMuxUrl("/e2e/stream-report"), func(w http.ResponseWriter, ctx *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "StreamReport")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"text/csv"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'StreamReport' cannot produce a response in any of the accepted media types",
+				"StreamReport",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var nameRawPtr *string = nil
+		nameRaw := ctx.URL.Query().Get("name")
+		isnameExists := ctx.URL.Query().Has("name")
+		if isnameExists {
+			name := nameRaw
+			nameRawPtr = &name
+		}
+		if validatorErr := validatorInstance.Var(nameRawPtr, "required"); validatorErr != nil {
+			fieldName := "name"
+			validationError := wrapValidatorError(validatorErr, "StreamReport", fieldName)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(w, ctx); continueOperation == false {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.StreamReport(*nameRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-extended", "StreamReport")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(w, ctx); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(w, ctx, opError); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'StreamReport'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/StreamReport",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// stream response extension placeholder
+		// File responses are sent as their own media type, if given, rather than the negotiated one
+		if value.ContentType != "" {
+			responseContentType = value.ContentType
+		}
+		streamErr := writeStream(w, statusCode, responseContentType, value.FileName, value.Content)
+		if streamErr != nil {
+			// The response is already under way - aborting it lets the client tell it apart from a complete one
+			panic(http.ErrAbortHandler)
+		}
+	}).Methods("GET")
+	engine.HandleFunc(toMuxUrl("/e2e/download-notes"), func(w http.ResponseWriter, ctx *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "DownloadNotes")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/octet-stream"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'DownloadNotes' cannot produce a response in any of the accepted media types",
+				"DownloadNotes",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(w, ctx); continueOperation == false {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.DownloadNotes()
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-extended", "DownloadNotes")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(w, ctx); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(w, ctx, opError); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'DownloadNotes'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/DownloadNotes",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// stream response extension placeholder
+		// File responses are sent as their own media type, if given, rather than the negotiated one
+		if value.ContentType != "" {
+			responseContentType = value.ContentType
+		}
+		streamErr := writeStream(w, statusCode, responseContentType, value.FileName, value.Content)
+		if streamErr != nil {
+			// The response is already under way - aborting it lets the client tell it apart from a complete one
+			panic(http.ErrAbortHandler)
+		}
+	}).Methods("GET")
+	engine.HandleFunc(toMuxUrl("/e2e/stream-bytes"), func(w http.ResponseWriter, ctx *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "StreamBytes")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/octet-stream"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'StreamBytes' cannot produce a response in any of the accepted media types",
+				"StreamBytes",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(w, ctx); continueOperation == false {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.StreamBytes()
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-extended", "StreamBytes")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(w, ctx); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(w, ctx, opError); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'StreamBytes'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/StreamBytes",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// stream response extension placeholder
+		streamErr := writeStream(w, statusCode, responseContentType, "", value)
+		if streamErr != nil {
+			// The response is already under way - aborting it lets the client tell it apart from a complete one
+			panic(http.ErrAbortHandler)
+		}
+	}).Methods("GET")
+	engine.HandleFunc(toMuxUrl("/e2e/event-stream"), func(w http.ResponseWriter, ctx *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "EventStream")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"text/event-stream"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'EventStream' cannot produce a response in any of the accepted media types",
+				"EventStream",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		var stepsRawPtr *int = nil
+		stepsRaw := ctx.URL.Query().Get("steps")
+		isstepsExists := ctx.URL.Query().Has("steps")
+		if isstepsExists {
+			stepsUint64, conversionErr := strconv.Atoi(stepsRaw)
+			if conversionErr != nil {
+				validationError := runtime.Rfc7807Error{
+					Type: http.StatusText(http.StatusUnprocessableEntity),
+					Detail: fmt.Sprintf(
+						"A request was made to operation 'EventStream' but parameter '%s' was not properly sent - Expected %s but got %s",
+						"steps",
+						"int",
+						reflect.TypeOf(stepsRaw).String(),
+					),
+					Status:     http.StatusUnprocessableEntity,
+					Instance:   "/gleece/validation/error/EventStream",
+					Extensions: map[string]string{"error": conversionErr.Error()},
+				}
+				// json validation error response extension placeholder
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(validationError)
+				return
+			}
+			steps := int(stepsUint64)
+			stepsRawPtr = &steps
+		}
+		if validatorErr := validatorInstance.Var(stepsRawPtr, "required"); validatorErr != nil {
+			fieldName := "steps"
+			validationError := wrapValidatorError(validatorErr, "EventStream", fieldName)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(w, ctx); continueOperation == false {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.EventStream(*stepsRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-extended", "EventStream")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(w, ctx); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(w, ctx, opError); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'EventStream'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/EventStream",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// event stream response extension placeholder
+		writeEventStream(w, ctx.Context(), statusCode, value)
+	}).Methods("GET")
+	engine.HandleFunc(toMuxUrl("/e2e/event-stream-error"), func(w http.ResponseWriter, ctx *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "EventStreamError")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"text/event-stream"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'EventStreamError' cannot produce a response in any of the accepted media types",
+				"EventStreamError",
+			)
+			return
+		}
+		controller := E2EControllerImport.E2EController{}
+		controller.InitController(ctx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(w, ctx); continueOperation == false {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.EventStreamError()
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-extended", "EventStreamError")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(w, ctx); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(w, ctx, opError); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'EventStreamError'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/EventStreamError",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// event stream response extension placeholder
+		writeEventStream(w, ctx.Context(), statusCode, value)
+	}).Methods("GET")
+	// E2EClassSecController
+	engine.HandleFunc(toMuxUrl("/e2e/with-default-class-security"), func(w http.ResponseWriter, ctx *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"class",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "WithDefaultClassSecurity")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'WithDefaultClassSecurity' cannot produce a response in any of the accepted media types",
+				"WithDefaultClassSecurity",
+			)
+			return
+		}
+		controller := E2EClassSecControllerImport.E2EClassSecController{}
+		controller.InitController(ctx)
+		var headerParamRawPtr *string = nil
+		headerParamRaw := ctx.Header.Get("x-test-scopes")
+		_, isheaderParamExists := ctx.Header["x-test-scopes"]
+		if !isheaderParamExists {
+			// In echo, the ctx..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := ctx.Header.Values("x-test-scopes")
+			isheaderParamExists = len(headerValues) > 0
+		}
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			fieldName := "headerParam"
+			validationError := wrapValidatorError(validatorErr, "WithDefaultClassSecurity", fieldName)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(w, ctx); continueOperation == false {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.WithDefaultClassSecurity(*headerParamRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-extended", "WithDefaultClassSecurity")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(w, ctx); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(w, ctx, opError); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithDefaultClassSecurity'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/WithDefaultClassSecurity",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
+	}).Methods("GET")
+	engine.HandleFunc(toMuxUrl("/e2e/with-default-override-class-security"), func(w http.ResponseWriter, ctx *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ctx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"method",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "WithOverrideClassSecurity")
+			return
+		}
+		responseContentType := negotiateContentType(ctx.Header.Get("Accept"), []string{"application/json"})
+		if responseContentType == "" {
+			handleContentNegotiationError(w,
+				http.StatusNotAcceptable,
+				"Operation 'WithOverrideClassSecurity' cannot produce a response in any of the accepted media types",
+				"WithOverrideClassSecurity",
+			)
+			return
+		}
+		controller := E2EClassSecControllerImport.E2EClassSecController{}
+		controller.InitController(ctx)
+		var headerParamRawPtr *string = nil
+		headerParamRaw := ctx.Header.Get("x-test-scopes")
+		_, isheaderParamExists := ctx.Header["x-test-scopes"]
+		if !isheaderParamExists {
+			// In echo, the ctx..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := ctx.Header.Values("x-test-scopes")
+			isheaderParamExists = len(headerValues) > 0
+		}
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			fieldName := "headerParam"
+			validationError := wrapValidatorError(validatorErr, "WithOverrideClassSecurity", fieldName)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(validationError)
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			if continueOperation := middleware(w, ctx); continueOperation == false {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.WithOverrideClassSecurity(*headerParamRawPtr)
+		// after operation routes extension placeholder
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-extended", "WithOverrideClassSecurity")
+		statusCode := getStatusCode(&controller, true, opError)
+		if opError == nil {
+			// Middlewares afterOperationSuccessMiddlewares section
+			for _, middleware := range afterOperationSuccessMiddlewares {
+				if continueOperation := middleware(w, ctx); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares afterOperationSuccessMiddlewares section
+		}
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				if continueOperation := middleware(w, ctx, opError); continueOperation == false {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithOverrideClassSecurity'",
+				Status:     statusCode,
+				Instance:   "/gleece/controller/error/WithOverrideClassSecurity",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		if responseContentType == "application/json" {
+			// json response extension placeholder
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(value)
+		}
 	}).Methods("GET")
 }
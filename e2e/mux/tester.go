@@ -33,8 +33,13 @@ func MuxRouterTest(routerTest common.RouterTest) common.RouterTestResult {
 
 	var req *http.Request
 
-	// Handle form data
-	if routerTest.Form != nil {
+	// Handle multipart form data
+	if routerTest.Files != nil {
+		body, contentType := common.BuildMultipartForm(routerTest.Form, routerTest.Files)
+		req = httptest.NewRequest(routerTest.Method, path, body)
+		req.Header.Set("Content-Type", contentType)
+	} else if routerTest.Form != nil {
+		// Handle form data
 		// Convert form data to url.Values
 		for k, v := range routerTest.Form {
 			formParams.Add(k, v)
@@ -43,6 +48,9 @@ func MuxRouterTest(routerTest common.RouterTest) common.RouterTestResult {
 		req = httptest.NewRequest(routerTest.Method, path, strings.NewReader(formParams.Encode()))
 		// Set content type for form data
 		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	} else if routerTest.RawBody != "" {
+		// Handle pre-serialized body, e.g. XML
+		req = httptest.NewRequest(routerTest.Method, path, strings.NewReader(routerTest.RawBody))
 	} else if routerTest.Body != nil {
 		// Handle JSON body
 		jsonData, _ := json.Marshal(routerTest.Body)
@@ -2,6 +2,8 @@ package annotations
 
 import (
 	"fmt"
+	"mime"
+	"regexp"
 	"slices"
 	"strconv"
 	"strings"
@@ -164,6 +166,76 @@ func (v *Validator) validateSpecificAnnotation(attr Attribute) error {
 		return validateStatusCode(attr.Value)
 	case "Security":
 		return validateSecurity(attr)
+	case "Example", "Default", "Format":
+		return validateText(attr)
+	case "Pattern":
+		return validatePattern(attr)
+	case "Consumes", "Produces":
+		return validateMediaType(attr)
+	case "FormFile":
+		return validateFormFile(attr)
+	}
+	return nil
+}
+
+// validateMediaType checks that the value of a @Consumes or @Produces annotation is a media type, e.g. 'application/json'
+func validateMediaType(attr Attribute) error {
+	mediaType, params, err := mime.ParseMediaType(attr.Value)
+	if err != nil || len(params) > 0 || !strings.Contains(mediaType, "/") || strings.Contains(mediaType, "*") {
+		return fmt.Errorf("annotation @%s requires a media type such as 'application/json' but got '%s'", attr.Name, attr.Value)
+	}
+	return nil
+}
+
+// validateFormFile checks that the maximum size given by a @FormFile annotation is a positive whole number of bytes
+// and that its allowed MIME types are media types, optionally with a wildcard subtype, e.g. 'image/*'
+func validateFormFile(attr Attribute) error {
+	if maxSize, hasMaxSize := attr.Properties[PropertyMaxSize]; hasMaxSize {
+		size, isNumber := maxSize.(float64)
+		if !isNumber || size <= 0 || size != float64(int64(size)) {
+			return fmt.Errorf("property %s of annotation @%s must be a positive whole number of bytes", PropertyMaxSize, attr.Name)
+		}
+	}
+
+	mimeTypes, _ := attr.Properties[PropertyMimeTypes].([]any)
+	for _, value := range mimeTypes {
+		mimeType, isString := value.(string)
+		mediaType, params, err := mime.ParseMediaType(mimeType)
+		isValid := isString && err == nil && len(params) == 0 && strings.Count(mediaType, "/") == 1
+		if isValid {
+			mainType, subType, _ := strings.Cut(mediaType, "/")
+			isValid = mainType != "*" && (subType == "*" || !strings.Contains(subType, "*"))
+		}
+
+		if !isValid {
+			return fmt.Errorf(
+				"property %s of annotation @%s requires media types such as 'image/png' or 'image/*' but got '%v'",
+				PropertyMimeTypes,
+				attr.Name,
+				value,
+			)
+		}
+	}
+
+	return nil
+}
+
+// validateText checks that an annotation whose value is given as text, e.g. '@Default 10', has one
+func validateText(attr Attribute) error {
+	if strings.TrimSpace(attr.Description) == "" {
+		return fmt.Errorf("annotation @%s requires a value following it, e.g. '// @%s <value>'", attr.Name, attr.Name)
+	}
+	return nil
+}
+
+// validatePattern checks that the regular expression given by a @Pattern annotation is valid
+func validatePattern(attr Attribute) error {
+	if err := validateText(attr); err != nil {
+		return err
+	}
+
+	if _, err := regexp.Compile(attr.Description); err != nil {
+		return fmt.Errorf("invalid pattern '%s' - %v", attr.Description, err)
 	}
 	return nil
 }
@@ -279,6 +351,20 @@ func initializeAnnotations() map[string]annotationDefinition {
 			allowsMultiple:      true,
 			requiresUniqueValue: false,
 		},
+		AttributeConsumes: {
+			contexts:            []CommentSource{"controller", "route"},
+			requiresValue:       true,
+			allowedProperties:   map[string]PropertyDefinition{},
+			allowsMultiple:      true,
+			requiresUniqueValue: false,
+		},
+		AttributeProduces: {
+			contexts:            []CommentSource{"controller", "route"},
+			requiresValue:       true,
+			allowedProperties:   map[string]PropertyDefinition{},
+			allowsMultiple:      true,
+			requiresUniqueValue: false,
+		},
 		AttributeDescription: {
 			contexts:            []CommentSource{"controller", "route", "schema", "property"},
 			requiresValue:       false,
@@ -294,6 +380,81 @@ func initializeAnnotations() map[string]annotationDefinition {
 			requiresUniqueValue: false,
 		},
 
+		// Schema (Struct-Level) Annotations
+		AttributeName: {
+			contexts:            []CommentSource{"schema"},
+			requiresValue:       true,
+			allowedProperties:   map[string]PropertyDefinition{},
+			allowsMultiple:      false,
+			requiresUniqueValue: false,
+		},
+		AttributeOneOf: {
+			contexts:      []CommentSource{"schema"},
+			requiresValue: true,
+			allowedProperties: map[string]PropertyDefinition{
+				"value": {
+					Required:     false,
+					Type:         "string",
+					DefaultValue: "",
+				},
+			},
+			allowsMultiple:      true,
+			requiresUniqueValue: false,
+		},
+		AttributeDiscriminator: {
+			contexts:            []CommentSource{"schema"},
+			requiresValue:       true,
+			allowedProperties:   map[string]PropertyDefinition{},
+			allowsMultiple:      false,
+			requiresUniqueValue: false,
+		},
+
+		// Property (Field-Level) Annotations
+		AttributeExample: {
+			contexts:            []CommentSource{"property"},
+			requiresValue:       false,
+			allowedProperties:   map[string]PropertyDefinition{},
+			allowsMultiple:      false,
+			requiresUniqueValue: false,
+		},
+		AttributeDefault: {
+			contexts:            []CommentSource{"property"},
+			requiresValue:       false,
+			allowedProperties:   map[string]PropertyDefinition{},
+			allowsMultiple:      false,
+			requiresUniqueValue: false,
+		},
+		AttributeFormat: {
+			contexts:            []CommentSource{"property"},
+			requiresValue:       false,
+			allowedProperties:   map[string]PropertyDefinition{},
+			allowsMultiple:      false,
+			requiresUniqueValue: false,
+		},
+		AttributePattern: {
+			contexts:            []CommentSource{"property"},
+			requiresValue:       false,
+			allowedProperties:   map[string]PropertyDefinition{},
+			allowsMultiple:      false,
+			requiresUniqueValue: false,
+		},
+		AttributeReadOnly: {
+			contexts:            []CommentSource{"property"},
+			requiresValue:       false,
+			allowedProperties:   map[string]PropertyDefinition{},
+			allowsMultiple:      false,
+			mutuallyExclusive:   []string{AttributeWriteOnly},
+			requiresUniqueValue: false,
+		},
+		AttributeWriteOnly: {
+			contexts:            []CommentSource{"property"},
+			requiresValue:       false,
+			allowedProperties:   map[string]PropertyDefinition{},
+			allowsMultiple:      false,
+			mutuallyExclusive:   []string{AttributeReadOnly},
+			requiresUniqueValue: false,
+		},
+
 		// Route (Function-Level) Annotations
 		AttributeMethod: {
 			contexts:            []CommentSource{"route"},
@@ -316,11 +477,50 @@ func initializeAnnotations() map[string]annotationDefinition {
 					Type:         "string",
 					DefaultValue: "",
 				},
+				"default": {
+					Required:     false,
+					Type:         "", // Strings, numbers or booleans, validated against the type of the parameter
+					DefaultValue: nil,
+				},
+				"style": {
+					Required:      false,
+					Type:          "string",
+					DefaultValue:  "form",
+					AllowedValues: []any{"form", "pipeDelimited"},
+				},
+				"explode": {
+					Required:     false,
+					Type:         "boolean",
+					DefaultValue: true,
+				},
 			},
 			allowsMultiple:      true,
 			requiresUniqueValue: true, // Values must be unique across all HTTP params annotations
 		},
 		AttributeHeader: {
+			contexts:      []CommentSource{"route"},
+			requiresValue: true,
+			allowedProperties: map[string]PropertyDefinition{
+				"name": {
+					Required:     false,
+					Type:         "string",
+					DefaultValue: "",
+				},
+				"validate": {
+					Required:     false,
+					Type:         "string",
+					DefaultValue: "",
+				},
+				"default": {
+					Required:     false,
+					Type:         "", // Strings, numbers or booleans, validated against the type of the parameter
+					DefaultValue: nil,
+				},
+			},
+			allowsMultiple:      true,
+			requiresUniqueValue: true, // Values must be unique across all HTTP params annotations
+		},
+		AttributeCookie: {
 			contexts:      []CommentSource{"route"},
 			requiresValue: true,
 			allowedProperties: map[string]PropertyDefinition{
@@ -367,7 +567,7 @@ func initializeAnnotations() map[string]annotationDefinition {
 				},
 			},
 			allowsMultiple:      false,
-			mutuallyExclusive:   []string{AttributeFormField},
+			mutuallyExclusive:   []string{AttributeFormField, AttributeFormFile},
 			requiresUniqueValue: true, // Values must be unique across all HTTP params annotations
 		},
 		AttributeFormField: {
@@ -384,6 +584,40 @@ func initializeAnnotations() map[string]annotationDefinition {
 					Type:         "string",
 					DefaultValue: "",
 				},
+				"default": {
+					Required:     false,
+					Type:         "", // Strings, numbers or booleans, validated against the type of the parameter
+					DefaultValue: nil,
+				},
+			},
+			allowsMultiple:      true,
+			mutuallyExclusive:   []string{AttributeBody},
+			requiresUniqueValue: true, // Values must be unique across all HTTP params annotations
+		},
+		AttributeFormFile: {
+			contexts:      []CommentSource{"route"},
+			requiresValue: true,
+			allowedProperties: map[string]PropertyDefinition{
+				"name": {
+					Required:     false,
+					Type:         "string",
+					DefaultValue: "",
+				},
+				"validate": {
+					Required:     false,
+					Type:         "string",
+					DefaultValue: "",
+				},
+				"maxSize": {
+					Required:     false,
+					Type:         "number", // In bytes, per uploaded file
+					DefaultValue: 0,
+				},
+				"mimeTypes": {
+					Required:     false,
+					Type:         "array",
+					DefaultValue: []any{},
+				},
 			},
 			allowsMultiple:      true,
 			mutuallyExclusive:   []string{AttributeBody},
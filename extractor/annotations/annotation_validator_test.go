@@ -289,6 +289,148 @@ var _ = Describe("Annotation Validator", func() {
 		})
 	})
 
+	Context("When validating property value annotations", func() {
+		It("Should accept values given as text", func() {
+			for _, name := range []string{"Example", "Default", "Format", "Pattern"} {
+				attr := annotations.Attribute{
+					Name:        name,
+					Description: "abc",
+				}
+
+				err := annotations.IsValidAnnotation(attr, "property")
+				Expect(err).To(BeNil())
+			}
+		})
+
+		It("Should reject missing values", func() {
+			attr := annotations.Attribute{
+				Name: "Default",
+			}
+
+			err := annotations.IsValidAnnotation(attr, "property")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("annotation @Default requires a value"))
+		})
+
+		It("Should reject invalid patterns", func() {
+			attr := annotations.Attribute{
+				Name:        "Pattern",
+				Description: "^[a-z+$",
+			}
+
+			err := annotations.IsValidAnnotation(attr, "property")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid pattern '^[a-z+$'"))
+		})
+
+		It("Should reject property annotations in route context", func() {
+			attr := annotations.Attribute{
+				Name: "ReadOnly",
+			}
+
+			err := annotations.IsValidAnnotation(attr, "route")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("annotation @ReadOnly is not valid in route context"))
+		})
+
+		It("Should reject ReadOnly and WriteOnly annotations together", func() {
+			attrs := []annotations.Attribute{
+				{Name: "ReadOnly"},
+				{Name: "WriteOnly"},
+			}
+
+			err := annotations.IsValidAnnotationCollection(attrs, "property")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("annotations @WriteOnly and @ReadOnly cannot be used together"))
+		})
+	})
+
+	Context("When validating media type annotations", func() {
+		It("Should accept media types in controller and route contexts", func() {
+			for _, source := range []annotations.CommentSource{"controller", "route"} {
+				for _, name := range []string{"Consumes", "Produces"} {
+					attr := annotations.Attribute{
+						Name:  name,
+						Value: "application/vnd.api+json",
+					}
+
+					err := annotations.IsValidAnnotation(attr, source)
+					Expect(err).To(BeNil())
+				}
+			}
+		})
+
+		It("Should reject values that are not media types", func() {
+			for _, value := range []string{"json", "application/*", "application/json; charset=utf-8"} {
+				attr := annotations.Attribute{
+					Name:  "Produces",
+					Value: value,
+				}
+
+				err := annotations.IsValidAnnotation(attr, "route")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("annotation @Produces requires a media type such as 'application/json'"))
+			}
+		})
+
+		It("Should parse vendor media types from comments", func() {
+			holder, err := annotations.NewAnnotationHolder(
+				[]string{"// @Produces(application/vnd.api+json)"},
+				annotations.CommentSourceRoute,
+			)
+			Expect(err).To(BeNil())
+			Expect(holder.GetFirstValueOrEmpty(annotations.AttributeProduces)).To(Equal("application/vnd.api+json"))
+		})
+	})
+
+	Context("When validating form file annotations", func() {
+		It("Should accept a maximum size and allowed MIME types", func() {
+			holder, err := annotations.NewAnnotationHolder(
+				[]string{`// @FormFile(avatar, { maxSize: 1048576, mimeTypes: ["image/png", "image/*"] })`},
+				annotations.CommentSourceRoute,
+			)
+			Expect(err).To(BeNil())
+			Expect(holder.GetFirstValueOrEmpty(annotations.AttributeFormFile)).To(Equal("avatar"))
+		})
+
+		It("Should reject maximum sizes that are not a positive whole number of bytes", func() {
+			for _, maxSize := range []any{0.0, -1.0, 1.5, "1mb"} {
+				attr := annotations.Attribute{
+					Name:       "FormFile",
+					Value:      "avatar",
+					Properties: map[string]any{"maxSize": maxSize},
+				}
+
+				err := annotations.IsValidAnnotation(attr, "route")
+				Expect(err).To(HaveOccurred())
+			}
+		})
+
+		It("Should reject MIME types that are not media types", func() {
+			for _, mimeType := range []any{"png", "*/*", "image/p*", "image/png; q=1", 1.0} {
+				attr := annotations.Attribute{
+					Name:       "FormFile",
+					Value:      "avatar",
+					Properties: map[string]any{"mimeTypes": []any{mimeType}},
+				}
+
+				err := annotations.IsValidAnnotation(attr, "route")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("property mimeTypes of annotation @FormFile requires media types"))
+			}
+		})
+
+		It("Should reject Body and FormFile annotations together", func() {
+			attrs := []annotations.Attribute{
+				{Name: "Body", Value: "data"},
+				{Name: "FormFile", Value: "avatar"},
+			}
+
+			err := annotations.NewValidator().ValidateAnnotationCollection(attrs, "route")
+			Expect(err).To(MatchError("annotations @FormFile and @Body cannot be used together"))
+		})
+	})
+
 	Context("When validating unknown annotations", func() {
 		It("Should reject unknown annotations", func() {
 			attr := annotations.Attribute{
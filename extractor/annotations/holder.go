@@ -11,6 +11,12 @@ const (
 	PropertyName            = "name"
 	PropertySecurityScopes  = "scopes"
 	PropertyValidatorString = "validate"
+	PropertyStyle           = "style"
+	PropertyExplode         = "explode"
+	PropertyValue           = "value"
+	PropertyDefault         = "default"
+	PropertyMaxSize         = "maxSize"
+	PropertyMimeTypes       = "mimeTypes"
 )
 
 const (
@@ -20,6 +26,10 @@ const (
 	AttributeBody            = "Body"
 	AttributeHeader          = "Header"
 	AttributeFormField       = "FormField"
+	AttributeCookie          = "Cookie"
+	AttributeFormFile        = "FormFile"
+	AttributeConsumes        = "Consumes"
+	AttributeProduces        = "Produces"
 	AttributeDeprecated      = "Deprecated"
 	AttributeHidden          = "Hidden"
 	AttributeSecurity        = "Security"
@@ -29,6 +39,15 @@ const (
 	AttributeMethod          = "Method"
 	AttributeErrorResponse   = "ErrorResponse"
 	AttributeTemplateContext = "TemplateContext"
+	AttributeName            = "Name"
+	AttributeOneOf           = "OneOf"
+	AttributeDiscriminator   = "Discriminator"
+	AttributeExample         = "Example"
+	AttributeDefault         = "Default"
+	AttributeFormat          = "Format"
+	AttributePattern         = "Pattern"
+	AttributeReadOnly        = "ReadOnly"
+	AttributeWriteOnly       = "WriteOnly"
 	// AttributeAdvancedSecurity = "AdvancedSecurity"
 )
 
@@ -74,7 +93,7 @@ const (
 
 func NewAnnotationHolder(comments []string, commentSource CommentSource) (AnnotationHolder, error) {
 	// Captures: 1. TEXT (after @), 2. TEXT (inside parentheses), 3. JSON5 Object, 4. Remaining TEXT
-	parsingRegex := regexp.MustCompile(`^// @(\w+)(?:(?:\(([\w-_/\\{} ]+))(?:\s*,\s*(\{.*\}))?\))?(?:\s+(.+))?$`)
+	parsingRegex := regexp.MustCompile(`^// @(\w+)(?:(?:\(([\w-_/\\{} .+]+))(?:\s*,\s*(\{.*\}))?\))?(?:\s+(.+))?$`)
 
 	holder := AnnotationHolder{
 		nonAttributeComments: make([]NonAttributeComment, 0),
@@ -3,9 +3,12 @@ package extractor
 import (
 	"fmt"
 	"go/ast"
+	"go/constant"
 	"go/token"
 	"go/types"
 	"path/filepath"
+	"slices"
+	"sort"
 	"strings"
 
 	MapSet "github.com/deckarep/golang-set/v2"
@@ -233,6 +236,9 @@ func GetTypeMetaByIdent(
 			return meta, err
 		}
 		meta.EntityKind = kind
+		if err := fillNamedPrimitiveInfo(&meta, relevantPkg); err != nil {
+			return meta, err
+		}
 	} else {
 		// If we've gotten here, the ident is a locally defined entity;
 		//
@@ -258,6 +264,9 @@ func GetTypeMetaByIdent(
 		meta.FullyQualifiedPackage = currentPackageName
 		meta.DefaultPackageAlias = GetDefaultAlias(currentPackageName)
 		meta.EntityKind = entityKind
+		if err := fillNamedPrimitiveInfo(&meta, FilterPackageByFullName(packages, currentPackageName)); err != nil {
+			return meta, err
+		}
 	}
 
 	return meta, nil
@@ -320,9 +329,93 @@ func GetTypeMetaBySelectorExpr(
 	}
 
 	meta.EntityKind = kind
+	if err := fillNamedPrimitiveInfo(&meta, pkg); err != nil {
+		return meta, err
+	}
+
 	return meta, nil
 }
 
+// fillNamedPrimitiveInfo sets the underlying type and enum values on the given metadata
+// if it describes a named primitive (e.g. 'type OrderStatus string')
+func fillNamedPrimitiveInfo(meta *definitions.TypeMetadata, pkg *packages.Package) error {
+	// Well-known types have dedicated handling, even when declared over a primitive (e.g. 'time.Duration')
+	if meta.IsWellKnownType() {
+		return nil
+	}
+
+	typeName, err := LookupTypeName(pkg, meta.Name)
+	if err != nil {
+		return err
+	}
+
+	if typeName == nil {
+		return nil
+	}
+
+	meta.UnderlyingType, meta.EnumValues = GetNamedPrimitiveInfo(typeName)
+	return nil
+}
+
+// GetNamedPrimitiveInfo returns the name of the universe type a named primitive is declared over, alongside the values
+// of all constants of that type which are declared in the same package, in order of declaration.
+//
+// Only string and integer types yield enum values.
+// Aliases of universe types (e.g. 'type UserID = string') are named primitives without values
+// whilst aliases of named types yield the information of the aliased type.
+// For any type that is not a named primitive, an empty string and a nil slice are returned.
+func GetNamedPrimitiveInfo(typeName *types.TypeName) (string, []string) {
+	resolved := types.Unalias(typeName.Type())
+	if basic, isBasic := resolved.(*types.Basic); isBasic && typeName.IsAlias() && typeName.Pkg() != nil {
+		return basic.Name(), nil
+	}
+
+	named, isNamed := resolved.(*types.Named)
+	if !isNamed {
+		return "", nil
+	}
+
+	basic, isBasic := named.Underlying().(*types.Basic)
+	if !isBasic {
+		return "", nil
+	}
+
+	isString := basic.Info()&types.IsString != 0
+	if !isString && basic.Info()&types.IsInteger == 0 {
+		return basic.Name(), nil
+	}
+
+	consts := []*types.Const{}
+	scope := named.Obj().Pkg().Scope()
+	for _, name := range scope.Names() {
+		if constObj, isConst := scope.Lookup(name).(*types.Const); isConst && types.Identical(constObj.Type(), named) {
+			consts = append(consts, constObj)
+		}
+	}
+
+	// Scope names are sorted alphabetically; enums are expected to retain their declaration order
+	sort.Slice(consts, func(i, j int) bool {
+		return consts[i].Pos() < consts[j].Pos()
+	})
+
+	var values []string
+	for _, constObj := range consts {
+		var value string
+		if isString {
+			value = constant.StringVal(constObj.Val())
+		} else {
+			value = constObj.Val().ExactString()
+		}
+
+		// Aliased constants (i.e., two names for the same value) should yield a single enum value
+		if !slices.Contains(values, value) {
+			values = append(values, value)
+		}
+	}
+
+	return basic.Name(), values
+}
+
 func GetFieldMetadata(
 	file *ast.File,
 	fileSet *token.FileSet,
@@ -340,12 +433,107 @@ func GetFieldMetadata(
 			meta.IsByAddress = true
 		}
 		return meta, err
+	case *ast.IndexExpr:
+		return getGenericInstanceMeta(file, fileSet, packages, fieldType.X, []ast.Expr{fieldType.Index})
+	case *ast.IndexListExpr:
+		return getGenericInstanceMeta(file, fileSet, packages, fieldType.X, fieldType.Indices)
+	case *ast.ArrayType:
+		fieldTypeString := GetFieldTypeString(fieldType)
+		if _, isWellKnown := definitions.GetWellKnownType(fieldTypeString); isWellKnown && fieldType.Len == nil {
+			// Well-known slices, i.e., byte slices, are composed solely of universe types
+			return definitions.TypeMetadata{
+				Name:           fieldTypeString,
+				IsUniverseType: true,
+				Import:         definitions.ImportTypeNone,
+				EntityKind:     definitions.AstNodeKindArray,
+			}, nil
+		}
+
+		if fieldType.Len != nil {
+			// Fixed-size arrays cannot be populated from a variable number of values
+			return definitions.TypeMetadata{}, fmt.Errorf("field type '%s' is not currently supported", fieldTypeString)
+		}
+
+		elementMeta, err := GetFieldMetadata(file, fileSet, packages, &ast.Field{Type: fieldType.Elt})
+		if err != nil {
+			return definitions.TypeMetadata{}, err
+		}
+
+		return definitions.TypeMetadata{
+			Name:        "[]" + elementMeta.Name,
+			Import:      definitions.ImportTypeNone,
+			EntityKind:  definitions.AstNodeKindArray,
+			ElementType: &elementMeta,
+		}, nil
+	case *ast.MapType:
+		keyMeta, err := GetFieldMetadata(file, fileSet, packages, &ast.Field{Type: fieldType.Key})
+		if err != nil {
+			return definitions.TypeMetadata{}, err
+		}
+
+		valueMeta, err := GetFieldMetadata(file, fileSet, packages, &ast.Field{Type: fieldType.Value})
+		if err != nil {
+			return definitions.TypeMetadata{}, err
+		}
+
+		return definitions.TypeMetadata{
+			Name:       fmt.Sprintf("map[%s]%s", keyMeta.Name, valueMeta.Name),
+			Import:     definitions.ImportTypeNone,
+			EntityKind: definitions.AstNodeKindMap,
+			KeyType:    &keyMeta,
+			ValueType:  &valueMeta,
+		}, nil
+	case *ast.ChanType:
+		if fieldType.Dir != ast.RECV {
+			// Channels are only ever read from, i.e., when streaming server-sent events
+			return definitions.TypeMetadata{}, fmt.Errorf(
+				"field type '%s' is not currently supported - only receive-only channels are",
+				GetFieldTypeString(fieldType),
+			)
+		}
+
+		elementMeta, err := GetFieldMetadata(file, fileSet, packages, &ast.Field{Type: fieldType.Value})
+		if err != nil {
+			return definitions.TypeMetadata{}, err
+		}
+
+		return definitions.TypeMetadata{
+			Name:        "<-chan " + elementMeta.Name,
+			Import:      definitions.ImportTypeNone,
+			EntityKind:  definitions.AstNodeKindChannel,
+			ElementType: &elementMeta,
+		}, nil
 	default:
 		fieldTypeString := GetFieldTypeString(fieldType)
 		return definitions.TypeMetadata{}, fmt.Errorf("field type '%s' is not currently supported", fieldTypeString)
 	}
 }
 
+// getGenericInstanceMeta returns the metadata of an instantiated generic type, e.g. 'Page[User]'.
+// The generic type itself is described by the returned metadata whilst its type arguments are stored in 'TypeArgs'
+func getGenericInstanceMeta(
+	file *ast.File,
+	fileSet *token.FileSet,
+	packages []*packages.Package,
+	genericType ast.Expr,
+	typeArgs []ast.Expr,
+) (definitions.TypeMetadata, error) {
+	meta, err := GetFieldMetadata(file, fileSet, packages, &ast.Field{Type: genericType})
+	if err != nil {
+		return meta, err
+	}
+
+	for _, typeArg := range typeArgs {
+		argMeta, err := GetFieldMetadata(file, fileSet, packages, &ast.Field{Type: typeArg})
+		if err != nil {
+			return meta, fmt.Errorf("could not process type argument of generic type '%s' - %v", meta.Name, err)
+		}
+		meta.TypeArgs = append(meta.TypeArgs, argMeta)
+	}
+
+	return meta, nil
+}
+
 func GetFuncParameterTypeList(
 	file *ast.File,
 	fileSet *token.FileSet,
@@ -501,13 +689,35 @@ func IsUniverseType(typeName string) bool {
 	return isType
 }
 
+// FilterPackageByFullName returns the package with the given full name from the given list of packages or,
+// failing that, from their transitive imports, i.e., packages outside the controller globs, including other modules
 func FilterPackageByFullName(packages []*packages.Package, fullName string) *packages.Package {
 	for _, pkg := range packages {
 		if pkg.PkgPath == fullName {
 			return pkg
 		}
 	}
-	return nil
+	return findImportedPackage(packages, fullName)
+}
+
+func findImportedPackage(pkgs []*packages.Package, fullName string) *packages.Package {
+	var found *packages.Package
+	packages.Visit(
+		pkgs,
+		func(pkg *packages.Package) bool {
+			if found != nil {
+				return false
+			}
+
+			if pkg.PkgPath == fullName {
+				found = pkg
+				return false
+			}
+			return true
+		},
+		nil,
+	)
+	return found
 }
 
 func FindGenDeclByName(pkg *packages.Package, typeSpecName string) *ast.GenDecl {
@@ -568,6 +778,33 @@ func GetStructFromGenDecl(decl *ast.GenDecl) *ast.StructType {
 	return nil
 }
 
+// FindStructInPackage returns the AST of the struct with the given name alongside the file in which it's declared.
+// Returns nils if no such struct exists
+func FindStructInPackage(pkg *packages.Package, structName string) (*ast.File, *ast.StructType) {
+	for _, file := range pkg.Syntax {
+		for _, decl := range file.Decls {
+			genDecl, isGenDecl := decl.(*ast.GenDecl)
+			if !isGenDecl || genDecl.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				typeSpec, isTypeSpec := spec.(*ast.TypeSpec)
+				if !isTypeSpec || typeSpec.Name.Name != structName {
+					continue
+				}
+
+				if structType, isStruct := typeSpec.Type.(*ast.StructType); isStruct {
+					return file, structType
+				}
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
 func FindTypesStructInPackage(pkg *packages.Package, structName string) (*types.Struct, error) {
 	typeName, err := LookupTypeName(pkg, structName)
 	if err != nil {
@@ -664,6 +901,16 @@ func GetFieldTypeString(fieldType ast.Expr) string {
 	case *ast.ParenExpr:
 		return fmt.Sprintf("Parenthesized (%s)", GetFieldTypeString(t.X))
 
+	case *ast.IndexExpr:
+		return fmt.Sprintf("%s[%s]", GetFieldTypeString(t.X), GetFieldTypeString(t.Index))
+
+	case *ast.IndexListExpr:
+		typeArgs := make([]string, len(t.Indices))
+		for i, index := range t.Indices {
+			typeArgs[i] = GetFieldTypeString(index)
+		}
+		return fmt.Sprintf("%s[%s]", GetFieldTypeString(t.X), strings.Join(typeArgs, ", "))
+
 	default:
 		return fmt.Sprintf("Unknown type (%T)", fieldType)
 	}
@@ -39,3 +39,15 @@ func MapDocListToStrings(docList []*ast.Comment) []string {
 	}
 	return result
 }
+
+// GetFieldComments returns the comments annotating the given struct field - its doc comments followed by its trailing line comment
+func GetFieldComments(field *ast.Field) []string {
+	comments := []string{}
+	if field.Doc != nil {
+		comments = append(comments, MapDocListToStrings(field.Doc.List)...)
+	}
+	if field.Comment != nil {
+		comments = append(comments, MapDocListToStrings(field.Comment.List)...)
+	}
+	return comments
+}
@@ -3,6 +3,7 @@ package controller
 import (
 	"fmt"
 	"go/ast"
+	"go/types"
 	"strings"
 
 	MapSet "github.com/deckarep/golang-set/v2"
@@ -11,6 +12,7 @@ import (
 	"github.com/gopher-fleece/gleece/extractor/annotations"
 	"github.com/gopher-fleece/gleece/infrastructure/logger"
 	"github.com/gopher-fleece/runtime"
+	"golang.org/x/tools/go/packages"
 )
 
 func (v *ControllerVisitor) getNextImportId() uint64 {
@@ -19,6 +21,121 @@ func (v *ControllerVisitor) getNextImportId() uint64 {
 	return value
 }
 
+// getPackage returns the package with the given full name.
+// Packages that are neither matched by the controller globs nor imported by them are loaded on demand
+func (v *ControllerVisitor) getPackage(fullPackageName string) (*packages.Package, error) {
+	pkg := extractor.FilterPackageByFullName(v.packages, fullPackageName)
+	if pkg != nil {
+		return pkg, nil
+	}
+
+	loadedPackages, err := extractor.GetPackagesFromExpressions([]string{fullPackageName})
+	if err != nil {
+		return nil, err
+	}
+
+	pkg = extractor.FilterPackageByFullName(loadedPackages, fullPackageName)
+	if pkg == nil {
+		return nil, fmt.Errorf("package '%s' could not be loaded", fullPackageName)
+	}
+
+	if len(pkg.Errors) > 0 {
+		return nil, fmt.Errorf("package '%s' could not be loaded - %v", fullPackageName, pkg.Errors[0])
+	}
+
+	v.packages = append(v.packages, pkg)
+	return pkg, nil
+}
+
+// getGenericInstance resolves the instantiated generic type described by the given metadata, e.g. 'Page[User]'
+func (v *ControllerVisitor) getGenericInstance(meta definitions.TypeMetadata) (*types.Named, error) {
+	genericType, err := v.getType(definitions.TypeMetadata{Name: meta.Name, FullyQualifiedPackage: meta.FullyQualifiedPackage})
+	if err != nil {
+		return nil, err
+	}
+
+	typeArgs := make([]types.Type, len(meta.TypeArgs))
+	for i, typeArgMeta := range meta.TypeArgs {
+		typeArgs[i], err = v.getType(typeArgMeta)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	instance, err := types.Instantiate(nil, genericType, typeArgs, true)
+	if err != nil {
+		return nil, fmt.Errorf("could not instantiate generic type '%s' - %v", meta.Name, err)
+	}
+
+	named, isNamed := instance.(*types.Named)
+	if !isNamed {
+		return nil, fmt.Errorf("instantiation of generic type '%s' did not yield a named type", meta.Name)
+	}
+
+	return named, nil
+}
+
+// getType resolves the go/types type described by the given metadata
+func (v *ControllerVisitor) getType(meta definitions.TypeMetadata) (types.Type, error) {
+	var resolved types.Type
+
+	switch {
+	case meta.IsGenericInstance():
+		instance, err := v.getGenericInstance(meta)
+		if err != nil {
+			return nil, err
+		}
+		resolved = instance
+	case meta.IsSlice():
+		element, err := v.getType(*meta.ElementType)
+		if err != nil {
+			return nil, err
+		}
+		resolved = types.NewSlice(element)
+	case meta.IsMap():
+		key, err := v.getType(*meta.KeyType)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := v.getType(*meta.ValueType)
+		if err != nil {
+			return nil, err
+		}
+		resolved = types.NewMap(key, value)
+	case meta.IsUniverseType:
+		elementName, isSlice := strings.CutPrefix(meta.Name, "[]")
+		universeObj := types.Universe.Lookup(elementName)
+		if universeObj == nil {
+			return nil, fmt.Errorf("could not find universe type '%s'", elementName)
+		}
+		resolved = universeObj.Type()
+		if isSlice {
+			resolved = types.NewSlice(resolved)
+		}
+	default:
+		pkg, err := v.getPackage(meta.FullyQualifiedPackage)
+		if err != nil {
+			return nil, err
+		}
+
+		typeName, err := extractor.LookupTypeName(pkg, meta.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		if typeName == nil {
+			return nil, fmt.Errorf("could not find type '%s' in package '%s'", meta.Name, meta.FullyQualifiedPackage)
+		}
+		resolved = typeName.Type()
+	}
+
+	if meta.IsByAddress {
+		return types.NewPointer(resolved), nil
+	}
+	return resolved, nil
+}
+
 func (v *ControllerVisitor) getAllSourceFiles() []*ast.File {
 	result := []*ast.File{}
 	for _, file := range v.sourceFiles {
@@ -27,7 +144,7 @@ func (v *ControllerVisitor) getAllSourceFiles() []*ast.File {
 	return result
 }
 
-func (v ControllerVisitor) isAnErrorEmbeddingType(meta definitions.TypeMetadata) (bool, error) {
+func (v *ControllerVisitor) isAnErrorEmbeddingType(meta definitions.TypeMetadata) (bool, error) {
 	v.enter(fmt.Sprintf("Type %s (%s)", meta.Name, meta.FullyQualifiedPackage))
 	defer v.exit()
 
@@ -35,7 +152,11 @@ func (v ControllerVisitor) isAnErrorEmbeddingType(meta definitions.TypeMetadata)
 		return true, nil
 	}
 
-	pkg := extractor.FilterPackageByFullName(v.packages, meta.FullyQualifiedPackage)
+	pkg, err := v.getPackage(meta.FullyQualifiedPackage)
+	if err != nil {
+		return false, err
+	}
+
 	embeds, err := extractor.DoesStructEmbedType(pkg, meta.Name, "", "error")
 	if err != nil {
 		return false, err
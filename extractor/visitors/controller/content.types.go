@@ -0,0 +1,216 @@
+package controller
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/gopher-fleece/gleece/definitions"
+	"github.com/gopher-fleece/gleece/extractor/annotations"
+)
+
+// The media types the generated routes are able to deserialize request bodies from
+var supportedRequestContentTypes = []definitions.ContentType{
+	definitions.ContentTypeJSON,
+	definitions.ContentTypeXML,
+}
+
+// The media types the generated routes are able to serialize responses to.
+// Plain text responses are limited to strings, numbers and booleans (see validatePlainTextContentType)
+var supportedResponseContentTypes = []definitions.ContentType{
+	definitions.ContentTypeJSON,
+	definitions.ContentTypeXML,
+	definitions.ContentTypePlainText,
+}
+
+// getContentTypes returns the media types given by the @Consumes or @Produces annotations in the given holder, in declaration order.
+// Returns an empty slice if there are no such annotations
+func (v *ControllerVisitor) getContentTypes(
+	holder annotations.AnnotationHolder,
+	attributeName string,
+) ([]definitions.ContentType, error) {
+	supported := supportedRequestContentTypes
+	if attributeName == annotations.AttributeProduces {
+		supported = supportedResponseContentTypes
+	}
+
+	contentTypes := []definitions.ContentType{}
+	for _, attr := range holder.GetAll(attributeName) {
+		contentType := definitions.ContentType(strings.ToLower(attr.Value))
+		if !slices.Contains(supported, contentType) {
+			return contentTypes, v.getFrozenError(
+				"media type '%s' given by @%s is not supported. Supported media types are %v",
+				attr.Value,
+				attributeName,
+				supported,
+			)
+		}
+
+		if !slices.Contains(contentTypes, contentType) {
+			contentTypes = append(contentTypes, contentType)
+		}
+	}
+
+	return contentTypes, nil
+}
+
+// getContentTypesWithInheritance returns the media types given by a route's @Consumes or @Produces annotations.
+// Routes without such annotations inherit those of their controller, or application/json if the controller has none either
+func (v *ControllerVisitor) getContentTypesWithInheritance(
+	attributes annotations.AnnotationHolder,
+	attributeName string,
+) ([]definitions.ContentType, error) {
+	explicit, err := v.getContentTypes(attributes, attributeName)
+	if err != nil {
+		return explicit, err
+	}
+
+	if len(explicit) > 0 {
+		return explicit, nil
+	}
+
+	inherited := v.currentController.Consumes
+	if attributeName == annotations.AttributeProduces {
+		inherited = v.currentController.Produces
+	}
+
+	if len(inherited) > 0 {
+		return inherited, nil
+	}
+
+	return []definitions.ContentType{definitions.ContentTypeJSON}, nil
+}
+
+// getResponseContentTypes returns the media types produced by a route whose value is of the given type.
+// Streamed values and file responses are written as-is and may thus be of any media type given by the route's @Produces annotations.
+// As a controller's media types describe how values are serialized, such routes do not inherit them
+// and produce application/octet-stream unless annotated otherwise.
+//
+// Channels are streamed as server-sent events and are the only values that may, and must, produce text/event-stream
+func (v *ControllerVisitor) getResponseContentTypes(
+	attributes annotations.AnnotationHolder,
+	valueType *definitions.TypeMetadata,
+) ([]definitions.ContentType, error) {
+	produced := attributes.GetAll(annotations.AttributeProduces)
+	producesEvents := slices.ContainsFunc(produced, func(attr *annotations.Attribute) bool {
+		return definitions.ContentType(strings.ToLower(attr.Value)) == definitions.ContentTypeEventStream
+	})
+
+	if valueType != nil && valueType.IsChannel() {
+		if !producesEvents || len(produced) > 1 {
+			return nil, v.getFrozenError(
+				"routes returning channels must be annotated with a sole @%s(%s)",
+				annotations.AttributeProduces,
+				definitions.ContentTypeEventStream,
+			)
+		}
+		return []definitions.ContentType{definitions.ContentTypeEventStream}, nil
+	}
+
+	if producesEvents {
+		return nil, v.getFrozenError(
+			"routes producing '%s' must return a receive-only channel ('<-chan T')",
+			definitions.ContentTypeEventStream,
+		)
+	}
+
+	if valueType == nil || (!valueType.IsStream() && !valueType.IsFileResponse) {
+		return v.getContentTypesWithInheritance(attributes, annotations.AttributeProduces)
+	}
+
+	contentTypes := []definitions.ContentType{}
+	for _, attr := range attributes.GetAll(annotations.AttributeProduces) {
+		contentType := definitions.ContentType(strings.ToLower(attr.Value))
+		if !slices.Contains(contentTypes, contentType) {
+			contentTypes = append(contentTypes, contentType)
+		}
+	}
+
+	if len(contentTypes) == 0 {
+		return []definitions.ContentType{definitions.ContentTypeOctetStream}, nil
+	}
+
+	return contentTypes, nil
+}
+
+// applyFormContentType sets the media type consumed by routes with form parameters.
+// Forms are sent URL encoded unless they carry files, in which case they're sent as multipart forms.
+// Either way, form parameters may not be combined with a route level @Consumes annotation
+func (v *ControllerVisitor) applyFormContentType(meta *definitions.RouteMetadata, attributes annotations.AnnotationHolder) error {
+	hasFormParams := slices.ContainsFunc(meta.FuncParams, func(param definitions.FuncParam) bool {
+		return param.PassedIn == definitions.PassedInForm || param.PassedIn == definitions.PassedInFormFile
+	})
+
+	if !hasFormParams {
+		return nil
+	}
+
+	contentType := definitions.ContentTypeFormURLEncoded
+	hasFormFiles := slices.ContainsFunc(meta.FuncParams, func(param definitions.FuncParam) bool {
+		return param.PassedIn == definitions.PassedInFormFile
+	})
+	if hasFormFiles {
+		contentType = definitions.ContentTypeMultipartForm
+	}
+
+	if attributes.Has(annotations.AttributeConsumes) {
+		return v.getFrozenError(
+			"@Consumes cannot be used on route '%s' as form parameters are always sent as '%s'",
+			meta.OperationId,
+			contentType,
+		)
+	}
+
+	meta.Consumes = []definitions.ContentType{contentType}
+	meta.RequestContentType = contentType
+	return nil
+}
+
+// validateXmlContentTypes verifies the route's request body and return value can be (de)serialized as XML, if it consumes or produces XML.
+// encoding/xml supports neither maps nor interfaces, so polymorphic bodies and map return values are always JSON
+func (v *ControllerVisitor) validateXmlContentTypes(meta definitions.RouteMetadata) error {
+	if slices.Contains(meta.Consumes, definitions.ContentTypeXML) {
+		for _, param := range meta.FuncParams {
+			if param.PassedIn == definitions.PassedInBody && (param.TypeMeta.IsPolymorphic() || param.TypeMeta.IsMap()) {
+				return v.getFrozenError(
+					"route '%s' cannot consume '%s' as the type of its body parameter '%s' cannot be deserialized from XML",
+					meta.OperationId,
+					definitions.ContentTypeXML,
+					param.Name,
+				)
+			}
+		}
+	}
+
+	valueType := meta.GetValueReturnType()
+	if valueType != nil && valueType.IsMap() && slices.Contains(meta.Produces, definitions.ContentTypeXML) {
+		return v.getFrozenError(
+			"route '%s' cannot produce '%s' as maps cannot be serialized to XML",
+			meta.OperationId,
+			definitions.ContentTypeXML,
+		)
+	}
+
+	return nil
+}
+
+// validatePlainTextContentType verifies the route's return value can be written as plain text, if it produces plain text.
+// Plain text responses hold the value's default format (see fmt.Sprint) and are thus limited to strings, numbers and booleans.
+// Streamed values and file responses are written as-is and may be of any media type
+func (v *ControllerVisitor) validatePlainTextContentType(meta definitions.RouteMetadata) error {
+	valueType := meta.GetValueReturnType()
+	if valueType == nil || valueType.IsStream() || valueType.IsFileResponse || !slices.Contains(meta.Produces, definitions.ContentTypePlainText) {
+		return nil
+	}
+
+	isScalar := valueType.IsUniverseType || valueType.IsNamedPrimitive()
+	isContainer := valueType.IsSlice() || valueType.IsMap() || valueType.IsChannel()
+	if !isScalar || isContainer || valueType.IsByAddress || valueType.Name == "any" {
+		return v.getFrozenError(
+			"route '%s' cannot produce '%s' as its return value is not a string, a number or a boolean",
+			meta.OperationId,
+			definitions.ContentTypePlainText,
+		)
+	}
+
+	return nil
+}
@@ -97,10 +97,22 @@ func (v *ControllerVisitor) createControllerMetadata(controllerNode *ast.TypeSpe
 			security = v.getDefaultSecurity()
 		}
 
+		consumes, err := v.getContentTypes(holder, annotations.AttributeConsumes)
+		if err != nil {
+			return meta, v.frozenError(err)
+		}
+
+		produces, err := v.getContentTypes(holder, annotations.AttributeProduces)
+		if err != nil {
+			return meta, v.frozenError(err)
+		}
+
 		meta.Tag = holder.GetFirstValueOrEmpty(annotations.AttributeTag)
 		meta.Description = holder.GetFirstDescriptionOrEmpty(annotations.AttributeDescription)
 		meta.RestMetadata = definitions.RestMetadata{Path: holder.GetFirstValueOrEmpty(annotations.AttributeRoute)}
 		meta.Security = security
+		meta.Consumes = consumes
+		meta.Produces = produces
 	}
 
 	return meta, nil
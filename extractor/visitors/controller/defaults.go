@@ -0,0 +1,111 @@
+package controller
+
+import (
+	"slices"
+	"strconv"
+
+	"github.com/gopher-fleece/gleece/definitions"
+	"github.com/gopher-fleece/gleece/extractor/annotations"
+)
+
+// getParamDefaultValue returns the value given by the 'default' property of a parameter's annotation,
+// e.g. '@Query(limit, { default: 20 })', as it would be sent in the request.
+// Returns an empty string if the property is not set
+func (v *ControllerVisitor) getParamDefaultValue(paramAttrib *annotations.Attribute) (string, error) {
+	value := paramAttrib.GetProperty(annotations.PropertyDefault)
+	if value == nil {
+		return "", nil
+	}
+
+	switch typedValue := (*value).(type) {
+	case string:
+		return typedValue, nil
+	case bool:
+		return strconv.FormatBool(typedValue), nil
+	case float64:
+		return strconv.FormatFloat(typedValue, 'f', -1, 64), nil
+	case int:
+		return strconv.Itoa(typedValue), nil
+	default:
+		return "", v.getFrozenError(
+			"the default value of parameter '%s' must be a string, a number or a boolean but got '%v'",
+			paramAttrib.Value,
+			*value,
+		)
+	}
+}
+
+// validateParamDefault verifies the default value of a parameter, if any, may be parsed as the parameter's type.
+// Enums must default to one of their values.
+// Well-known types are parsed by their own parsers and are not verified
+func (v *ControllerVisitor) validateParamDefault(param definitions.FuncParam) error {
+	if param.DefaultValue == "" {
+		return nil
+	}
+
+	if param.TypeMeta.IsSlice() || len(param.QueryFields) > 0 {
+		return v.getFrozenError(
+			"default values are only supported for primitive parameters but %s parameter '%s' (schema name '%s') is of type '%s'",
+			param.PassedIn,
+			param.Name,
+			param.NameInSchema,
+			param.TypeMeta.Name,
+		)
+	}
+
+	if param.TypeMeta.IsEnum() {
+		if !slices.Contains(param.TypeMeta.EnumValues, param.DefaultValue) {
+			return v.getFrozenError(
+				"the default value '%s' of parameter '%s' is not a valid %s value",
+				param.DefaultValue,
+				param.Name,
+				param.TypeMeta.Name,
+			)
+		}
+		return nil
+	}
+
+	typeName := param.TypeMeta.UnderlyingType
+	if typeName == "" {
+		typeName = param.TypeMeta.Name
+	}
+
+	var err error
+	switch typeName {
+	case "bool":
+		_, err = strconv.ParseBool(param.DefaultValue)
+	case "int", "int8", "int16", "int32", "int64":
+		_, err = strconv.ParseInt(param.DefaultValue, 10, getBitSize(typeName))
+	case "uint", "uint8", "uint16", "uint32", "uint64":
+		_, err = strconv.ParseUint(param.DefaultValue, 10, getBitSize(typeName))
+	case "float32", "float64":
+		_, err = strconv.ParseFloat(param.DefaultValue, getBitSize(typeName))
+	}
+
+	if err != nil {
+		return v.getFrozenError(
+			"the default value '%s' of parameter '%s' is not a valid %s - %v",
+			param.DefaultValue,
+			param.Name,
+			typeName,
+			err,
+		)
+	}
+	return nil
+}
+
+// getBitSize returns the size of the given numeric universe type, in bits. Returns 0 for 'int' and 'uint', which are platform-sized
+func getBitSize(typeName string) int {
+	switch typeName {
+	case "int8", "uint8":
+		return 8
+	case "int16", "uint16":
+		return 16
+	case "int32", "uint32", "float32":
+		return 32
+	case "int64", "uint64", "float64":
+		return 64
+	default:
+		return 0
+	}
+}
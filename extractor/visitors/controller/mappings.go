@@ -0,0 +1,123 @@
+package controller
+
+import (
+	"fmt"
+	"go/types"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/gopher-fleece/gleece/definitions"
+	"github.com/gopher-fleece/gleece/extractor"
+	"golang.org/x/tools/go/packages"
+)
+
+// applyTypeMapping attaches the user-configured mapping of the given type, and of the types it's composed of, if any.
+// Mapped types are represented as configured and are hence never treated as named primitives
+func (v *ControllerVisitor) applyTypeMapping(typeMeta *definitions.TypeMetadata) {
+	if mapping, isMapped := v.config.OpenAPIGeneratorConfig.TypeMappings[typeMeta.FullName()]; isMapped {
+		typeMeta.Mapping = &mapping
+		typeMeta.UnderlyingType = ""
+		typeMeta.EnumValues = nil
+	}
+
+	for _, composedType := range []*definitions.TypeMetadata{typeMeta.ElementType, typeMeta.KeyType, typeMeta.ValueType} {
+		if composedType != nil {
+			v.applyTypeMapping(composedType)
+		}
+	}
+
+	for i := range typeMeta.TypeArgs {
+		v.applyTypeMapping(&typeMeta.TypeArgs[i])
+	}
+}
+
+// validateTypeMappings verifies the parse function of each user-configured type mapping is a 'func(string) (T, error)',
+// where 'T' is the mapped type.
+//
+// Misconfigured parse functions are reported here rather than by the compiler, once the generated routes are built
+func (v *ControllerVisitor) validateTypeMappings() error {
+	typeMappings := v.config.OpenAPIGeneratorConfig.TypeMappings
+	for _, fullTypeName := range slices.Sorted(maps.Keys(typeMappings)) {
+		if typeMappings[fullTypeName].ParseFuncName == "" {
+			continue
+		}
+
+		if err := v.validateParseFunc(fullTypeName, typeMappings[fullTypeName]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateParseFunc resolves the parse function of the given type mapping and verifies its signature
+func (v *ControllerVisitor) validateParseFunc(fullTypeName string, mapping definitions.WellKnownType) error {
+	parseFuncName := fmt.Sprintf("%s.%s", mapping.ParseFuncPackage, mapping.ParseFuncName)
+
+	separatorIndex := strings.LastIndex(fullTypeName, ".")
+	if separatorIndex <= 0 {
+		return fmt.Errorf("type mapping '%s' must refer to a fully qualified type, e.g. 'github.com/oklog/ulid/v2.ULID'", fullTypeName)
+	}
+
+	mappedTypeMeta := definitions.TypeMetadata{
+		Name:                  fullTypeName[separatorIndex+1:],
+		FullyQualifiedPackage: fullTypeName[:separatorIndex],
+	}
+	mappedType, err := v.getType(mappedTypeMeta)
+	if err != nil {
+		return fmt.Errorf("could not resolve the type of type mapping '%s' - %v", fullTypeName, err)
+	}
+
+	pkg, err := v.getPackage(mapping.ParseFuncPackage)
+	if err != nil {
+		return fmt.Errorf("could not resolve parse function '%s' of type mapping '%s' - %v", parseFuncName, fullTypeName, err)
+	}
+
+	parseFunc, isFunc := pkg.Types.Scope().Lookup(mapping.ParseFuncName).(*types.Func)
+	if !isFunc || !parseFunc.Exported() {
+		return fmt.Errorf(
+			"parse function '%s' of type mapping '%s' does not exist or is not an exported function",
+			parseFuncName,
+			fullTypeName,
+		)
+	}
+
+	// Packages loaded on demand are type-checked apart from the others, so their types are never identical to types loaded before.
+	// The mapped type is hence compared as seen by the parse function's package, which must import it for the signature to match
+	if typePkg := extractor.FilterPackageByFullName([]*packages.Package{pkg}, mappedTypeMeta.FullyQualifiedPackage); typePkg != nil {
+		if typeName, _ := extractor.LookupTypeName(typePkg, mappedTypeMeta.Name); typeName != nil {
+			mappedType = typeName.Type()
+		}
+	}
+
+	// Types are referred to by their package name, i.e., the same way they're referred to in code
+	qualifier := func(pkg *types.Package) string { return pkg.Name() }
+	signature := parseFunc.Type().(*types.Signature)
+	if !isParseFuncSignature(signature, mappedType) {
+		return fmt.Errorf(
+			"parse function '%s' of type mapping '%s' must be a 'func(string) (%s, error)' but is a '%s'",
+			parseFuncName,
+			fullTypeName,
+			types.TypeString(mappedType, qualifier),
+			types.TypeString(signature, qualifier),
+		)
+	}
+
+	return nil
+}
+
+// isParseFuncSignature returns whether the given signature is a 'func(string) (T, error)', where 'T' is the given type
+func isParseFuncSignature(signature *types.Signature, parsedType types.Type) bool {
+	if signature.TypeParams().Len() > 0 || signature.Variadic() {
+		return false
+	}
+
+	if signature.Params().Len() != 1 || !types.Identical(signature.Params().At(0).Type(), types.Typ[types.String]) {
+		return false
+	}
+
+	return signature.Results().Len() == 2 &&
+		types.Identical(signature.Results().At(0).Type(), parsedType) &&
+		types.Identical(signature.Results().At(1).Type(), types.Universe.Lookup("error").Type())
+}
@@ -1,32 +1,80 @@
 package controller
 
-import "github.com/gopher-fleece/gleece/definitions"
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/gopher-fleece/gleece/definitions"
+	"github.com/gopher-fleece/gleece/extractor"
+	"github.com/gopher-fleece/gleece/extractor/visitors"
+	"golang.org/x/tools/go/packages"
+)
+
+// getTypeIdentity returns a key uniquely identifying the given type, including the type arguments of generic instantiations
+func getTypeIdentity(typeMeta definitions.TypeMetadata) string {
+	identity := typeMeta.FullName()
+	if typeMeta.IsGenericInstance() {
+		typeArgs := make([]string, len(typeMeta.TypeArgs))
+		for i, typeArg := range typeMeta.TypeArgs {
+			typeArgs[i] = getTypeIdentity(typeArg)
+			if typeArg.IsByAddress {
+				typeArgs[i] = "*" + typeArgs[i]
+			}
+		}
+		identity += fmt.Sprintf("[%s]", strings.Join(typeArgs, ", "))
+	}
+	return identity
+}
+
+// getParamModelTypes returns the types a parameter refers to that may be models.
+// Struct-typed query parameters refer to the types of their fields;
+// the query struct itself is expanded into individual parameters and is not a model
+func getParamModelTypes(param *definitions.FuncParam) []*definitions.TypeMetadata {
+	if len(param.QueryFields) > 0 {
+		typeMetas := []*definitions.TypeMetadata{}
+		for fieldIndex := range param.QueryFields {
+			typeMetas = append(typeMetas, getParamModelTypes(&param.QueryFields[fieldIndex])...)
+		}
+		return typeMetas
+	}
+
+	return []*definitions.TypeMetadata{getModelType(&param.TypeMeta)}
+}
+
+// getModelType returns the type a given type refers to that may be a model.
+// Slices and channels refer to the types of their elements and maps to the types of their values
+func getModelType(typeMeta *definitions.TypeMetadata) *definitions.TypeMetadata {
+	switch {
+	case typeMeta.IsSlice() || typeMeta.IsChannel():
+		return getModelType(typeMeta.ElementType)
+	case typeMeta.IsMap():
+		return getModelType(typeMeta.ValueType)
+	default:
+		return typeMeta
+	}
+}
 
 func (v *ControllerVisitor) addToTypeMap(
 	existingTypesMap *map[string]string,
 	existingModels *[]definitions.TypeMetadata,
 	typeMeta definitions.TypeMetadata,
 ) error {
-	if typeMeta.IsUniverseType {
+	// Well-known types such as 'time.Time' are not models and are mapped directly to their dedicated schemas
+	if typeMeta.IsUniverseType || typeMeta.IsWellKnownType() {
 		return nil
 	}
 
-	existsInPackage, exists := (*existingTypesMap)[typeMeta.Name]
-	if exists {
-		if existsInPackage == typeMeta.FullyQualifiedPackage {
-			// Same type referenced from a separate location
-			return nil
-		}
-
-		return v.getFrozenError(
-			"type '%s' exists in more that one package (%s and %s). This is not currently supported",
-			typeMeta.Name,
-			typeMeta.FullyQualifiedPackage,
-			existsInPackage,
-		)
+	// Same-named types from different packages are distinct models; colliding schema names are detected once all models are named
+	identity := getTypeIdentity(typeMeta)
+	if _, exists := (*existingTypesMap)[identity]; exists {
+		// Same type referenced from a separate location
+		return nil
 	}
 
-	(*existingTypesMap)[typeMeta.Name] = typeMeta.FullyQualifiedPackage
+	(*existingTypesMap)[identity] = typeMeta.FullyQualifiedPackage
 	(*existingModels) = append((*existingModels), typeMeta)
 	return nil
 }
@@ -43,9 +91,11 @@ func (v *ControllerVisitor) insertRouteTypeList(
 			// Mark whether we've encountered any 'error' type
 			plainErrorEncountered = true
 		}
-		err := v.addToTypeMap(existingTypesMap, existingModels, param.TypeMeta)
-		if err != nil {
-			return plainErrorEncountered, v.frozenError(err)
+		for _, typeMeta := range getParamModelTypes(&param) {
+			err := v.addToTypeMap(existingTypesMap, existingModels, *typeMeta)
+			if err != nil {
+				return plainErrorEncountered, v.frozenError(err)
+			}
 		}
 	}
 
@@ -54,7 +104,7 @@ func (v *ControllerVisitor) insertRouteTypeList(
 			// Mark whether we've encountered any 'error' type
 			plainErrorEncountered = true
 		}
-		err := v.addToTypeMap(existingTypesMap, existingModels, param.TypeMetadata)
+		err := v.addToTypeMap(existingTypesMap, existingModels, *getModelType(&param.TypeMetadata))
 		if err != nil {
 			return plainErrorEncountered, v.frozenError(err)
 		}
@@ -62,3 +112,232 @@ func (v *ControllerVisitor) insertRouteTypeList(
 
 	return plainErrorEncountered, nil
 }
+
+// visitRouteModel visits a model referenced directly by a route and returns the name of its schema
+func (v *ControllerVisitor) visitRouteModel(
+	typeVisitor *visitors.TypeVisitor,
+	pkg *packages.Package,
+	model definitions.TypeMetadata,
+) (string, error) {
+	if model.IsNamedPrimitive() {
+		if !model.IsEnum() && v.config.OpenAPIGeneratorConfig.NamedPrimitivesMode != definitions.NamedPrimitivesModeSchema {
+			// Described by the universe type the model is declared over
+			return "", nil
+		}
+
+		err := typeVisitor.VisitNamedPrimitive(model.FullyQualifiedPackage, model.Name, model.UnderlyingType, model.EnumValues)
+		if err != nil {
+			return "", err
+		}
+		return typeVisitor.GetSchemaName(model.FullyQualifiedPackage, model.Name)
+	}
+
+	if model.IsGenericInstance() {
+		instance, err := v.getGenericInstance(model)
+		if err != nil {
+			return "", err
+		}
+
+		if err := typeVisitor.VisitGenericInstance(instance); err != nil {
+			return "", err
+		}
+		return typeVisitor.GetGenericInstanceSchemaName(instance)
+	}
+
+	if model.EntityKind == definitions.AstNodeKindInterface {
+		if err := typeVisitor.VisitInterface(model.FullyQualifiedPackage, model.Name); err != nil {
+			return "", err
+		}
+		return typeVisitor.GetSchemaName(model.FullyQualifiedPackage, model.Name)
+	}
+
+	structNode, err := extractor.FindTypesStructInPackage(pkg, model.Name)
+	if err != nil {
+		return "", err
+	}
+
+	if structNode == nil {
+		return "", fmt.Errorf("could not find struct '%s' in package '%s'", model.Name, model.FullyQualifiedPackage)
+	}
+
+	if err := typeVisitor.VisitStruct(model.FullyQualifiedPackage, model.Name, structNode); err != nil {
+		return "", err
+	}
+	return typeVisitor.GetSchemaName(model.FullyQualifiedPackage, model.Name)
+}
+
+// ensureUniqueSchemaNames fails if distinct types would produce the same schema name,
+// as they would otherwise silently overwrite one another in the spec's components
+func (v *ControllerVisitor) ensureUniqueSchemaNames(typeVisitor *visitors.TypeVisitor) error {
+	typesBySchemaName := typeVisitor.GetTypesBySchemaName()
+
+	schemaNames := make([]string, 0, len(typesBySchemaName))
+	for schemaName := range typesBySchemaName {
+		schemaNames = append(schemaNames, schemaName)
+	}
+	slices.Sort(schemaNames)
+
+	for _, schemaName := range schemaNames {
+		typeNames := typesBySchemaName[schemaName]
+		if len(typeNames) > 1 {
+			slices.Sort(typeNames)
+			return v.getFrozenError(
+				"schema name '%s' is shared by multiple types (%s). "+
+					"Use the 'packageQualified' schema naming strategy or an explicit @Name annotation to disambiguate them",
+				schemaName,
+				strings.Join(typeNames, ", "),
+			)
+		}
+	}
+
+	return nil
+}
+
+// applySchemaNames sets the schema names of all route parameters and responses that refer to models
+func (v *ControllerVisitor) applySchemaNames(schemaNames map[string]string) {
+	for controllerIndex := range v.controllers {
+		routes := v.controllers[controllerIndex].Routes
+		for routeIndex := range routes {
+			for paramIndex := range routes[routeIndex].FuncParams {
+				for _, typeMeta := range getParamModelTypes(&routes[routeIndex].FuncParams[paramIndex]) {
+					typeMeta.SchemaName = schemaNames[getTypeIdentity(*typeMeta)]
+				}
+			}
+
+			for responseIndex := range routes[routeIndex].Responses {
+				typeMeta := getModelType(&routes[routeIndex].Responses[responseIndex].TypeMetadata)
+				typeMeta.SchemaName = schemaNames[getTypeIdentity(*typeMeta)]
+			}
+		}
+	}
+}
+
+// applyBodyDefaults sets the default values of the fields of all body parameters, as given by the fields' @Default annotations.
+// Defaults apply to the body model's own fields, including those of the models it embeds, and to the fields of the models
+// it nests by value, which are present in every decoded body.
+//
+// Models nested by pointer, slice or map may be absent from a body and their defaults thus cannot be applied; these are rejected
+func (v *ControllerVisitor) applyBodyDefaults(models []definitions.ModelMetadata) error {
+	modelsByName := make(map[string]definitions.ModelMetadata, len(models))
+	for _, model := range models {
+		modelsByName[model.Name] = model
+	}
+
+	for controllerIndex := range v.controllers {
+		routes := v.controllers[controllerIndex].Routes
+		for routeIndex := range routes {
+			for paramIndex := range routes[routeIndex].FuncParams {
+				param := &routes[routeIndex].FuncParams[paramIndex]
+				if param.PassedIn != definitions.PassedInBody || param.TypeMeta.SchemaName == "" {
+					continue
+				}
+
+				defaults, err := collectFieldDefaults(modelsByName, param.TypeMeta.SchemaName)
+				if err != nil {
+					return v.getFrozenError("could not apply the default values of body '%s' - %v", param.Name, err)
+				}
+				if len(defaults) == 0 {
+					continue
+				}
+
+				defaultsJson, err := json.Marshal(defaults)
+				if err != nil {
+					return v.getFrozenError("could not serialize the default values of body '%s' - %v", param.Name, err)
+				}
+				param.BodyDefaults = string(defaultsJson)
+			}
+		}
+	}
+
+	return nil
+}
+
+// collectFieldDefaults returns the default values of the given model's fields and those of the models it embeds,
+// keyed by the fields' JSON names. The defaults of models nested by value are given as objects of their own,
+// e.g. '{"address": {"country": "PT"}}'
+func collectFieldDefaults(modelsByName map[string]definitions.ModelMetadata, modelName string) (map[string]any, error) {
+	defaults := map[string]any{}
+	model, exists := modelsByName[modelName]
+	if !exists {
+		return defaults, nil
+	}
+
+	for _, embeddedModel := range model.EmbeddedModels {
+		embeddedDefaults, err := collectFieldDefaults(modelsByName, embeddedModel)
+		if err != nil {
+			return nil, err
+		}
+		maps.Copy(defaults, embeddedDefaults)
+	}
+
+	for _, field := range model.Fields {
+		jsonName, _ := visitors.GetJsonName(field.Name, field.Tag)
+		if field.Default != nil {
+			defaults[jsonName] = field.Default
+			continue
+		}
+
+		nestedModel, isNestedByValue := getNestedModelName(field)
+		if !isNestedByValue {
+			if hasFieldDefaults(modelsByName, nestedModel, map[string]bool{}) {
+				return nil, fmt.Errorf(
+					"field '%s' of model '%s' nests model '%s' by pointer, slice or map, whose default values cannot be applied as it may be absent",
+					field.Name,
+					modelName,
+					nestedModel,
+				)
+			}
+			continue
+		}
+
+		nestedDefaults, err := collectFieldDefaults(modelsByName, nestedModel)
+		if err != nil {
+			return nil, err
+		}
+		if len(nestedDefaults) > 0 {
+			defaults[jsonName] = nestedDefaults
+		}
+	}
+
+	return defaults, nil
+}
+
+// hasFieldDefaults returns whether any field of the given model, of the models it embeds or of the models it nests has a default value
+func hasFieldDefaults(modelsByName map[string]definitions.ModelMetadata, modelName string, visited map[string]bool) bool {
+	model, exists := modelsByName[modelName]
+	if !exists || visited[modelName] {
+		return false
+	}
+	visited[modelName] = true
+
+	for _, embeddedModel := range model.EmbeddedModels {
+		if hasFieldDefaults(modelsByName, embeddedModel, visited) {
+			return true
+		}
+	}
+
+	for _, field := range model.Fields {
+		nestedModel, _ := getNestedModelName(field)
+		if field.Default != nil || hasFieldDefaults(modelsByName, nestedModel, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// getNestedModelName returns the name of the model the given field refers to, unwrapping slices and maps,
+// and whether the field holds the model by value, i.e., not by pointer, slice or map
+func getNestedModelName(field definitions.FieldMetadata) (string, bool) {
+	typeName := field.Type
+	isByValue := !field.IsByAddress
+	for {
+		if elemType, isSlice := strings.CutPrefix(typeName, "[]"); isSlice {
+			typeName = elemType
+		} else if mapType, isMap := strings.CutPrefix(typeName, "map["); isMap {
+			_, typeName, _ = strings.Cut(mapType, "]")
+		} else {
+			return typeName, isByValue
+		}
+		isByValue = false
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/gopher-fleece/gleece/definitions"
 	"github.com/gopher-fleece/gleece/extractor"
 	"github.com/gopher-fleece/gleece/extractor/visitors"
+	"golang.org/x/tools/go/packages"
 )
 
 func (v *ControllerVisitor) GetFormattedDiagnosticStack() string {
@@ -86,32 +87,48 @@ func (v *ControllerVisitor) GetModelsFlat() ([]definitions.ModelMetadata, bool,
 		}
 	}
 
-	typeVisitor := visitors.NewTypeVisitor(v.packages)
-	for _, model := range models {
-		pkg := extractor.FilterPackageByFullName(v.packages, model.FullyQualifiedPackage)
-		if pkg == nil {
+	// Resolve all model packages before visiting, as packages outside the controller globs may be loaded on demand
+	modelPackages := make([]*packages.Package, len(models))
+	for i, model := range models {
+		pkg, err := v.getPackage(model.FullyQualifiedPackage)
+		if err != nil {
 			return nil, hasAnyErrorTypes, v.getFrozenError(
-				"could locate packages.Package '%s' whilst looking for type '%s'.\n"+
-					"Please note that Gleece currently cannot use any structs from externally imported packages",
+				"could not locate packages.Package '%s' whilst looking for type '%s' - %v",
 				model.FullyQualifiedPackage,
 				model.Name,
+				err,
 			)
 		}
+		modelPackages[i] = pkg
+	}
 
-		structNode, err := extractor.FindTypesStructInPackage(pkg, model.Name)
+	typeVisitor := visitors.NewTypeVisitor(
+		v.packages,
+		v.config.OpenAPIGeneratorConfig.EmbeddedStructsMode,
+		v.config.OpenAPIGeneratorConfig.SchemaNamingStrategy,
+		v.config.OpenAPIGeneratorConfig.NamedPrimitivesMode,
+		v.config.OpenAPIGeneratorConfig.TypeMappings,
+	)
+
+	// The names of the route models' schemas, keyed by the models' type identities
+	schemaNames := make(map[string]string, len(models))
+	for i, model := range models {
+		schemaName, err := v.visitRouteModel(typeVisitor, modelPackages[i], model)
 		if err != nil {
 			return nil, hasAnyErrorTypes, v.frozenError(err)
 		}
+		schemaNames[getTypeIdentity(model)] = schemaName
+	}
 
-		if structNode == nil {
-			return nil, hasAnyErrorTypes, v.getFrozenError("could not find struct '%s' in package '%s'", model.Name, model.FullyQualifiedPackage)
-		}
-
-		err = typeVisitor.VisitStruct(model.FullyQualifiedPackage, model.Name, structNode)
-		if err != nil {
-			return nil, hasAnyErrorTypes, v.frozenError(err)
-		}
+	if err := v.ensureUniqueSchemaNames(typeVisitor); err != nil {
+		return nil, hasAnyErrorTypes, err
 	}
 
-	return typeVisitor.GetStructs(), hasAnyErrorTypes, nil
+	v.applySchemaNames(schemaNames)
+
+	flatModels := typeVisitor.GetStructs()
+	if err := v.applyBodyDefaults(flatModels); err != nil {
+		return nil, hasAnyErrorTypes, err
+	}
+	return flatModels, hasAnyErrorTypes, nil
 }
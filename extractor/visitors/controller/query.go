@@ -0,0 +1,129 @@
+package controller
+
+import (
+	"go/ast"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gopher-fleece/gleece/definitions"
+	"github.com/gopher-fleece/gleece/extractor"
+	"github.com/gopher-fleece/gleece/extractor/annotations"
+)
+
+// getQueryFields expands a struct-typed query parameter into the struct's fields, each bound to a query parameter of its own.
+// Fields are named in the query after their 'query' tag or, if absent, after the field itself.
+// Fields tagged 'query:"-"' and unexported fields are ignored
+func (v *ControllerVisitor) getQueryFields(param definitions.FuncParam) ([]definitions.FuncParam, error) {
+	v.enter("")
+	defer v.exit()
+
+	if param.TypeMeta.IsGenericInstance() {
+		return nil, v.getFrozenError(
+			"query parameter '%s' is of generic type '%s' which is not currently supported for query objects",
+			param.Name,
+			param.TypeMeta.Name,
+		)
+	}
+
+	pkg, err := v.getPackage(param.TypeMeta.FullyQualifiedPackage)
+	if err != nil {
+		return nil, v.frozenError(err)
+	}
+
+	file, structType := extractor.FindStructInPackage(pkg, param.TypeMeta.Name)
+	if structType == nil {
+		return nil, v.getFrozenError(
+			"could not find struct '%s' in package '%s'",
+			param.TypeMeta.Name,
+			param.TypeMeta.FullyQualifiedPackage,
+		)
+	}
+
+	queryFields := []definitions.FuncParam{}
+	for _, field := range structType.Fields.List {
+		if len(field.Names) == 0 {
+			return nil, v.getFrozenError(
+				"query object '%s' embeds '%s' but embedded fields are not currently supported for query objects",
+				param.TypeMeta.Name,
+				extractor.GetFieldTypeString(field.Type),
+			)
+		}
+
+		fieldMeta, err := extractor.GetFieldMetadata(file, pkg.Fset, v.packages, field)
+		if err != nil {
+			return nil, v.frozenError(err)
+		}
+		v.applyTypeMapping(&fieldMeta)
+
+		tag, err := getFieldTag(field)
+		if err != nil {
+			return nil, v.frozenError(err)
+		}
+
+		description, err := getFieldDescription(field)
+		if err != nil {
+			return nil, v.frozenError(err)
+		}
+
+		for _, name := range field.Names {
+			nameInSchema, _, _ := strings.Cut(tag.Get("query"), ",")
+			if !name.IsExported() || nameInSchema == "-" {
+				continue
+			}
+
+			if nameInSchema == "" {
+				nameInSchema = name.Name
+			}
+
+			queryField := definitions.FuncParam{
+				ParamMeta: definitions.ParamMeta{
+					// Prefixed by the parameter's name so variables in the generated routes do not collide with other parameters
+					Name:     param.Name + name.Name,
+					TypeMeta: fieldMeta,
+				},
+				PassedIn:           definitions.PassedInQuery,
+				NameInSchema:       nameInSchema,
+				Description:        description,
+				UniqueImportSerial: v.getNextImportId(),
+				// The validation itself is performed on the whole struct
+				Validator: tag.Get("validate"),
+				FieldName: name.Name,
+			}
+
+			if fieldMeta.IsSlice() {
+				queryField.Style = definitions.ParamStyleForm
+				queryField.Explode = true
+			}
+
+			queryFields = append(queryFields, queryField)
+		}
+	}
+
+	return queryFields, nil
+}
+
+func getFieldTag(field *ast.Field) (reflect.StructTag, error) {
+	if field.Tag == nil {
+		return "", nil
+	}
+
+	tag, err := strconv.Unquote(field.Tag.Value)
+	if err != nil {
+		return "", err
+	}
+	return reflect.StructTag(tag), nil
+}
+
+func getFieldDescription(field *ast.Field) (string, error) {
+	comments := extractor.GetFieldComments(field)
+	if len(comments) == 0 {
+		return "", nil
+	}
+
+	holder, err := annotations.NewAnnotationHolder(comments, annotations.CommentSourceProperty)
+	if err != nil {
+		return "", err
+	}
+	return holder.GetDescription(), nil
+}
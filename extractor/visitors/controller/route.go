@@ -9,7 +9,9 @@ import (
 	"github.com/gopher-fleece/gleece/definitions"
 	"github.com/gopher-fleece/gleece/extractor"
 	"github.com/gopher-fleece/gleece/extractor/annotations"
+	"github.com/gopher-fleece/gleece/extractor/visitors"
 	"github.com/gopher-fleece/gleece/infrastructure/logger"
+	"golang.org/x/tools/go/packages"
 )
 
 func (v *ControllerVisitor) visitMethod(funcDecl *ast.FuncDecl) (definitions.RouteMetadata, bool, error) {
@@ -64,18 +66,23 @@ func (v *ControllerVisitor) visitMethod(funcDecl *ast.FuncDecl) (definitions.Rou
 		return definitions.RouteMetadata{}, true, err
 	}
 
+	consumes, err := v.getContentTypesWithInheritance(attributes, annotations.AttributeConsumes)
+	if err != nil {
+		return definitions.RouteMetadata{}, true, v.frozenError(err)
+	}
+
 	meta := definitions.RouteMetadata{
-		OperationId:         funcDecl.Name.Name,
-		HttpVerb:            definitions.EnsureValidHttpVerb(methodAttr.Value),
-		Description:         attributes.GetDescription(),
-		Hiding:              v.getMethodHideOpts(&attributes),
-		Deprecation:         v.getDeprecationOpts(&attributes),
-		RestMetadata:        definitions.RestMetadata{Path: routePath},
-		ErrorResponses:      errorResponses,
-		RequestContentType:  definitions.ContentTypeJSON, // Hardcoded for now, should be supported via comments later
-		ResponseContentType: definitions.ContentTypeJSON, // Hardcoded for now, should be supported via comments later
-		Security:            security,
-		TemplateContext:     templateContext,
+		OperationId:        funcDecl.Name.Name,
+		HttpVerb:           definitions.EnsureValidHttpVerb(methodAttr.Value),
+		Description:        attributes.GetDescription(),
+		Hiding:             v.getMethodHideOpts(&attributes),
+		Deprecation:        v.getDeprecationOpts(&attributes),
+		RestMetadata:       definitions.RestMetadata{Path: routePath},
+		ErrorResponses:     errorResponses,
+		RequestContentType: consumes[0],
+		Consumes:           consumes,
+		Security:           security,
+		TemplateContext:    templateContext,
 	}
 
 	// Check whether the method is an API endpoint, i.e., has all the relevant metadata.
@@ -92,6 +99,10 @@ func (v *ControllerVisitor) visitMethod(funcDecl *ast.FuncDecl) (definitions.Rou
 	}
 	meta.FuncParams = funcParams
 
+	if err := v.applyFormContentType(&meta, attributes); err != nil {
+		return meta, true, err
+	}
+
 	// Set the function's return types
 	responses, err := v.getFuncReturnValue(funcDecl)
 	if err != nil {
@@ -100,6 +111,22 @@ func (v *ControllerVisitor) visitMethod(funcDecl *ast.FuncDecl) (definitions.Rou
 	meta.Responses = responses
 	meta.HasReturnValue = len(responses) > 1
 
+	// The produced media types depend on the returned value, as streamed values aren't serialized
+	produces, err := v.getResponseContentTypes(attributes, meta.GetValueReturnType())
+	if err != nil {
+		return meta, true, v.frozenError(err)
+	}
+	meta.Produces = produces
+	meta.ResponseContentType = produces[0]
+
+	if err := v.validateXmlContentTypes(meta); err != nil {
+		return meta, true, err
+	}
+
+	if err := v.validatePlainTextContentType(meta); err != nil {
+		return meta, true, err
+	}
+
 	successResponseCode, successDescription, err := v.getResponseStatusCodeAndDescription(&attributes, meta.HasReturnValue)
 	if err != nil {
 		return meta, true, v.frozenError(err)
@@ -122,6 +149,8 @@ func (v *ControllerVisitor) getValidatedFuncParams(funcDecl *ast.FuncDecl, comme
 		switch param.PassedIn {
 		case definitions.PassedInBody:
 			validationErr = v.validateBodyParam(param)
+		case definitions.PassedInFormFile:
+			validationErr = v.validateFormFileParam(param)
 		default:
 			validationErr = v.validatePrimitiveParam(param)
 		}
@@ -135,10 +164,16 @@ func (v *ControllerVisitor) getValidatedFuncParams(funcDecl *ast.FuncDecl, comme
 }
 
 func (v *ControllerVisitor) validateBodyParam(param definitions.FuncParam) error {
+	// Polymorphic interfaces are unmarshalled into the struct identified by their discriminator
+	if param.TypeMeta.EntityKind == definitions.AstNodeKindInterface && param.TypeMeta.IsPolymorphic() {
+		return nil
+	}
+
 	// Verify the body is a struct
 	if param.ParamMeta.TypeMeta.EntityKind != definitions.AstNodeKindStruct {
 		return v.getFrozenError(
-			"body parameters must be structs but '%s' (schema name '%s', type '%s') is of kind '%s'",
+			"body parameters must be structs or interfaces annotated with @OneOf "+
+				"but '%s' (schema name '%s', type '%s') is of kind '%s'",
 			param.Name,
 			param.NameInSchema,
 			param.TypeMeta.Name,
@@ -149,15 +184,42 @@ func (v *ControllerVisitor) validateBodyParam(param definitions.FuncParam) error
 	return nil
 }
 
+// validateFormFileParam verifies a form file parameter is bound to either a '*multipart.FileHeader' or a '[]*multipart.FileHeader'
+func (v *ControllerVisitor) validateFormFileParam(param definitions.FuncParam) error {
+	fileType := param.TypeMeta
+	if fileType.IsSlice() {
+		fileType = *fileType.ElementType
+	}
+
+	if !fileType.IsFormFile() || !fileType.IsByAddress {
+		return v.getFrozenError(
+			"form file parameters must be of type '*multipart.FileHeader' or '[]*multipart.FileHeader' but "+
+				"parameter '%s' (schema name '%s') is of type '%s'",
+			param.Name,
+			param.NameInSchema,
+			param.TypeMeta.Name,
+		)
+	}
+
+	return nil
+}
+
 func (v *ControllerVisitor) validatePrimitiveParam(param definitions.FuncParam) error {
-	// Currently, we're limited to primitive header, path and query parameters.
-	// This is a simple and silly check for those.
-	// need to fully integrate the EntityKind field..
-	isErrType := param.TypeMeta.FullyQualifiedPackage == "" && param.TypeMeta.Name == "error"
-	isMapType := param.TypeMeta.FullyQualifiedPackage == "" && strings.HasPrefix(param.TypeMeta.Name, "map[")
-	if !param.TypeMeta.IsUniverseType || isErrType || isMapType {
+	if err := v.validateParamDefault(param); err != nil {
+		return err
+	}
+
+	if param.TypeMeta.IsSlice() {
+		return v.validateSliceParam(param)
+	}
+
+	if len(param.QueryFields) > 0 {
+		return v.validateQueryObjectParam(param)
+	}
+
+	if !isPrimitiveType(param.TypeMeta) {
 		return v.getFrozenError(
-			"header, path and query parameters are currently limited to primitives only but "+
+			"header, path, query and cookie parameters are currently limited to primitives only but "+
 				"%s parameter '%s' (schema name '%s', type '%s') is of kind '%s'",
 			param.PassedIn,
 			param.Name,
@@ -170,6 +232,107 @@ func (v *ControllerVisitor) validatePrimitiveParam(param definitions.FuncParam)
 	return nil
 }
 
+func (v *ControllerVisitor) validateSliceParam(param definitions.FuncParam) error {
+	if param.PassedIn != definitions.PassedInQuery && param.PassedIn != definitions.PassedInHeader {
+		return v.getFrozenError(
+			"slices are only supported for header and query parameters but %s parameter '%s' (schema name '%s') is of type '%s'",
+			param.PassedIn,
+			param.Name,
+			param.NameInSchema,
+			param.TypeMeta.Name,
+		)
+	}
+
+	element := *param.TypeMeta.ElementType
+	if element.IsByAddress || !isPrimitiveType(element) {
+		return v.getFrozenError(
+			"slice parameters are currently limited to slices of primitives only but "+
+				"%s parameter '%s' (schema name '%s') is of type '%s'",
+			param.PassedIn,
+			param.Name,
+			param.NameInSchema,
+			param.TypeMeta.Name,
+		)
+	}
+
+	return nil
+}
+
+// fillPolymorphismInfo sets the discriminator and members of an interface annotated with '@OneOf' on the given metadata
+func (v *ControllerVisitor) fillPolymorphismInfo(typeMeta *definitions.TypeMetadata) error {
+	pkg, err := v.getPackage(typeMeta.FullyQualifiedPackage)
+	if err != nil {
+		return v.frozenError(err)
+	}
+
+	discriminator, members, err := visitors.GetPolymorphismInfo([]*packages.Package{pkg}, typeMeta.FullyQualifiedPackage, typeMeta.Name)
+	if err != nil {
+		return v.frozenError(err)
+	}
+
+	typeMeta.Discriminator = discriminator
+	typeMeta.OneOf = members
+	return nil
+}
+
+func (v *ControllerVisitor) validateQueryObjectParam(param definitions.FuncParam) error {
+	for _, field := range param.QueryFields {
+		if err := v.validatePrimitiveParam(field); err != nil {
+			return v.getFrozenError(
+				"field '%s' of query object '%s' cannot be bound to a query parameter - %v",
+				field.FieldName,
+				param.TypeMeta.Name,
+				err,
+			)
+		}
+	}
+
+	return nil
+}
+
+// isPrimitiveType returns whether the given type may be parsed from a single string value
+func isPrimitiveType(typeMeta definitions.TypeMetadata) bool {
+	// Currently, we're limited to primitive (enum or well-known type) header, path and query parameters.
+	// This is a simple and silly check for those.
+	// need to fully integrate the EntityKind field..
+	isErrType := typeMeta.FullyQualifiedPackage == "" && typeMeta.Name == "error"
+	isMapType := typeMeta.IsMap()
+	// Uploaded files are only ever bound to form file parameters and streams are only ever returned
+	isFileType := typeMeta.IsFormFile() || typeMeta.IsStream()
+	// User-mapped types can only be parsed if given a parse function
+	_, isBuiltInWellKnown := definitions.GetWellKnownType(typeMeta.FullName())
+	isParsableWellKnown := isBuiltInWellKnown || (typeMeta.Mapping != nil && typeMeta.Mapping.ParseFuncName != "")
+
+	isPrimitive := typeMeta.IsUniverseType || typeMeta.IsNamedPrimitive() || isParsableWellKnown
+	return isPrimitive && !isErrType && !isMapType && !isFileType
+}
+
+// isFileResponse returns whether the given type is a file response, i.e., a 'responses.FileResponse' (see TypeMetadata.IsFileResponse).
+// Pointers to file responses are file responses as well, so they may be reported as unsupported
+func isFileResponse(typeMeta definitions.TypeMetadata) bool {
+	return !typeMeta.IsSlice() && !typeMeta.IsChannel() && !typeMeta.IsMap() && typeMeta.FullName() == definitions.FileResponseTypeName
+}
+
+// isMapKeyType returns whether encoding/json serializes maps with keys of the given type as objects,
+// i.e., whether the type is a string or an integer, possibly named
+func isMapKeyType(typeMeta definitions.TypeMetadata) bool {
+	if typeMeta.IsByAddress {
+		return false
+	}
+
+	underlyingType := typeMeta.UnderlyingType
+	if typeMeta.IsUniverseType {
+		underlyingType = typeMeta.Name
+	}
+
+	switch underlyingType {
+	case "string", "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64", "uintptr":
+		return true
+	default:
+		return false
+	}
+}
+
 // This function is deprecated - no need to test here, all validation moved to the NewAnnotationHolder logic
 func (v *ControllerVisitor) validateParamsCombinations(funcParams []definitions.FuncParam, newParamType definitions.ParamPassedIn) error {
 
@@ -178,7 +341,7 @@ func (v *ControllerVisitor) validateParamsCombinations(funcParams []definitions.
 	})
 
 	isFormParamAlreadyExists := slices.ContainsFunc(funcParams, func(p definitions.FuncParam) bool {
-		return p.PassedIn == definitions.PassedInForm
+		return p.PassedIn == definitions.PassedInForm || p.PassedIn == definitions.PassedInFormFile
 	})
 
 	// Body is a special case, only one body parameter is allowed per route
@@ -192,7 +355,7 @@ func (v *ControllerVisitor) validateParamsCombinations(funcParams []definitions.
 	}
 
 	// Form is an implementation of url encoded string in the body, thus it cannot be used if the body is already in use
-	if newParamType == definitions.PassedInForm && isBodyParamAlreadyExists {
+	if (newParamType == definitions.PassedInForm || newParamType == definitions.PassedInFormFile) && isBodyParamAlreadyExists {
 		return v.getFrozenError("form parameter is invalid, using form is not allowed when a body is in use")
 	}
 	return nil
@@ -209,6 +372,10 @@ func (v *ControllerVisitor) getFuncParams(funcDecl *ast.FuncDecl, comments []str
 		return funcParams, err
 	}
 
+	for i := range paramTypes {
+		v.applyTypeMapping(&paramTypes[i].TypeMeta)
+	}
+
 	for _, param := range paramTypes {
 		// Record state for diagnostics
 		v.enter(fmt.Sprintf("Param %s", param.Name))
@@ -257,6 +424,10 @@ func (v *ControllerVisitor) getFuncParams(funcDecl *ast.FuncDecl, comments []str
 			paramPassedIn = definitions.PassedInBody
 		case "formfield": // Currently, form fields are the only supported form of form parameters, in the future, a full form object may be supported too
 			paramPassedIn = definitions.PassedInForm
+		case "formfile":
+			paramPassedIn = definitions.PassedInFormFile
+		case "cookie":
+			paramPassedIn = definitions.PassedInCookie
 		}
 
 		if err := v.validateParamsCombinations(funcParams, paramPassedIn); err != nil {
@@ -272,12 +443,119 @@ func (v *ControllerVisitor) getFuncParams(funcDecl *ast.FuncDecl, comments []str
 			UniqueImportSerial: v.getNextImportId(),
 		}
 
+		finalParamMeta.DefaultValue, err = v.getParamDefaultValue(paramAttrib)
+		if err != nil {
+			return funcParams, err
+		}
+
+		if paramPassedIn == definitions.PassedInFormFile {
+			finalParamMeta.MaxFileSize, finalParamMeta.AllowedMimeTypes, err = v.getFormFileRestrictions(paramAttrib)
+			if err != nil {
+				return funcParams, err
+			}
+		}
+
+		if paramPassedIn == definitions.PassedInBody && param.TypeMeta.EntityKind == definitions.AstNodeKindInterface {
+			if err := v.fillPolymorphismInfo(&finalParamMeta.TypeMeta); err != nil {
+				return funcParams, err
+			}
+		}
+
+		// Well-known and user-mapped structs are parsed as a single value rather than expanded into their fields
+		isQueryObject := paramPassedIn == definitions.PassedInQuery &&
+			param.TypeMeta.EntityKind == definitions.AstNodeKindStruct &&
+			!param.TypeMeta.IsWellKnownType()
+
+		if isQueryObject {
+			queryFields, err := v.getQueryFields(finalParamMeta)
+			if err != nil {
+				return funcParams, err
+			}
+			finalParamMeta.QueryFields = queryFields
+		}
+
+		if param.TypeMeta.IsSlice() {
+			style, explode, err := v.getSliceParamStyle(paramAttrib, paramPassedIn)
+			if err != nil {
+				return funcParams, err
+			}
+			finalParamMeta.Style = style
+			finalParamMeta.Explode = explode
+		}
+
 		funcParams = append(funcParams, finalParamMeta)
 	}
 
 	return funcParams, nil
 }
 
+// getFormFileRestrictions returns the maximum size and allowed MIME types of the files uploaded for a form file parameter,
+// as given by the 'maxSize' and 'mimeTypes' properties of its @FormFile annotation
+func (v *ControllerVisitor) getFormFileRestrictions(paramAttrib *annotations.Attribute) (int64, []string, error) {
+	castMaxSize, err := annotations.GetCastProperty[float64](paramAttrib, annotations.PropertyMaxSize)
+	if err != nil {
+		return 0, nil, v.frozenError(err)
+	}
+
+	castMimeTypes, err := annotations.GetCastProperty[[]string](paramAttrib, annotations.PropertyMimeTypes)
+	if err != nil {
+		return 0, nil, v.frozenError(err)
+	}
+
+	var maxSize int64
+	if castMaxSize != nil {
+		maxSize = int64(*castMaxSize)
+	}
+
+	mimeTypes := []string{}
+	if castMimeTypes != nil {
+		for _, mimeType := range *castMimeTypes {
+			mimeTypes = append(mimeTypes, strings.ToLower(mimeType))
+		}
+	}
+
+	return maxSize, mimeTypes, nil
+}
+
+// getSliceParamStyle returns the serialization style of a slice parameter.
+// Query parameters default to the exploded 'form' style (i.e., 'ids=1&ids=2') whilst headers are always comma-separated
+func (v *ControllerVisitor) getSliceParamStyle(
+	paramAttrib *annotations.Attribute,
+	passedIn definitions.ParamPassedIn,
+) (definitions.ParamStyle, bool, error) {
+	if passedIn != definitions.PassedInQuery {
+		return definitions.ParamStyleSimple, false, nil
+	}
+
+	castStyle, err := annotations.GetCastProperty[string](paramAttrib, annotations.PropertyStyle)
+	if err != nil {
+		return "", false, v.frozenError(err)
+	}
+
+	castExplode, err := annotations.GetCastProperty[bool](paramAttrib, annotations.PropertyExplode)
+	if err != nil {
+		return "", false, v.frozenError(err)
+	}
+
+	style := definitions.ParamStyleForm
+	if castStyle != nil && len(*castStyle) > 0 {
+		style = definitions.ParamStyle(*castStyle)
+	}
+
+	if style == definitions.ParamStylePipeDelimited {
+		if castExplode != nil && *castExplode {
+			return "", false, v.getFrozenError(
+				"query parameter '%s' uses the 'pipeDelimited' style which cannot be exploded",
+				paramAttrib.Value,
+			)
+		}
+		return style, false, nil
+	}
+
+	explode := castExplode == nil || *castExplode
+	return style, explode, nil
+}
+
 func (v *ControllerVisitor) getFuncReturnValue(funcDecl *ast.FuncDecl) ([]definitions.FuncReturnValue, error) {
 	v.enter("")
 	defer v.exit()
@@ -290,6 +568,11 @@ func (v *ControllerVisitor) getFuncReturnValue(funcDecl *ast.FuncDecl) ([]defini
 		return values, err
 	}
 
+	for i := range returnTypes {
+		v.applyTypeMapping(&returnTypes[i])
+		returnTypes[i].IsFileResponse = isFileResponse(returnTypes[i])
+	}
+
 	// Note that controller methods must return and error or (any, error)
 
 	switch len(returnTypes) {
@@ -324,6 +607,31 @@ func (v *ControllerVisitor) getFuncReturnValue(funcDecl *ast.FuncDecl) ([]defini
 	}
 
 	for _, value := range returnTypes {
+		if value.IsSlice() {
+			return values, v.getFrozenError("return type '%s' is not currently supported", value.Name)
+		}
+
+		if value.IsChannel() && (value.ElementType.IsChannel() || value.ElementType.IsStream()) {
+			return values, v.getFrozenError(
+				"return type '%s' is not supported - channels must carry JSON serializable values, which are sent as server-sent events",
+				value.Name,
+			)
+		}
+
+		if value.IsFileResponse && value.IsByAddress {
+			return values, v.getFrozenError(
+				"return type '%s' is not supported - file responses must be returned by value",
+				value.Name,
+			)
+		}
+
+		if value.IsMap() && !isMapKeyType(*value.KeyType) {
+			return values, v.getFrozenError(
+				"return type '%s' is not supported - map keys must be strings or integers, which are serialized as JSON object keys",
+				value.Name,
+			)
+		}
+
 		values = append(
 			values,
 			definitions.FuncReturnValue{
@@ -46,7 +46,11 @@ func NewControllerVisitor(config *definitions.GleeceConfig) (*ControllerVisitor,
 		globs = []string{"./*.go", "./**/*.go"}
 	}
 
-	return &visitor, visitor.init(globs)
+	if err := visitor.init(globs); err != nil {
+		return &visitor, err
+	}
+
+	return &visitor, visitor.validateTypeMappings()
 }
 
 func (v *ControllerVisitor) init(sourceFileGlobs []string) error {
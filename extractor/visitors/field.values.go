@@ -0,0 +1,452 @@
+package visitors
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"go/types"
+	"net"
+	"net/url"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gopher-fleece/gleece/definitions"
+	"github.com/gopher-fleece/gleece/extractor"
+	"github.com/gopher-fleece/gleece/extractor/annotations"
+)
+
+// valueSizes are the sizes of the basic types annotated values are parsed per, e.g. 8 bits for 'int8'
+var valueSizes = types.SizesFor("gc", "amd64")
+
+// uuidPattern matches the canonical textual representation of UUIDs, e.g. '5f0c6b1e-8d3a-4a1e-9a59-3f1a2b3c4d5e'
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// applyValueAnnotations sets the example and default values, the format, the pattern and the access mode of a field
+// as given by its annotations:
+//
+//	// @Example 42
+//	// @Default 10
+//	// @ReadOnly
+//	PageSize int `json:"pageSize"`
+func (v *TypeVisitor) applyValueAnnotations(
+	fieldMeta *definitions.FieldMetadata,
+	attributes annotations.AnnotationHolder,
+	fieldType types.Type,
+	tag string,
+) error {
+	if example := attributes.GetFirst(annotations.AttributeExample); example != nil {
+		value, err := v.parseFieldValue(example.Description, fieldType, tag)
+		if err != nil {
+			return fmt.Errorf("invalid @Example value '%s' - %v", example.Description, err)
+		}
+		fieldMeta.Example = value
+	}
+
+	if defaultAttr := attributes.GetFirst(annotations.AttributeDefault); defaultAttr != nil {
+		value, err := v.parseFieldValue(defaultAttr.Description, fieldType, tag)
+		if err != nil {
+			return fmt.Errorf("invalid @Default value '%s' - %v", defaultAttr.Description, err)
+		}
+		fieldMeta.Default = value
+	}
+
+	fieldMeta.Format = attributes.GetFirstDescriptionOrEmpty(annotations.AttributeFormat)
+	fieldMeta.Pattern = attributes.GetFirstDescriptionOrEmpty(annotations.AttributePattern)
+	fieldMeta.ReadOnly = attributes.Has(annotations.AttributeReadOnly)
+	fieldMeta.WriteOnly = attributes.Has(annotations.AttributeWriteOnly)
+	return nil
+}
+
+// parseFieldValue parses a value given for a field by an annotation into the value the field is serialized as.
+// Scalars (numbers, booleans, strings and well-known types serialized as strings, e.g. 'time.Time') are given as-is
+// and any other value is expected as JSON. Values are validated against the field's type, as the routes apply them to
+// request bodies, e.g. '300' is not a valid value for an 'int8' field and 'pending' is not a valid value for an enum
+// that does not declare it
+func (v *TypeVisitor) parseFieldValue(value string, fieldType types.Type, tag string) (any, error) {
+	fieldType = types.Unalias(fieldType)
+
+	if !v.isScalarValueType(fieldType) {
+		decoder := json.NewDecoder(strings.NewReader(value))
+		decoder.UseNumber()
+
+		var parsed any
+		if err := decoder.Decode(&parsed); err != nil {
+			return nil, fmt.Errorf("expected a JSON value - %v", err)
+		}
+		if decoder.More() {
+			return nil, fmt.Errorf("expected a single JSON value")
+		}
+		return v.checkJsonValue(parsed, fieldType, "")
+	}
+
+	parsed, err := v.parseScalarValue(value, fieldType)
+	if err != nil {
+		return nil, err
+	}
+	return applyStringOption(parsed, fieldType, tag), nil
+}
+
+// isScalarValueType returns whether values of the given type are serialized as JSON scalars that are given to annotations as-is
+func (v *TypeVisitor) isScalarValueType(valueType types.Type) bool {
+	if wellKnownType, isWellKnown := v.getWellKnownType(valueType); isWellKnown {
+		if wellKnownType.OpenApiType == "string" {
+			return true
+		}
+		// Well-known types serialized as JSON numbers (e.g. 'time.Duration') are scalars whilst others (e.g. 'json.RawMessage') are JSON
+		_, isBasic := valueType.Underlying().(*types.Basic)
+		return isBasic && wellKnownType.OpenApiType != ""
+	}
+
+	_, isBasic := valueType.Underlying().(*types.Basic)
+	return isBasic
+}
+
+// parseScalarValue parses the text of a scalar value (see isScalarValueType) per its type.
+// Numbers are parsed per the bit size of their type and values of enums must be one of the enum's values
+func (v *TypeVisitor) parseScalarValue(text string, valueType types.Type) (any, error) {
+	wellKnownType, isWellKnown := v.getWellKnownType(valueType)
+	if isWellKnown && wellKnownType.OpenApiType == "string" {
+		if err := validateStringFormat(text, getWellKnownTypeName(valueType), wellKnownType.OpenApiFormat); err != nil {
+			return nil, err
+		}
+		return text, nil
+	}
+
+	basic := valueType.Underlying().(*types.Basic)
+	parsed, err := parseBasicValue(text, basic)
+	if err != nil {
+		return nil, err
+	}
+
+	if named, isNamed := valueType.(*types.Named); isNamed && !isWellKnown && named.Obj().Pkg() != nil {
+		if _, enumValues := extractor.GetNamedPrimitiveInfo(named.Obj()); len(enumValues) > 0 && !slices.Contains(enumValues, fmt.Sprint(parsed)) {
+			return nil, fmt.Errorf("expected one of the values of enum '%s' (%s)", named.Obj().Name(), strings.Join(enumValues, ", "))
+		}
+	}
+
+	return parsed, nil
+}
+
+// parseBasicValue parses the text of a value of the given basic type, e.g. '42' for an 'int8'
+func parseBasicValue(text string, basic *types.Basic) (any, error) {
+	info := basic.Info()
+	bitSize := int(valueSizes.Sizeof(basic) * 8)
+
+	var parsed any
+	var err error
+	switch {
+	case info&types.IsBoolean != 0:
+		parsed, err = strconv.ParseBool(text)
+	case info&types.IsUnsigned != 0:
+		parsed, err = strconv.ParseUint(text, 10, bitSize)
+	case info&types.IsInteger != 0:
+		parsed, err = strconv.ParseInt(text, 10, bitSize)
+	case info&types.IsFloat != 0:
+		parsed, err = strconv.ParseFloat(text, bitSize)
+	case info&types.IsString != 0:
+		parsed = text
+	default:
+		return nil, fmt.Errorf("values of type '%s' are not supported", basic.Name())
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("expected a value of type '%s' - %v", basic.Name(), err)
+	}
+	return parsed, nil
+}
+
+// applyStringOption returns the given scalar value as serialized for a field tagged ',string',
+// i.e., as a JSON string holding the value's JSON representation. Values of other fields are returned as-is
+func applyStringOption(value any, valueType types.Type, tag string) any {
+	if !hasStringOption(valueType, tag) {
+		return value
+	}
+
+	serialized, _ := json.Marshal(value)
+	return string(serialized)
+}
+
+// hasStringOption returns whether a field of the given type and tag is serialized as a JSON string per the ',string' option.
+// As per encoding/json, the option only applies to fields of basic types
+func hasStringOption(valueType types.Type, tag string) bool {
+	if _, isBasic := valueType.Underlying().(*types.Basic); !isBasic {
+		return false
+	}
+
+	_, options, _ := strings.Cut(reflect.StructTag(tag).Get("json"), ",")
+	return slices.Contains(strings.Split(options, ","), "string")
+}
+
+// checkJsonValue validates a JSON value given for a field (decoded with json.Number numbers) against the value's type,
+// returning the value with its scalars parsed per their types.
+// The tag is that of the struct field holding the value, if any
+func (v *TypeVisitor) checkJsonValue(value any, valueType types.Type, tag string) (any, error) {
+	valueType = types.Unalias(valueType)
+
+	if pointer, isPointer := valueType.(*types.Pointer); isPointer {
+		if value == nil {
+			return nil, nil
+		}
+		return v.checkJsonValue(value, pointer.Elem(), tag)
+	}
+
+	if v.isScalarValueType(valueType) {
+		return v.checkJsonScalar(value, valueType, tag)
+	}
+
+	// Other well-known and mapped types are serialized per their own logic and are only checked by their OpenAPI type, if any
+	if wellKnownType, isWellKnown := v.getWellKnownType(valueType); isWellKnown {
+		if wellKnownType.OpenApiType != "" && !isJsonType(value, wellKnownType.OpenApiType) {
+			return nil, fmt.Errorf("expected a JSON %s, got %s", wellKnownType.OpenApiType, getJsonValueType(value))
+		}
+		return value, nil
+	}
+
+	switch typed := valueType.Underlying().(type) {
+	case *types.Interface:
+		// Interfaces may hold any value
+		return value, nil
+	case *types.Slice:
+		if value == nil {
+			return nil, nil
+		}
+		return v.checkJsonArray(value, typed.Elem())
+	case *types.Array:
+		return v.checkJsonArray(value, typed.Elem())
+	case *types.Map:
+		if value == nil {
+			return nil, nil
+		}
+		return v.checkJsonMap(value, typed)
+	case *types.Struct:
+		return v.checkJsonObject(value, typed)
+	default:
+		return nil, fmt.Errorf("values of type '%s' are not supported", valueType.String())
+	}
+}
+
+// checkJsonScalar validates a JSON scalar given for a value of a scalar type (see isScalarValueType) and parses it per the type
+func (v *TypeVisitor) checkJsonScalar(value any, valueType types.Type, tag string) (any, error) {
+	stringified := hasStringOption(valueType, tag)
+	expectedType := "string"
+	if wellKnownType, isWellKnown := v.getWellKnownType(valueType); !stringified && (!isWellKnown || wellKnownType.OpenApiType != "string") {
+		expectedType = getBasicJsonType(valueType.Underlying().(*types.Basic))
+	}
+
+	if !isJsonType(value, expectedType) {
+		return nil, fmt.Errorf("expected a JSON %s, got %s", expectedType, getJsonValueType(value))
+	}
+
+	text := fmt.Sprint(value)
+	if stringified {
+		// The string holds the value's own JSON representation
+		var inner any
+		decoder := json.NewDecoder(strings.NewReader(text))
+		decoder.UseNumber()
+		if err := decoder.Decode(&inner); err != nil {
+			return nil, fmt.Errorf("expected a JSON value in a ',string' field - %v", err)
+		}
+		text = fmt.Sprint(inner)
+	}
+
+	parsed, err := v.parseScalarValue(text, valueType)
+	if err != nil {
+		return nil, err
+	}
+	return applyStringOption(parsed, valueType, tag), nil
+}
+
+// checkJsonArray validates a JSON array given for a slice or an array against the type of its elements
+func (v *TypeVisitor) checkJsonArray(value any, elemType types.Type) (any, error) {
+	items, isArray := value.([]any)
+	if !isArray {
+		return nil, fmt.Errorf("expected a JSON array, got %s", getJsonValueType(value))
+	}
+
+	checked := make([]any, len(items))
+	for i, item := range items {
+		checkedItem, err := v.checkJsonValue(item, elemType, "")
+		if err != nil {
+			return nil, fmt.Errorf("item %d - %v", i, err)
+		}
+		checked[i] = checkedItem
+	}
+	return checked, nil
+}
+
+// checkJsonMap validates a JSON object given for a map against the types of the map's keys and values
+func (v *TypeVisitor) checkJsonMap(value any, mapType *types.Map) (any, error) {
+	entries, isObject := value.(map[string]any)
+	if !isObject {
+		return nil, fmt.Errorf("expected a JSON object, got %s", getJsonValueType(value))
+	}
+
+	keyType := types.Unalias(mapType.Key())
+	checked := make(map[string]any, len(entries))
+	for key, entry := range entries {
+		if v.isScalarValueType(keyType) {
+			if _, err := v.parseScalarValue(key, keyType); err != nil {
+				return nil, fmt.Errorf("key '%s' - %v", key, err)
+			}
+		}
+
+		checkedEntry, err := v.checkJsonValue(entry, mapType.Elem(), "")
+		if err != nil {
+			return nil, fmt.Errorf("key '%s' - %v", key, err)
+		}
+		checked[key] = checkedEntry
+	}
+	return checked, nil
+}
+
+// checkJsonObject validates a JSON object given for a struct against the types of the struct's fields, by their JSON names
+func (v *TypeVisitor) checkJsonObject(value any, structType *types.Struct) (any, error) {
+	properties, isObject := value.(map[string]any)
+	if !isObject {
+		return nil, fmt.Errorf("expected a JSON object, got %s", getJsonValueType(value))
+	}
+
+	fields := map[string]*types.Var{}
+	tags := map[string]string{}
+	collectJsonFields(structType, fields, tags)
+
+	checked := make(map[string]any, len(properties))
+	for name, property := range properties {
+		field, exists := fields[name]
+		if !exists {
+			return nil, fmt.Errorf("unknown property '%s'", name)
+		}
+
+		checkedProperty, err := v.checkJsonValue(property, field.Type(), tags[name])
+		if err != nil {
+			return nil, fmt.Errorf("property '%s' - %v", name, err)
+		}
+		checked[name] = checkedProperty
+	}
+	return checked, nil
+}
+
+// collectJsonFields adds the serialized fields of the given struct, including those promoted from embedded structs,
+// to the given maps, keyed by their JSON names
+func collectJsonFields(structType *types.Struct, fields map[string]*types.Var, tags map[string]string) {
+	for i := 0; i < structType.NumFields(); i++ {
+		field := structType.Field(i)
+		tag := structType.Tag(i)
+
+		jsonName, hasJsonName := GetJsonName(field.Name(), tag)
+		if jsonName == "-" {
+			continue
+		}
+
+		if field.Embedded() && !hasJsonName {
+			embeddedType := types.Unalias(field.Type())
+			if pointer, isPointer := embeddedType.(*types.Pointer); isPointer {
+				embeddedType = types.Unalias(pointer.Elem())
+			}
+			if embeddedStruct, isStruct := embeddedType.Underlying().(*types.Struct); isStruct {
+				collectJsonFields(embeddedStruct, fields, tags)
+				continue
+			}
+		}
+
+		if !field.Exported() {
+			continue
+		}
+		fields[jsonName] = field
+		tags[jsonName] = tag
+	}
+}
+
+// getWellKnownType returns the OpenAPI representation of the given type, if it's either user-mapped or a built-in well-known type
+func (v *TypeVisitor) getWellKnownType(valueType types.Type) (definitions.WellKnownType, bool) {
+	if typeName := getWellKnownTypeName(valueType); typeName != "" {
+		return v.typeMappings.GetWellKnownType(typeName)
+	}
+	return definitions.WellKnownType{}, false
+}
+
+// getWellKnownTypeName returns the name by which the given type is listed as a well-known type, e.g. 'time.Time' or '[]byte'.
+// Empty for types that may not be well-known
+func getWellKnownTypeName(valueType types.Type) string {
+	switch typed := valueType.(type) {
+	case *types.Named:
+		if typed.Obj().Pkg() != nil {
+			return fmt.Sprintf("%s.%s", typed.Obj().Pkg().Path(), typed.Obj().Name())
+		}
+	case *types.Slice:
+		return typed.String()
+	}
+	return ""
+}
+
+// validateStringFormat validates a value given for a well-known type serialized as a string, per the type's OpenAPI format
+func validateStringFormat(value string, typeName string, format string) error {
+	var err error
+	switch {
+	case format == "date-time":
+		_, err = time.Parse(time.RFC3339, value)
+	case format == "date":
+		_, err = time.Parse(time.DateOnly, value)
+	case format == "uuid":
+		if !uuidPattern.MatchString(value) {
+			err = fmt.Errorf("not a UUID")
+		}
+	case format == "uri":
+		_, err = url.Parse(value)
+	case format == "byte":
+		_, err = base64.StdEncoding.DecodeString(value)
+	case typeName == "net.IP":
+		if net.ParseIP(value) == nil {
+			err = fmt.Errorf("not an IP address")
+		}
+	}
+
+	if err != nil {
+		return fmt.Errorf("expected a value of type '%s' - %v", typeName, err)
+	}
+	return nil
+}
+
+// getBasicJsonType returns the type of the JSON values of the given basic type
+func getBasicJsonType(basic *types.Basic) string {
+	switch info := basic.Info(); {
+	case info&types.IsBoolean != 0:
+		return "boolean"
+	case info&types.IsNumeric != 0:
+		return "number"
+	default:
+		return "string"
+	}
+}
+
+// getJsonValueType returns the type of a decoded JSON value, as named by OpenAPI.
+// Numbers are expected to be decoded as json.Number
+func getJsonValueType(value any) string {
+	switch typed := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case json.Number:
+		if _, err := typed.Int64(); err == nil {
+			return "integer"
+		}
+		return "number"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	default:
+		return "object"
+	}
+}
+
+// isJsonType returns whether a decoded JSON value is of the given OpenAPI type. Integers are numbers as well
+func isJsonType(value any, openApiType string) bool {
+	valueType := getJsonValueType(value)
+	return valueType == openApiType || (valueType == "integer" && openApiType == "number")
+}
@@ -0,0 +1,178 @@
+package visitors
+
+import (
+	"fmt"
+	"go/types"
+	"slices"
+
+	"github.com/gopher-fleece/gleece/definitions"
+	"github.com/gopher-fleece/gleece/extractor"
+	"github.com/gopher-fleece/gleece/extractor/annotations"
+	"golang.org/x/tools/go/packages"
+)
+
+// GetPolymorphismInfo returns the discriminator property and the members of a polymorphic interface,
+// i.e., an interface annotated with '@Discriminator' and '@OneOf':
+//
+//	// @Discriminator(kind)
+//	// @OneOf(Cat, { value: "cat" })
+//	// @OneOf(Dog, { value: "dog" })
+//	type Animal interface { ... }
+//
+// Members are structs declared in the interface's package which implement it, either directly or via a pointer.
+// A member is identified by the given discriminator value or, in its absence, by the name of its struct.
+//
+// For interfaces without '@OneOf' annotations, an empty discriminator and a nil slice are returned
+func GetPolymorphismInfo(
+	pkgs []*packages.Package,
+	fullPackageName string,
+	interfaceName string,
+) (string, []definitions.OneOfMember, error) {
+	pkg := extractor.FilterPackageByFullName(pkgs, fullPackageName)
+	if pkg == nil {
+		return "", nil, fmt.Errorf(
+			"could not find package object for '%s' whilst looking for interface '%s'",
+			fullPackageName,
+			interfaceName,
+		)
+	}
+
+	genDecl := extractor.FindGenDeclByName(pkg, interfaceName)
+	if genDecl == nil || genDecl.Doc == nil {
+		return "", nil, nil
+	}
+
+	holder, err := annotations.NewAnnotationHolder(extractor.MapDocListToStrings(genDecl.Doc.List), annotations.CommentSourceSchema)
+	if err != nil {
+		return "", nil, err
+	}
+
+	oneOfAttributes := holder.GetAll(annotations.AttributeOneOf)
+	if len(oneOfAttributes) == 0 {
+		return "", nil, nil
+	}
+
+	discriminator := holder.GetFirstValueOrEmpty(annotations.AttributeDiscriminator)
+	if discriminator == "" {
+		return "", nil, fmt.Errorf("interface '%s' has @OneOf annotations but no @Discriminator", interfaceName)
+	}
+
+	typeName, err := extractor.LookupTypeName(pkg, interfaceName)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if typeName == nil {
+		return "", nil, fmt.Errorf("could not find type '%s' in package '%s'", interfaceName, fullPackageName)
+	}
+
+	iface, isInterface := typeName.Type().Underlying().(*types.Interface)
+	if !isInterface {
+		return "", nil, fmt.Errorf("@OneOf annotations are only supported on interfaces but '%s' is not an interface", interfaceName)
+	}
+
+	members := []definitions.OneOfMember{}
+	for _, attribute := range oneOfAttributes {
+		member, err := getOneOfMember(pkg, interfaceName, iface, discriminator, attribute)
+		if err != nil {
+			return "", nil, err
+		}
+
+		isDuplicate := slices.ContainsFunc(members, func(existing definitions.OneOfMember) bool {
+			return existing.DiscriminatorValue == member.DiscriminatorValue
+		})
+		if isDuplicate {
+			return "", nil, fmt.Errorf(
+				"discriminator value '%s' is used by more than one @OneOf member of interface '%s'",
+				member.DiscriminatorValue,
+				interfaceName,
+			)
+		}
+
+		members = append(members, member)
+	}
+
+	return discriminator, members, nil
+}
+
+// getOneOfMember resolves the struct named by a '@OneOf' annotation and verifies it may be held by the interface
+func getOneOfMember(
+	pkg *packages.Package,
+	interfaceName string,
+	iface *types.Interface,
+	discriminator string,
+	attribute *annotations.Attribute,
+) (definitions.OneOfMember, error) {
+	member := definitions.OneOfMember{Name: attribute.Value, DiscriminatorValue: attribute.Value}
+	if value, isString := attribute.Properties[annotations.PropertyValue].(string); isString && value != "" {
+		member.DiscriminatorValue = value
+	}
+
+	typeName, err := extractor.LookupTypeName(pkg, attribute.Value)
+	if err != nil {
+		return member, err
+	}
+
+	if typeName == nil {
+		return member, fmt.Errorf(
+			"@OneOf member '%s' of interface '%s' is not a type in package '%s'",
+			attribute.Value,
+			interfaceName,
+			pkg.PkgPath,
+		)
+	}
+
+	named, isNamed := typeName.Type().(*types.Named)
+	structType, isStruct := typeName.Type().Underlying().(*types.Struct)
+	if !isNamed || !isStruct || named.TypeParams().Len() > 0 {
+		return member, fmt.Errorf("@OneOf member '%s' of interface '%s' must be a non-generic struct", attribute.Value, interfaceName)
+	}
+
+	switch {
+	case types.Implements(named, iface):
+	case types.Implements(types.NewPointer(named), iface):
+		member.IsByAddress = true
+	default:
+		return member, fmt.Errorf("@OneOf member '%s' does not implement interface '%s'", attribute.Value, interfaceName)
+	}
+
+	if !hasJsonField(structType, discriminator, map[*types.Struct]bool{}) {
+		return member, fmt.Errorf(
+			"@OneOf member '%s' of interface '%s' has no '%s' property to be discriminated by",
+			attribute.Value,
+			interfaceName,
+			discriminator,
+		)
+	}
+
+	return member, nil
+}
+
+// hasJsonField returns whether the given struct has a field serialized under the given JSON name,
+// including fields promoted from embedded structs
+func hasJsonField(structType *types.Struct, jsonName string, visited map[*types.Struct]bool) bool {
+	visited[structType] = true
+	for i := 0; i < structType.NumFields(); i++ {
+		field := structType.Field(i)
+		if !isSerializedField(field, structType.Tag(i)) {
+			continue
+		}
+
+		fieldName, isTagged := GetJsonName(field.Name(), structType.Tag(i))
+
+		if field.Embedded() && !isTagged {
+			if _, embeddedStruct, _ := getEmbeddedStruct(field.Type()); embeddedStruct != nil {
+				if !visited[embeddedStruct] && hasJsonField(embeddedStruct, jsonName, visited) {
+					return true
+				}
+				continue
+			}
+		}
+
+		if fieldName == jsonName {
+			return true
+		}
+	}
+
+	return false
+}
@@ -2,7 +2,9 @@ package visitors
 
 import (
 	"fmt"
+	"go/ast"
 	"go/types"
+	"reflect"
 	"strings"
 
 	"github.com/gopher-fleece/gleece/definitions"
@@ -25,43 +27,279 @@ type StructInfo struct {
 }
 
 type TypeVisitor struct {
-	packages    []*packages.Package
-	typesByName map[string]*definitions.ModelMetadata
+	packages             []*packages.Package
+	typesByName          map[string]*definitions.ModelMetadata
+	embeddedStructsMode  definitions.EmbeddedStructsMode
+	schemaNamingStrategy definitions.SchemaNamingStrategy
+	namedPrimitivesMode  definitions.NamedPrimitivesMode
+	typeMappings         definitions.TypeMappings
 }
 
 type StructAttributeHolders struct {
 	StructHolder annotations.AnnotationHolder
 	FieldHolders map[string]*annotations.AnnotationHolder
+	// The struct's declaration, in which the declarations of anonymous struct fields are looked up
+	StructNode *ast.StructType
 }
 
-func NewTypeVisitor(packages []*packages.Package) *TypeVisitor {
+// modelOwner identifies the model whose fields are being collected.
+// Anonymous struct fields are models of their own, named after the model that owns them
+type modelOwner struct {
+	fullName        string
+	fullPackageName string
+	modelName       string
+}
+
+// structField is a model field alongside the information required to resolve conflicts
+// between fields promoted from embedded structs
+type structField struct {
+	meta     definitions.FieldMetadata
+	jsonName string
+	isTagged bool
+	depth    int
+}
+
+func NewTypeVisitor(
+	packages []*packages.Package,
+	embeddedStructsMode definitions.EmbeddedStructsMode,
+	schemaNamingStrategy definitions.SchemaNamingStrategy,
+	namedPrimitivesMode definitions.NamedPrimitivesMode,
+	typeMappings definitions.TypeMappings,
+) *TypeVisitor {
+	if embeddedStructsMode == "" {
+		embeddedStructsMode = definitions.EmbeddedStructsModeFlatten
+	}
+
+	if schemaNamingStrategy == "" {
+		schemaNamingStrategy = definitions.SchemaNamingStrategyShort
+	}
+
+	if namedPrimitivesMode == "" {
+		namedPrimitivesMode = definitions.NamedPrimitivesModeInline
+	}
+
 	return &TypeVisitor{
-		packages:    packages,
-		typesByName: make(map[string]*definitions.ModelMetadata),
+		packages:             packages,
+		typesByName:          make(map[string]*definitions.ModelMetadata),
+		embeddedStructsMode:  embeddedStructsMode,
+		schemaNamingStrategy: schemaNamingStrategy,
+		namedPrimitivesMode:  namedPrimitivesMode,
+		typeMappings:         typeMappings,
 	}
 }
 
+// VisitStruct records a struct model alongside the models its fields refer to.
+// As structs are commonly shared by multiple routes and structs, visiting the same struct more than once is a no-op
 func (v *TypeVisitor) VisitStruct(fullPackageName string, structName string, structType *types.Struct) error {
 	fullName := fmt.Sprintf("%s.%s", fullPackageName, structName)
 	if v.typesByName[fullName] != nil {
-		return fmt.Errorf("struct %q has already been processed", fullName)
+		return nil
+	}
+
+	modelName, err := v.GetSchemaName(fullPackageName, structName)
+	if err != nil {
+		return err
+	}
+
+	return v.visitStruct(fullName, fullPackageName, structName, modelName, structType)
+}
+
+// VisitGenericInstance records an instantiated generic struct, e.g. 'Page[User]', as a model of its own.
+// The model is named after the generic struct and its type arguments, e.g. 'PageOfUser'.
+// As instantiations are commonly shared by multiple routes, visiting the same instantiation more than once is a no-op
+func (v *TypeVisitor) VisitGenericInstance(named *types.Named) error {
+	structType, isStruct := named.Underlying().(*types.Struct)
+	if !isStruct {
+		return fmt.Errorf("generic type %q is not a struct", named.String())
+	}
+
+	if v.typesByName[named.String()] != nil {
+		return nil
+	}
+
+	modelName, err := v.GetGenericInstanceSchemaName(named)
+	if err != nil {
+		return err
+	}
+
+	return v.visitStruct(named.String(), named.Obj().Pkg().Path(), named.Obj().Name(), modelName, structType)
+}
+
+// VisitInterface records a polymorphic interface model alongside the models of the structs the interface may hold.
+// Only interfaces annotated with '@OneOf' may be described.
+// As interfaces are commonly shared by multiple structs, visiting the same interface more than once is a no-op
+func (v *TypeVisitor) VisitInterface(fullPackageName string, interfaceName string) error {
+	fullName := fmt.Sprintf("%s.%s", fullPackageName, interfaceName)
+	if v.typesByName[fullName] != nil {
+		return nil
+	}
+
+	discriminator, members, err := GetPolymorphismInfo(v.packages, fullPackageName, interfaceName)
+	if err != nil {
+		return err
+	}
+
+	if len(members) == 0 {
+		return fmt.Errorf(
+			"interface %q cannot be described as it is not annotated with @OneOf and @Discriminator",
+			fullName,
+		)
+	}
+
+	modelName, err := v.GetSchemaName(fullPackageName, interfaceName)
+	if err != nil {
+		return err
+	}
+
+	interfaceInfo := definitions.ModelMetadata{
+		Name:                  modelName,
+		FullyQualifiedPackage: fullPackageName,
+		Discriminator:         discriminator,
+	}
+
+	relevantPackage := extractor.FilterPackageByFullName(v.packages, fullPackageName)
+	genDecl := extractor.FindGenDeclByName(relevantPackage, interfaceName)
+	attributes, err := annotations.NewAnnotationHolder(extractor.MapDocListToStrings(genDecl.Doc.List), annotations.CommentSourceSchema)
+	if err != nil {
+		return err
+	}
+	interfaceInfo.Description = attributes.GetDescription()
+	interfaceInfo.Deprecation = getDeprecationOpts(attributes)
+
+	// Recorded ahead of its members, which may refer back to the interface
+	v.typesByName[fullName] = &interfaceInfo
+
+	for _, member := range members {
+		memberFullName := fmt.Sprintf("%s.%s", fullPackageName, member.Name)
+		if v.typesByName[memberFullName] == nil {
+			typeName, err := extractor.LookupTypeName(relevantPackage, member.Name)
+			if err != nil {
+				return err
+			}
+
+			if err := v.VisitStruct(fullPackageName, member.Name, typeName.Type().Underlying().(*types.Struct)); err != nil {
+				return err
+			}
+		}
+
+		member.SchemaName = v.typesByName[memberFullName].Name
+		interfaceInfo.OneOf = append(interfaceInfo.OneOf, member)
+	}
+
+	return nil
+}
+
+// GetSchemaName returns the name of the given type's schema, as determined by the type's '@Name' annotation
+// or, in its absence, the schema naming strategy
+func (v *TypeVisitor) GetSchemaName(fullPackageName string, typeName string) (string, error) {
+	relevantPackage := extractor.FilterPackageByFullName(v.packages, fullPackageName)
+	if relevantPackage == nil {
+		return "", fmt.Errorf("could not find package object for '%s' whilst naming type '%s'", fullPackageName, typeName)
+	}
+
+	genDecl := extractor.FindGenDeclByName(relevantPackage, typeName)
+	if genDecl != nil && genDecl.Doc != nil && len(genDecl.Doc.List) > 0 {
+		attributes, err := annotations.NewAnnotationHolder(extractor.MapDocListToStrings(genDecl.Doc.List), annotations.CommentSourceSchema)
+		if err != nil {
+			return "", err
+		}
+
+		if explicitName := attributes.GetFirstValueOrEmpty(annotations.AttributeName); explicitName != "" {
+			return explicitName, nil
+		}
 	}
 
+	if v.schemaNamingStrategy == definitions.SchemaNamingStrategyPackageQualified {
+		return fmt.Sprintf("%s.%s", relevantPackage.Name, typeName), nil
+	}
+
+	return typeName, nil
+}
+
+// GetGenericInstanceSchemaName returns the schema name of an instantiated generic type, e.g. 'PageOfUser' for 'Page[User]'
+func (v *TypeVisitor) GetGenericInstanceSchemaName(named *types.Named) (string, error) {
+	baseName, err := v.GetSchemaName(named.Obj().Pkg().Path(), named.Obj().Name())
+	if err != nil {
+		return "", err
+	}
+
+	argNames := make([]string, named.TypeArgs().Len())
+	for i := range argNames {
+		argNames[i], err = v.getTypeArgName(named.TypeArgs().At(i))
+		if err != nil {
+			return "", fmt.Errorf("could not name instantiated generic type '%s' - %v", named.Obj().Name(), err)
+		}
+	}
+
+	return definitions.GetGenericSchemaName(baseName, argNames), nil
+}
+
+// visitStruct records a struct model named 'modelName' under the given key.
+// The model's annotations are taken from the declaration of 'structName', which differs from the model name
+// for instantiated generic structs
+func (v *TypeVisitor) visitStruct(
+	fullName string,
+	fullPackageName string,
+	structName string,
+	modelName string,
+	structType *types.Struct,
+) error {
 	attributeHolders, err := v.getAttributeHolders(fullPackageName, structName)
 	if err != nil {
 		return err
 	}
 
+	return v.recordStruct(fullName, fullPackageName, structName, modelName, structType, attributeHolders)
+}
+
+// recordStruct records a struct model with the given attributes under the given key.
+// The model is recorded ahead of its fields so that recursive references, e.g. 'Children []TreeNode', refer back to it
+func (v *TypeVisitor) recordStruct(
+	fullName string,
+	fullPackageName string,
+	structName string,
+	modelName string,
+	structType *types.Struct,
+	attributeHolders StructAttributeHolders,
+) error {
 	structInfo := definitions.ModelMetadata{
-		Name:                  structName,
+		Name:                  modelName,
 		FullyQualifiedPackage: fullPackageName,
 		Description:           attributeHolders.StructHolder.GetDescription(),
 		Deprecation:           getDeprecationOpts(attributeHolders.StructHolder),
+		XmlName:               getXmlName(structType),
 	}
 
+	v.typesByName[fullName] = &structInfo
+
+	owner := modelOwner{fullName: fullName, fullPackageName: fullPackageName, modelName: modelName}
+	fields, embeddedModels, err := v.collectFields(owner, structName, structType, attributeHolders, 0, map[string]bool{fullName: true})
+	if err != nil {
+		return err
+	}
+
+	structInfo.Fields = resolveFieldConflicts(fields)
+	structInfo.EmbeddedModels = embeddedModels
+	return nil
+}
+
+// collectFields returns the fields of the given struct, including those promoted from embedded structs.
+// When embedded structs are composed via 'allOf', the names of the struct's embedded models are returned as well.
+//
+// Like encoding/json, an embedded struct with a JSON name in its tag is treated as a regular field
+func (v *TypeVisitor) collectFields(
+	owner modelOwner,
+	structName string,
+	structType *types.Struct,
+	attributeHolders StructAttributeHolders,
+	depth int,
+	visitedEmbeds map[string]bool,
+) ([]structField, []string, error) {
+	fields := []structField{}
+	embeddedModels := []string{}
+
 	for i := 0; i < structType.NumFields(); i++ {
 		field := structType.Field(i)
-		fieldType := field.Type()
 		tag := structType.Tag(i)
 
 		// Skip embedded error fields.
@@ -69,46 +307,348 @@ func (v *TypeVisitor) VisitStruct(fullPackageName string, structName string, str
 			continue
 		}
 
-		var fieldTypeString string
+		if !isSerializedField(field, tag) || isXmlNameField(field) {
+			continue
+		}
 
-		switch t := fieldType.(type) {
-		case *types.Pointer:
-			// Raise error for pointer fields.
-			return fmt.Errorf("field %q in struct %q is a pointer, which is not allowed", field.Name(), structName)
-		case *types.Named:
-			// Check if the named type is a struct.
-			if underlying, ok := t.Underlying().(*types.Struct); ok {
-				// Recursively process the nested struct.
-				err := v.VisitStruct(fullPackageName, t.Obj().Name(), underlying)
+		jsonName, isTagged := GetJsonName(field.Name(), tag)
+
+		if field.Embedded() && !isTagged {
+			embeddedType, embeddedStruct, isByAddress := getEmbeddedStruct(field.Type())
+			if embeddedStruct != nil {
+				// Pointers to unexported structs cannot be populated by encoding/json and are ignored
+				if isByAddress && !embeddedType.Obj().Exported() {
+					continue
+				}
+
+				promotedFields, embeddedModel, err := v.visitEmbeddedStruct(owner, embeddedType, embeddedStruct, depth, visitedEmbeds)
 				if err != nil {
-					return err
+					return nil, nil, err
+				}
+
+				fields = append(fields, promotedFields...)
+				if embeddedModel != "" {
+					embeddedModels = append(embeddedModels, embeddedModel)
 				}
+				continue
 			}
+		}
 
-			// Add the field as a reference to another struct.
-			fieldTypeString = t.Obj().Name()
-		default:
-			// Primitive field
-			fieldTypeString = fieldType.String()
+		fieldMeta, err := v.getFieldMeta(owner, structName, field, tag, attributeHolders)
+		if err != nil {
+			return nil, nil, err
 		}
 
-		fieldMeta := definitions.FieldMetadata{
-			Name: field.Name(),
-			Type: fieldTypeString,
-			Tag:  tag,
+		fields = append(fields, structField{
+			meta:     fieldMeta,
+			jsonName: jsonName,
+			isTagged: isTagged,
+			depth:    depth,
+		})
+	}
+
+	return fields, embeddedModels, nil
+}
+
+// visitEmbeddedStruct handles a struct embedded at the given depth.
+// In 'flatten' mode, the embedded struct's fields are returned so they may be promoted.
+// In 'allOf' mode, top-level embedded structs are visited as models of their own and their name is returned instead
+func (v *TypeVisitor) visitEmbeddedStruct(
+	owner modelOwner,
+	embeddedType *types.Named,
+	embeddedStruct *types.Struct,
+	depth int,
+	visitedEmbeds map[string]bool,
+) ([]structField, string, error) {
+	embeddedPackage := embeddedType.Obj().Pkg().Path()
+	embeddedName := embeddedType.Obj().Name()
+	embeddedFullName := fmt.Sprintf("%s.%s", embeddedPackage, embeddedName)
+
+	if v.embeddedStructsMode == definitions.EmbeddedStructsModeAllOf && depth == 0 {
+		if embeddedType.TypeArgs().Len() > 0 {
+			if err := v.VisitGenericInstance(embeddedType); err != nil {
+				return nil, "", err
+			}
+			return nil, v.typesByName[embeddedType.String()].Name, nil
 		}
 
-		fieldAttr := attributeHolders.FieldHolders[field.Name()]
-		if fieldAttr != nil {
-			fieldMeta.Description = fieldAttr.GetDescription()
-			deprecationOpts := getDeprecationOpts(*fieldAttr)
-			fieldMeta.Deprecation = &deprecationOpts
+		// Embedded models are commonly shared by multiple structs
+		if v.typesByName[embeddedFullName] == nil {
+			if err := v.VisitStruct(embeddedPackage, embeddedName, embeddedStruct); err != nil {
+				return nil, "", err
+			}
 		}
+		return nil, v.typesByName[embeddedFullName].Name, nil
+	}
 
-		structInfo.Fields = append(structInfo.Fields, fieldMeta)
+	// An embedding cycle is only possible via pointers; the repeated fields would be hidden by their shallower counterparts anyway
+	if visitedEmbeds[embeddedFullName] {
+		return nil, "", nil
 	}
 
-	v.typesByName[fullName] = &structInfo
+	attributeHolders, err := v.getAttributeHolders(embeddedPackage, embeddedName)
+	if err != nil {
+		return nil, "", err
+	}
+
+	visitedEmbeds[embeddedFullName] = true
+	promotedFields, _, err := v.collectFields(owner, embeddedName, embeddedStruct, attributeHolders, depth+1, visitedEmbeds)
+	delete(visitedEmbeds, embeddedFullName)
+
+	return promotedFields, "", err
+}
+
+func (v *TypeVisitor) getFieldMeta(
+	owner modelOwner,
+	structName string,
+	field *types.Var,
+	tag string,
+	attributeHolders StructAttributeHolders,
+) (definitions.FieldMetadata, error) {
+	fieldType := field.Type()
+
+	// Pointer fields are nullable and are otherwise treated as their element type
+	isByAddress := false
+	if pointer, isPointer := fieldType.(*types.Pointer); isPointer {
+		fieldType = pointer.Elem()
+		isByAddress = true
+	}
+
+	// Raise error for multi-level pointer fields.
+	if _, isPointer := fieldType.(*types.Pointer); isPointer {
+		return definitions.FieldMetadata{}, fmt.Errorf(
+			"field %q in struct %q is a pointer to a pointer, which is not allowed",
+			field.Name(),
+			structName,
+		)
+	}
+
+	var anonymousStructNode *ast.StructType
+	if attributeHolders.StructNode != nil {
+		anonymousStructNode = findAnonymousStructNode(attributeHolders.StructNode, field.Name())
+	}
+
+	fieldTypeString, err := v.getTypeString(owner, structName, field.Name(), fieldType, anonymousStructNode)
+	if err != nil {
+		return definitions.FieldMetadata{}, err
+	}
+
+	fieldMeta := definitions.FieldMetadata{
+		Name:        field.Name(),
+		Type:        fieldTypeString,
+		Tag:         tag,
+		IsByAddress: isByAddress,
+	}
+
+	fieldAttr := attributeHolders.FieldHolders[field.Name()]
+	if fieldAttr != nil {
+		fieldMeta.Description = fieldAttr.GetDescription()
+		deprecationOpts := getDeprecationOpts(*fieldAttr)
+		fieldMeta.Deprecation = &deprecationOpts
+
+		if err := v.applyValueAnnotations(&fieldMeta, *fieldAttr, fieldType, tag); err != nil {
+			return definitions.FieldMetadata{}, fmt.Errorf("field %q in struct %q - %v", field.Name(), structName, err)
+		}
+	}
+
+	return fieldMeta, nil
+}
+
+// getTypeString returns the name by which the type of a field is referred to in the models, visiting the models it refers to.
+// Slices and arrays are referred to as '[]<element>' and maps as 'map[<key>]<value>', with their element types resolved recursively.
+// Anonymous structs are models of their own, named after the model owning the field and the field itself, e.g., 'UserAddress'
+func (v *TypeVisitor) getTypeString(
+	owner modelOwner,
+	structName string,
+	fieldName string,
+	fieldType types.Type,
+	anonymousStructNode *ast.StructType,
+) (string, error) {
+	switch t := fieldType.(type) {
+	case *types.Pointer:
+		// Pointers to elements are serialized as the elements themselves
+		return v.getTypeString(owner, structName, fieldName, t.Elem(), anonymousStructNode)
+	case *types.Slice:
+		elemString, err := v.getTypeString(owner, structName, fieldName, t.Elem(), anonymousStructNode)
+		return "[]" + elemString, err
+	case *types.Array:
+		elemString, err := v.getTypeString(owner, structName, fieldName, t.Elem(), anonymousStructNode)
+		return "[]" + elemString, err
+	case *types.Map:
+		keyString, err := v.getTypeString(owner, structName, fieldName, t.Key(), nil)
+		if err != nil {
+			return "", err
+		}
+		valueString, err := v.getTypeString(owner, structName, fieldName, t.Elem(), anonymousStructNode)
+		return fmt.Sprintf("map[%s]%s", keyString, valueString), err
+	case *types.Struct:
+		return v.visitAnonymousStruct(owner, structName, fieldName, t, anonymousStructNode)
+	case *types.Named:
+		return v.getNamedTypeString(t)
+	case *types.Alias:
+		// Aliases of universe types are named primitives whilst other aliases stand for the types they alias
+		if t.Obj().Pkg() != nil {
+			// Well-known types may themselves be aliases (e.g. 'json.RawMessage', depending on the toolchain)
+			fullName := fmt.Sprintf("%s.%s", t.Obj().Pkg().Path(), t.Obj().Name())
+			if _, isWellKnown := v.typeMappings.GetWellKnownType(fullName); isWellKnown {
+				return fullName, nil
+			}
+		}
+
+		if _, isBasic := types.Unalias(t).(*types.Basic); isBasic && t.Obj().Pkg() != nil {
+			return v.getNamedPrimitiveTypeString(t.Obj(), types.Unalias(t).String())
+		}
+
+		if t.Obj().Pkg() == nil {
+			// Universe aliases such as 'any' are referred to by name
+			return t.Obj().Name(), nil
+		}
+		return v.getTypeString(owner, structName, fieldName, types.Unalias(t), anonymousStructNode)
+	default:
+		// Primitive field
+		return fieldType.String(), nil
+	}
+}
+
+// getNamedTypeString returns the name by which a named type is referred to in the models, visiting its model if it has one
+func (v *TypeVisitor) getNamedTypeString(t *types.Named) (string, error) {
+	// Universe types such as 'error' have no package and no model of their own
+	if t.Obj().Pkg() == nil {
+		return t.Obj().Name(), nil
+	}
+
+	// Well-known types (e.g. 'time.Time') are referred to by their full name and have dedicated schemas
+	fullName := fmt.Sprintf("%s.%s", t.Obj().Pkg().Path(), t.Obj().Name())
+	if _, isWellKnown := v.typeMappings.GetWellKnownType(fullName); isWellKnown {
+		return fullName, nil
+	}
+
+	// Instantiated generic structs are models of their own, named after their type arguments
+	if t.TypeArgs().Len() > 0 {
+		if err := v.VisitGenericInstance(t); err != nil {
+			return "", err
+		}
+		return v.typesByName[t.String()].Name, nil
+	}
+
+	// Interfaces are polymorphic models, listing the structs they may hold
+	if _, isInterface := t.Underlying().(*types.Interface); isInterface {
+		if err := v.VisitInterface(t.Obj().Pkg().Path(), t.Obj().Name()); err != nil {
+			return "", err
+		}
+	}
+
+	// Check if the named type is a struct that has not yet been processed.
+	underlying, ok := t.Underlying().(*types.Struct)
+	if ok && v.typesByName[fullName] == nil {
+		// Recursively process the nested struct.
+		err := v.VisitStruct(t.Obj().Pkg().Path(), t.Obj().Name(), underlying)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	// Check if the named type is an enum, i.e., a named primitive with declared constants
+	if underlyingType, enumValues := extractor.GetNamedPrimitiveInfo(t.Obj()); len(enumValues) > 0 {
+		err := v.VisitNamedPrimitive(t.Obj().Pkg().Path(), t.Obj().Name(), underlyingType, enumValues)
+		if err != nil {
+			return "", err
+		}
+	} else if underlyingType != "" {
+		return v.getNamedPrimitiveTypeString(t.Obj(), underlyingType)
+	}
+
+	// Add the field as a reference to another model, by the model's schema name
+	if model := v.typesByName[fullName]; model != nil {
+		return model.Name, nil
+	}
+	return t.Obj().Name(), nil
+}
+
+// getNamedPrimitiveTypeString returns the name by which a named primitive that is not an enum is referred to in the models.
+// Depending on the named primitives mode, this is either the universe type it's declared over or the name of its own model
+func (v *TypeVisitor) getNamedPrimitiveTypeString(typeName *types.TypeName, underlyingType string) (string, error) {
+	if v.namedPrimitivesMode != definitions.NamedPrimitivesModeSchema {
+		return underlyingType, nil
+	}
+
+	if err := v.VisitNamedPrimitive(typeName.Pkg().Path(), typeName.Name(), underlyingType, nil); err != nil {
+		return "", err
+	}
+	return v.typesByName[fmt.Sprintf("%s.%s", typeName.Pkg().Path(), typeName.Name())].Name, nil
+}
+
+// visitAnonymousStruct records the anonymous struct type of a field as a model named after the field's owner, e.g.,
+// 'UserAddress' for the 'Address' field of 'User', and returns the model's name.
+// The struct's fields are described by the comments in its declaration, if one was found
+func (v *TypeVisitor) visitAnonymousStruct(
+	owner modelOwner,
+	structName string,
+	fieldName string,
+	structType *types.Struct,
+	structNode *ast.StructType,
+) (string, error) {
+	fullName := fmt.Sprintf("%s.%s", owner.fullName, fieldName)
+	if model := v.typesByName[fullName]; model != nil {
+		return model.Name, nil
+	}
+
+	attributeHolders := StructAttributeHolders{FieldHolders: make(map[string]*annotations.AnnotationHolder)}
+	if structNode != nil {
+		attributeHolders.StructNode = structNode
+		if err := addFieldHolders(&attributeHolders, structName, structNode); err != nil {
+			return "", err
+		}
+	}
+
+	modelName := owner.modelName + fieldName
+	err := v.recordStruct(fullName, owner.fullPackageName, fmt.Sprintf("%s.%s", structName, fieldName), modelName, structType, attributeHolders)
+	return modelName, err
+}
+
+// VisitNamedPrimitive records a named primitive model, e.g. 'type UserID string'.
+// Enums, i.e., named primitives with a set of declared constants, list the constants' values.
+// As named primitives are commonly shared by multiple structs, visiting the same type more than once is a no-op
+func (v *TypeVisitor) VisitNamedPrimitive(fullPackageName string, typeName string, underlyingType string, values []string) error {
+	fullName := fmt.Sprintf("%s.%s", fullPackageName, typeName)
+	if v.typesByName[fullName] != nil {
+		return nil
+	}
+
+	modelName, err := v.GetSchemaName(fullPackageName, typeName)
+	if err != nil {
+		return err
+	}
+
+	primitiveInfo := definitions.ModelMetadata{
+		Name:                  modelName,
+		FullyQualifiedPackage: fullPackageName,
+		UnderlyingType:        underlyingType,
+		EnumValues:            values,
+	}
+
+	relevantPackage := extractor.FilterPackageByFullName(v.packages, fullPackageName)
+	if relevantPackage == nil {
+		return fmt.Errorf(
+			"could not find package object for '%s' whilst looking for named primitive '%s'",
+			fullPackageName,
+			typeName,
+		)
+	}
+
+	genDecl := extractor.FindGenDeclByName(relevantPackage, typeName)
+	if genDecl != nil && genDecl.Doc != nil && len(genDecl.Doc.List) > 0 {
+		primitiveAttributes, err := annotations.NewAnnotationHolder(extractor.MapDocListToStrings(genDecl.Doc.List), annotations.CommentSourceSchema)
+		if err != nil {
+			logger.Error("Could not create an attribute holder for named primitive '%s' - %v", typeName, err)
+			return err
+		}
+
+		primitiveInfo.Description = primitiveAttributes.GetDescription()
+		primitiveInfo.Deprecation = getDeprecationOpts(primitiveAttributes)
+	}
+
+	v.typesByName[fullName] = &primitiveInfo
 	return nil
 }
 
@@ -148,32 +688,228 @@ func (v *TypeVisitor) getAttributeHolders(fullPackageName string, structName str
 		holders.StructHolder = structAttributes
 	}
 
+	holders.StructNode = structNode
+	err := addFieldHolders(&holders, structName, structNode)
+	return holders, err
+}
+
+// addFieldHolders adds the annotations of the fields declared by the given struct node to the given holders
+func addFieldHolders(holders *StructAttributeHolders, structName string, structNode *ast.StructType) error {
 	for _, field := range structNode.Fields.List {
-		if field.Doc != nil && field.Doc.List != nil && len(field.Doc.List) > 0 {
-			if len(field.Names) > 1 {
-				names := []string{}
-				for _, nameIdent := range field.Names {
-					names = append(names, nameIdent.Name)
-				}
-				return holders, fmt.Errorf(
-					"field/s [%s] on struct %s has more than one name i.e., is a multi-var declaration. This is not currently supported",
-					strings.Join(names, ", "),
-					structName,
-				)
+		// Embedded fields are either promoted or composed and hence have no attributes of their own
+		if len(field.Names) == 0 {
+			continue
+		}
+
+		comments := extractor.GetFieldComments(field)
+		if len(comments) == 0 {
+			continue
+		}
+
+		// Names declared together (e.g. 'A, B string') share their comments and hence their annotations
+		fieldNames := []string{}
+		for _, nameIdent := range field.Names {
+			fieldNames = append(fieldNames, nameIdent.Name)
+		}
+
+		fieldHolder, err := annotations.NewAnnotationHolder(comments, annotations.CommentSourceProperty)
+		if err != nil {
+			logger.Error(
+				"Could not create an attribute holder for field/s [%s] on struct '%s' - %v",
+				strings.Join(fieldNames, ", "),
+				structName,
+				err,
+			)
+			return err
+		}
+
+		for _, fieldName := range fieldNames {
+			holders.FieldHolders[fieldName] = &fieldHolder
+		}
+	}
+
+	return nil
+}
+
+// findAnonymousStructNode returns the declaration of the anonymous struct held by the given field, if any.
+// The struct may be held directly or as the element of pointers, slices, arrays and maps
+func findAnonymousStructNode(structNode *ast.StructType, fieldName string) *ast.StructType {
+	for _, field := range structNode.Fields.List {
+		for _, nameIdent := range field.Names {
+			if nameIdent.Name != fieldName {
+				continue
 			}
 
-			fieldName := field.Names[0].Name
-			fieldHolder, err := annotations.NewAnnotationHolder(extractor.MapDocListToStrings(field.Doc.List), annotations.CommentSourceProperty)
-			if err != nil {
-				logger.Error("Could not create an attribute holder for field %s on struct '%s' - %v", fieldName, structName, err)
-				return holders, err
+			typeExpr := field.Type
+			for {
+				switch expr := typeExpr.(type) {
+				case *ast.StructType:
+					return expr
+				case *ast.StarExpr:
+					typeExpr = expr.X
+				case *ast.ArrayType:
+					typeExpr = expr.Elt
+				case *ast.MapType:
+					typeExpr = expr.Value
+				default:
+					return nil
+				}
 			}
+		}
+	}
 
-			holders.FieldHolders[fieldName] = &fieldHolder
+	return nil
+}
+
+// isSerializedField returns whether encoding/json serializes the given field.
+// Fields tagged 'json:"-"' are ignored, as are unexported fields unless they embed a struct whose fields may be promoted
+func isSerializedField(field *types.Var, tag string) bool {
+	if reflect.StructTag(tag).Get("json") == "-" {
+		return false
+	}
+
+	if field.Exported() {
+		return true
+	}
+
+	if !field.Embedded() {
+		return false
+	}
+
+	fieldType := field.Type()
+	if pointer, isPointer := fieldType.(*types.Pointer); isPointer {
+		fieldType = pointer.Elem()
+	}
+	_, isStruct := fieldType.Underlying().(*types.Struct)
+	return isStruct
+}
+
+// GetJsonName returns the name of the field as serialized by encoding/json and whether the name was set via the field's tag
+func GetJsonName(fieldName string, tag string) (string, bool) {
+	tagName, _, _ := strings.Cut(reflect.StructTag(tag).Get("json"), ",")
+	if tagName == "" {
+		return fieldName, false
+	}
+	return tagName, true
+}
+
+// getTypeArgName returns the name by which a type argument is referred to in a generic schema name
+func (v *TypeVisitor) getTypeArgName(typeArg types.Type) (string, error) {
+	switch t := typeArg.(type) {
+	case *types.Pointer:
+		return v.getTypeArgName(t.Elem())
+	case *types.Slice:
+		elemName, err := v.getTypeArgName(t.Elem())
+		return "[]" + elemName, err
+	case *types.Named:
+		if t.TypeArgs().Len() > 0 {
+			return v.GetGenericInstanceSchemaName(t)
 		}
+		if t.Obj().Pkg() == nil || v.isWellKnownNamedType(t) {
+			// Universe types such as 'error' and well-known types have no models of their own
+			return t.Obj().Name(), nil
+		}
+		return v.GetSchemaName(t.Obj().Pkg().Path(), t.Obj().Name())
+	case *types.Map:
+		keyName, err := v.getTypeArgName(t.Key())
+		if err != nil {
+			return "", err
+		}
+
+		valueName, err := v.getTypeArgName(t.Elem())
+		if err != nil {
+			return "", err
+		}
+		return definitions.GetMapTypeArgName(keyName, valueName), nil
+	case *types.Basic:
+		return t.Name(), nil
+	case *types.Alias:
+		// Universe aliases (e.g. 'any') and named primitive aliases (e.g. 'type UserID = string') are referred to by name
+		if _, isBasic := types.Unalias(t).(*types.Basic); isBasic || t.Obj().Pkg() == nil {
+			return t.Obj().Name(), nil
+		}
+		return v.getTypeArgName(types.Unalias(t))
+	case *types.Interface:
+		if t.Empty() {
+			return "any", nil
+		}
+	}
+
+	// Anonymous structs and interfaces, funcs, channels and arrays have no name a schema could be named after
+	return "", fmt.Errorf(
+		"type argument '%s' is not supported - type arguments must be named types, or slices or maps thereof",
+		types.TypeString(typeArg, func(pkg *types.Package) string { return pkg.Name() }),
+	)
+}
+
+func (v *TypeVisitor) isWellKnownNamedType(named *types.Named) bool {
+	_, isWellKnown := v.typeMappings.GetWellKnownType(fmt.Sprintf("%s.%s", named.Obj().Pkg().Path(), named.Obj().Name()))
+	return isWellKnown
+}
+
+// getEmbeddedStruct returns the named struct type of an embedded field, if any, and whether it's embedded via a pointer
+func getEmbeddedStruct(fieldType types.Type) (*types.Named, *types.Struct, bool) {
+	isByAddress := false
+	if pointer, isPointer := fieldType.(*types.Pointer); isPointer {
+		fieldType = pointer.Elem()
+		isByAddress = true
+	}
+
+	named, isNamed := fieldType.(*types.Named)
+	if !isNamed {
+		return nil, nil, false
+	}
+
+	structType, isStruct := named.Underlying().(*types.Struct)
+	if !isStruct {
+		return nil, nil, false
 	}
 
-	return holders, nil
+	return named, structType, isByAddress
+}
+
+// resolveFieldConflicts drops fields hidden by other fields with the same JSON name, using encoding/json's rules -
+// the shallowest field wins, followed by a tagged field at the same depth.
+// If the conflict remains ambiguous, all conflicting fields are dropped
+func resolveFieldConflicts(fields []structField) []definitions.FieldMetadata {
+	indicesByName := map[string][]int{}
+	for i, field := range fields {
+		indicesByName[field.jsonName] = append(indicesByName[field.jsonName], i)
+	}
+
+	resolved := []definitions.FieldMetadata{}
+	for i, field := range fields {
+		if getDominantFieldIndex(fields, indicesByName[field.jsonName]) == i {
+			resolved = append(resolved, field.meta)
+		}
+	}
+
+	return resolved
+}
+
+// getDominantFieldIndex returns the index of the field that wins a name conflict or -1 if there is no such field
+func getDominantFieldIndex(fields []structField, candidates []int) int {
+	dominant := candidates[0]
+	isAmbiguous := false
+
+	for _, index := range candidates[1:] {
+		candidate := fields[index]
+		current := fields[dominant]
+
+		switch {
+		case candidate.depth < current.depth,
+			candidate.depth == current.depth && candidate.isTagged && !current.isTagged:
+			dominant = index
+			isAmbiguous = false
+		case candidate.depth == current.depth && candidate.isTagged == current.isTagged:
+			isAmbiguous = true
+		}
+	}
+
+	if isAmbiguous {
+		return -1
+	}
+	return dominant
 }
 
 // GetStructs returns the list of processed structs.
@@ -185,6 +921,16 @@ func (v *TypeVisitor) GetStructs() []definitions.ModelMetadata {
 	return models
 }
 
+// GetTypesBySchemaName groups the full names of all visited types by the names of their schemas.
+// Distinct types sharing a schema name would overwrite one another in the spec's components
+func (v *TypeVisitor) GetTypesBySchemaName() map[string][]string {
+	typesBySchemaName := map[string][]string{}
+	for fullName, model := range v.typesByName {
+		typesBySchemaName[model.Name] = append(typesBySchemaName[model.Name], fullName)
+	}
+	return typesBySchemaName
+}
+
 func getDeprecationOpts(attributes annotations.AnnotationHolder) definitions.DeprecationOptions {
 	deprecationAttr := attributes.GetFirst(annotations.AttributeDeprecated)
 	if deprecationAttr == nil {
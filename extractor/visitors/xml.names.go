@@ -0,0 +1,41 @@
+package visitors
+
+import (
+	"go/types"
+	"reflect"
+	"strings"
+)
+
+// isXmlNameField checks whether the given field is an 'XMLName' field, used by encoding/xml to name a struct's element.
+// Such fields describe the struct itself and are not considered fields of the model
+func isXmlNameField(field *types.Var) bool {
+	named, isNamed := types.Unalias(field.Type()).(*types.Named)
+	if field.Name() != "XMLName" || !isNamed || named.Obj().Pkg() == nil {
+		return false
+	}
+
+	return named.Obj().Pkg().Path() == "encoding/xml" && named.Obj().Name() == "Name"
+}
+
+// getXmlName returns the name of a struct's XML element, as given by the tag of its 'XMLName' field, e.g. 'user' for:
+//
+//	XMLName xml.Name `xml:"user"`
+//
+// Namespaced names ('http://example.com/ns user') are returned without their namespace.
+// Returns an empty string if the struct has no such field or the field's tag does not name the element
+func getXmlName(structType *types.Struct) string {
+	for i := 0; i < structType.NumFields(); i++ {
+		if !isXmlNameField(structType.Field(i)) {
+			continue
+		}
+
+		name, _, _ := strings.Cut(reflect.StructTag(structType.Tag(i)).Get("xml"), ",")
+		fields := strings.Fields(name)
+		if len(fields) == 0 {
+			return ""
+		}
+		return fields[len(fields)-1]
+	}
+
+	return ""
+}
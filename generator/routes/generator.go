@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/aymerick/raymond"
@@ -61,9 +63,125 @@ func registerHelpers() {
 		return options.Inverse()
 	})
 
+	raymond.RegisterHelper("BaseTypeNameEquals", func(typeMeta definitions.TypeMetadata, value string, options *raymond.Options) string {
+		// Named primitives (i.e., enums) are parsed as the universe type they're declared over
+		typeName := typeMeta.Name
+		if typeMeta.UnderlyingType != "" {
+			typeName = typeMeta.UnderlyingType
+		}
+
+		if typeName == value {
+			return options.Fn()
+		}
+
+		return options.Inverse()
+	})
+
+	raymond.RegisterHelper("WellKnownTypeEquals", func(typeMeta definitions.TypeMetadata, value string, options *raymond.Options) string {
+		// Well-known types are matched by their full name, e.g. 'time.Time'.
+		// Types with a user-provided parse function are handled by 'ifTypeHasParseFunc' instead
+		wellKnownType, isWellKnown := typeMeta.GetWellKnownType()
+		if isWellKnown && wellKnownType.ParseFuncName == "" && typeMeta.FullName() == value {
+			return options.Fn()
+		}
+
+		return options.Inverse()
+	})
+
+	raymond.RegisterHelper("ifTypeHasParseFunc", func(typeMeta definitions.TypeMetadata, options *raymond.Options) string {
+		if wellKnownType, isWellKnown := typeMeta.GetWellKnownType(); isWellKnown && wellKnownType.ParseFuncName != "" {
+			return options.Fn()
+		}
+
+		return options.Inverse()
+	})
+
+	raymond.RegisterHelper("TypeParseFuncPackage", func(typeMeta definitions.TypeMetadata) string {
+		wellKnownType, _ := typeMeta.GetWellKnownType()
+		return wellKnownType.ParseFuncPackage
+	})
+
+	raymond.RegisterHelper("TypeParseFuncName", func(typeMeta definitions.TypeMetadata) string {
+		wellKnownType, _ := typeMeta.GetWellKnownType()
+		return wellKnownType.ParseFuncName
+	})
+
+	raymond.RegisterHelper("EnumValuesLiteral", func(typeMeta definitions.TypeMetadata) string {
+		literals := []string{}
+		for _, value := range typeMeta.EnumValues {
+			if typeMeta.UnderlyingType == "string" {
+				literals = append(literals, strconv.Quote(value))
+			} else {
+				literals = append(literals, value)
+			}
+		}
+		return strings.Join(literals, ", ")
+	})
+
+	raymond.RegisterHelper("StringLiteral", func(value string) string {
+		return strconv.Quote(value)
+	})
+
+	raymond.RegisterHelper("StringsLiteral", func(values []string) string {
+		literals := []string{}
+		for _, value := range values {
+			literals = append(literals, strconv.Quote(value))
+		}
+		return strings.Join(literals, ", ")
+	})
+
+	raymond.RegisterHelper("ContentTypesLiteral", func(contentTypes []definitions.ContentType) string {
+		literals := []string{}
+		for _, contentType := range contentTypes {
+			literals = append(literals, strconv.Quote(string(contentType)))
+		}
+		return strings.Join(literals, ", ")
+	})
+
+	raymond.RegisterHelper("ifContainsContentType", func(contentTypes []definitions.ContentType, value string, options *raymond.Options) string {
+		if slices.Contains(contentTypes, definitions.ContentType(value)) {
+			return options.Fn()
+		}
+
+		return options.Inverse()
+	})
+
+	raymond.RegisterHelper("ifReturnsStream", func(types []definitions.FuncReturnValue, options *raymond.Options) string {
+		// The value, if any, is always the first of the returned types
+		if len(types) > 1 && (types[0].IsStream() || types[0].IsFileResponse) {
+			return options.Fn()
+		}
+
+		return options.Inverse()
+	})
+
+	raymond.RegisterHelper("ifReturnsFileResponse", func(types []definitions.FuncReturnValue, options *raymond.Options) string {
+		// The value, if any, is always the first of the returned types
+		if len(types) > 1 && types[0].IsFileResponse {
+			return options.Fn()
+		}
+
+		return options.Inverse()
+	})
+
+	raymond.RegisterHelper("ifReturnsChannel", func(types []definitions.FuncReturnValue, options *raymond.Options) string {
+		// The value, if any, is always the first of the returned types
+		if len(types) > 1 && types[0].IsChannel() {
+			return options.Fn()
+		}
+
+		return options.Inverse()
+	})
+
 	raymond.RegisterHelper("ifAnyParamRequiresConversion", func(params []definitions.FuncParam, options *raymond.Options) string {
 		for _, param := range params {
-			if param.TypeMeta.Name != "string" && param.TypeMeta.FullyQualifiedPackage != "" {
+			// Named primitives (including enums), well-known types, query objects and form files are converted in self-contained blocks
+			// and do not make use of the shared conversion error
+			isSelfContained := param.TypeMeta.IsNamedPrimitive() ||
+				param.TypeMeta.IsWellKnownType() ||
+				len(param.QueryFields) > 0 ||
+				param.PassedIn == definitions.PassedInFormFile
+			if param.TypeMeta.Name != "string" && param.TypeMeta.FullyQualifiedPackage != "" && !isSelfContained {
 				// Currently, only 'string' parameters don't undergo any validation
 				return options.Fn()
 			}
@@ -90,12 +208,107 @@ func registerHelpers() {
 		}
 
 		last := types[len(types)-1]
-		return fmt.Sprintf("Response%d%s.%s", last.UniqueImportSerial, last.Name, last.Name)
+		alias := fmt.Sprintf("Response%d%s", last.UniqueImportSerial, last.Name)
+		return fmt.Sprintf("%s.%s%s", alias, last.Name, getTypeArgsExpr(alias, last.TypeMetadata))
+	})
+
+	raymond.RegisterHelper("TypeArgImports", func(prefix string, serial any, name string, typeMeta definitions.TypeMetadata) string {
+		return getTypeArgImports(fmt.Sprintf("%s%v%s", prefix, serial, name), typeMeta)
+	})
+
+	raymond.RegisterHelper("TypeArgsExpr", func(prefix string, serial any, name string, typeMeta definitions.TypeMetadata) string {
+		return getTypeArgsExpr(fmt.Sprintf("%s%v%s", prefix, serial, name), typeMeta)
+	})
+
+	raymond.RegisterHelper("withSliceElement", func(param definitions.FuncParam, options *raymond.Options) string {
+		return options.FnWith(getSliceElementParam(param))
+	})
+
+	raymond.RegisterHelper("SliceElementTypeExpr", func(param definitions.FuncParam) string {
+		element := getSliceElementParam(param)
+		return getTypeExpr(fmt.Sprintf("Param%d%s", element.UniqueImportSerial, element.Name), element.TypeMeta)
 	})
 
 	helpersRegistered = true
 }
 
+// getTypeArgImports returns the import lines required by the type arguments of an instantiated generic type.
+// Each type argument is imported under the alias of the generic type, suffixed by the argument's index, e.g. 'Param0PageArg0'
+func getTypeArgImports(alias string, typeMeta definitions.TypeMetadata) string {
+	imports := []string{}
+	for i, typeArg := range typeMeta.TypeArgs {
+		imports = append(imports, getTypeImports(fmt.Sprintf("%sArg%d", alias, i), typeArg)...)
+	}
+	return strings.Join(imports, "\n")
+}
+
+// getTypeImports returns the import lines required by a type referred to under the given alias (see getTypeExpr).
+// Slices are imported by their element type and maps by their key and value types
+func getTypeImports(alias string, typeMeta definitions.TypeMetadata) []string {
+	switch {
+	case typeMeta.IsSlice():
+		return getTypeImports(alias, *typeMeta.ElementType)
+	case typeMeta.IsMap():
+		return append(getTypeImports(alias+"Key", *typeMeta.KeyType), getTypeImports(alias+"Value", *typeMeta.ValueType)...)
+	}
+
+	imports := []string{}
+	if typeMeta.FullyQualifiedPackage != "" {
+		imports = append(imports, fmt.Sprintf("%s \"%s\"", alias, typeMeta.FullyQualifiedPackage))
+	}
+	if nested := getTypeArgImports(alias, typeMeta); nested != "" {
+		imports = append(imports, nested)
+	}
+	return imports
+}
+
+// getTypeArgsExpr returns the type argument list of an instantiated generic type, e.g. '[Param0PageArg0.User]'.
+// Returns an empty string for non-generic types
+func getTypeArgsExpr(alias string, typeMeta definitions.TypeMetadata) string {
+	if !typeMeta.IsGenericInstance() {
+		return ""
+	}
+
+	typeArgs := make([]string, len(typeMeta.TypeArgs))
+	for i, typeArg := range typeMeta.TypeArgs {
+		typeArgs[i] = getTypeExpr(fmt.Sprintf("%sArg%d", alias, i), typeArg)
+	}
+	return fmt.Sprintf("[%s]", strings.Join(typeArgs, ", "))
+}
+
+// getTypeExpr returns the Go expression referring to a type whose package is imported under the given alias,
+// e.g. '*Param0PageArg0.User' or '[]Param1IdsItem.Status'.
+// The packages of a map's keys and values are imported under the alias suffixed by 'Key' and 'Value', respectively
+func getTypeExpr(alias string, typeMeta definitions.TypeMetadata) string {
+	var expr string
+	if typeMeta.IsSlice() {
+		expr = "[]" + getTypeExpr(alias, *typeMeta.ElementType)
+	} else if typeMeta.IsMap() {
+		expr = fmt.Sprintf("map[%s]%s", getTypeExpr(alias+"Key", *typeMeta.KeyType), getTypeExpr(alias+"Value", *typeMeta.ValueType))
+	} else {
+		expr = typeMeta.Name + getTypeArgsExpr(alias, typeMeta)
+		if typeMeta.FullyQualifiedPackage != "" {
+			expr = fmt.Sprintf("%s.%s", alias, expr)
+		}
+	}
+
+	if typeMeta.IsByAddress {
+		return "*" + expr
+	}
+	return expr
+}
+
+// getSliceElementParam returns a parameter describing a single element of the given slice parameter.
+// The element is named after the slice, suffixed by 'Item', so its package is imported under e.g. 'Param1IdsItem'
+func getSliceElementParam(param definitions.FuncParam) definitions.FuncParam {
+	element := param
+	element.Name = param.Name + "Item"
+	element.TypeMeta = *param.TypeMeta.ElementType
+	element.Style = ""
+	element.Explode = false
+	return element
+}
+
 func getDefaultTemplate(engine definitions.RoutingEngineType) string {
 	switch engine {
 	case definitions.RoutingEngineGin:
@@ -94,6 +94,65 @@ var _ = Describe("Engine Registration", func() {
 	})
 })
 
+var _ = Describe("Generic Type Arguments", func() {
+	// Envelope[Pair[string, *contracts.User]]
+	envelopeMeta := definitions.TypeMetadata{
+		Name:                  "Envelope",
+		FullyQualifiedPackage: "github.com/example/envelopes",
+		TypeArgs: []definitions.TypeMetadata{
+			{
+				Name:                  "Pair",
+				FullyQualifiedPackage: "github.com/example/pairs",
+				TypeArgs: []definitions.TypeMetadata{
+					{Name: "string", IsUniverseType: true},
+					{Name: "User", FullyQualifiedPackage: "github.com/example/contracts", IsByAddress: true},
+				},
+			},
+		},
+	}
+
+	It("should alias each type argument after the generic type", func() {
+		Expect(getTypeArgsExpr("Param0Envelope", envelopeMeta)).To(
+			Equal("[Param0EnvelopeArg0.Pair[string, *Param0EnvelopeArg0Arg1.User]]"),
+		)
+	})
+
+	It("should import the packages of all nested type arguments", func() {
+		Expect(getTypeArgImports("Param0Envelope", envelopeMeta)).To(Equal(
+			"Param0EnvelopeArg0 \"github.com/example/pairs\"\n" +
+				"Param0EnvelopeArg0Arg1 \"github.com/example/contracts\"",
+		))
+	})
+
+	It("should alias the keys and values of map type arguments separately", func() {
+		// Envelope[map[regions.Region]string]
+		mapEnvelopeMeta := definitions.TypeMetadata{
+			Name:                  "Envelope",
+			FullyQualifiedPackage: "github.com/example/envelopes",
+			TypeArgs: []definitions.TypeMetadata{
+				{
+					Name:      "map[Region]string",
+					KeyType:   &definitions.TypeMetadata{Name: "Region", FullyQualifiedPackage: "github.com/example/regions"},
+					ValueType: &definitions.TypeMetadata{Name: "string", IsUniverseType: true},
+				},
+			},
+		}
+
+		Expect(getTypeArgsExpr("Param0Envelope", mapEnvelopeMeta)).To(
+			Equal("[map[Param0EnvelopeArg0Key.Region]string]"),
+		)
+		Expect(getTypeArgImports("Param0Envelope", mapEnvelopeMeta)).To(
+			Equal("Param0EnvelopeArg0Key \"github.com/example/regions\""),
+		)
+	})
+
+	It("should yield nothing for non-generic types", func() {
+		plainMeta := definitions.TypeMetadata{Name: "User", FullyQualifiedPackage: "github.com/example/contracts"}
+		Expect(getTypeArgsExpr("Param0User", plainMeta)).To(BeEmpty())
+		Expect(getTypeArgImports("Param0User", plainMeta)).To(BeEmpty())
+	})
+})
+
 func TestRoutes(t *testing.T) {
 	logger.SetLogLevel(logger.LogLevelNone)
 	RegisterFailHandler(Fail)
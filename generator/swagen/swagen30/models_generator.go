@@ -1,15 +1,79 @@
 package swagen30
 
 import (
+	"strconv"
+
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gopher-fleece/gleece/definitions"
 	"github.com/gopher-fleece/gleece/generator/swagen/swagtool"
+	"github.com/gopher-fleece/gleece/infrastructure/logger"
 )
 
 var objectType = &openapi3.Types{"object"}
 var arrayType = &openapi3.Types{"array"}
 
-func generateModelSpec(openapi *openapi3.T, model definitions.ModelMetadata) {
+// generateNamedPrimitiveSpec describes a named primitive model by the universe type it's declared over.
+// Enums additionally list their values
+func generateNamedPrimitiveSpec(openapi *openapi3.T, model definitions.ModelMetadata) {
+	schema := &openapi3.Schema{
+		Title:       model.Name,
+		Description: model.Description,
+		Type:        &openapi3.Types{swagtool.ToOpenApiType(nil, model.UnderlyingType)},
+		Deprecated:  swagtool.IsDeprecated(&model.Deprecation),
+	}
+
+	for _, value := range model.EnumValues {
+		if schema.Type.Is("integer") {
+			if intValue, err := strconv.ParseInt(value, 10, 64); err == nil {
+				schema.Enum = append(schema.Enum, intValue)
+			} else {
+				logger.Warn("Invalid integer value '%s' in enum %s", value, model.Name)
+			}
+			continue
+		}
+		schema.Enum = append(schema.Enum, value)
+	}
+
+	// Add schema to components
+	openapi.Components.Schemas[model.Name] = &openapi3.SchemaRef{
+		Value: schema,
+	}
+}
+
+// generatePolymorphicSpec describes an interface model as a 'oneOf' its members, discriminated by the model's discriminator property
+func generatePolymorphicSpec(openapi *openapi3.T, typeMappings definitions.TypeMappings, model definitions.ModelMetadata) {
+	schema := &openapi3.Schema{
+		Title:       model.Name,
+		Description: model.Description,
+		Deprecated:  swagtool.IsDeprecated(&model.Deprecation),
+		Discriminator: &openapi3.Discriminator{
+			PropertyName: model.Discriminator,
+			Mapping:      map[string]string{},
+		},
+	}
+
+	for _, member := range model.OneOf {
+		memberSchemaRef := InterfaceToSchemaRef(openapi, typeMappings, member.SchemaName)
+		schema.OneOf = append(schema.OneOf, memberSchemaRef)
+		schema.Discriminator.Mapping[member.DiscriminatorValue] = memberSchemaRef.Ref
+	}
+
+	openapi.Components.Schemas[model.Name] = &openapi3.SchemaRef{
+		Value: schema,
+	}
+}
+
+func generateModelSpec(openapi *openapi3.T, typeMappings definitions.TypeMappings, model definitions.ModelMetadata) {
+	if model.IsNamedPrimitive() {
+		generateNamedPrimitiveSpec(openapi, model)
+		return
+	}
+
+	if model.IsPolymorphic() {
+		generatePolymorphicSpec(openapi, typeMappings, model)
+		return
+	}
+
 	schema := &openapi3.Schema{
 		Title:       model.Name,
 		Description: model.Description,
@@ -21,12 +85,38 @@ func generateModelSpec(openapi *openapi3.T, model definitions.ModelMetadata) {
 	requiredFields := []string{}
 
 	for _, field := range model.Fields {
-		fieldSchemaRef := InterfaceToSchemaRef(openapi, field.Type)
+		// Fields tagged ',string' are encoded as JSON strings. Their validations apply to the decoded value and are not described
+		isStringEncoded := swagtool.IsJsonStringEncoded(field.Tag, field.Type)
+		schemaType := field.Type
+		if isStringEncoded {
+			schemaType = "string"
+		}
+
+		fieldSchemaRef := InterfaceToSchemaRef(openapi, typeMappings, schemaType)
+		if field.IsByAddress {
+			fieldSchemaRef = toNullableSchemaRef(fieldSchemaRef)
+		}
+
+		xmlName, isXmlAttribute := swagtool.GetXmlNameFromTag(field.Tag, field.Name)
+		hasXmlName := xmlName != "" || isXmlAttribute
+
+		// Siblings of a '$ref' are ignored and the field's annotated values are instead set on an 'allOf' wrapping it
+		if (field.HasValueAnnotations() || hasXmlName) && fieldSchemaRef.Ref != "" {
+			fieldSchemaRef = &openapi3.SchemaRef{
+				Value: &openapi3.Schema{AllOf: openapi3.SchemaRefs{fieldSchemaRef}},
+			}
+		}
+
+		// Enum, named primitive and polymorphic schemas are shared by reference and should retain their own description and validations
+		isSharedRef := fieldSchemaRef.Ref != "" && fieldSchemaRef.Value != nil &&
+			(len(fieldSchemaRef.Value.Enum) > 0 || isPrimitiveSchema(fieldSchemaRef.Value) || len(fieldSchemaRef.Value.OneOf) > 0)
 
 		validationTag := swagtool.GetTagValue(field.Tag, "validate", "")
-		BuildSchemaValidation(fieldSchemaRef, validationTag, field.Type)
+		if !isSharedRef && !isStringEncoded {
+			BuildSchemaValidation(fieldSchemaRef, typeMappings, validationTag, field.Type)
+		}
 
-		if fieldSchemaRef.Value != nil {
+		if fieldSchemaRef.Value != nil && !isSharedRef {
 			fieldSchemaRef.Value.Description = field.Description
 
 			// If the schema marked as deprecated, the field / property should be marked as deprecated as well
@@ -34,6 +124,12 @@ func generateModelSpec(openapi *openapi3.T, model definitions.ModelMetadata) {
 			if !fieldSchemaRef.Value.Deprecated {
 				fieldSchemaRef.Value.Deprecated = swagtool.IsDeprecated(field.Deprecation)
 			}
+
+			applyValueAnnotations(fieldSchemaRef.Value, field)
+
+			if hasXmlName {
+				applyXmlName(fieldSchemaRef.Value, xmlName, isXmlAttribute)
+			}
 		}
 
 		// Add field to schema properties
@@ -41,7 +137,7 @@ func generateModelSpec(openapi *openapi3.T, model definitions.ModelMetadata) {
 		schema.Properties[fName] = fieldSchemaRef
 
 		// If the field should be required, add its name to the requiredFields slice
-		if swagtool.IsFieldRequired(validationTag) {
+		if swagtool.IsJsonFieldRequired(field.Tag) {
 			requiredFields = append(requiredFields, fName)
 		}
 	}
@@ -51,12 +147,97 @@ func generateModelSpec(openapi *openapi3.T, model definitions.ModelMetadata) {
 		schema.Required = requiredFields
 	}
 
+	if len(model.EmbeddedModels) > 0 {
+		schema = composeWithEmbeddedModels(openapi, typeMappings, schema, model.EmbeddedModels)
+	}
+
+	if model.XmlName != "" {
+		schema.XML = &openapi3.XML{Name: model.XmlName}
+	}
+
 	// Add schema to components
 	openapi.Components.Schemas[model.Name] = &openapi3.SchemaRef{
 		Value: schema,
 	}
 }
 
+// applyValueAnnotations sets the example and default values, the format, the pattern and the access mode
+// given by the annotations of a field on the field's schema
+func applyValueAnnotations(schema *openapi3.Schema, field definitions.FieldMetadata) {
+	if field.Example != nil {
+		schema.Example = field.Example
+	}
+	if field.Default != nil {
+		schema.Default = field.Default
+	}
+	if field.Format != "" {
+		schema.Format = field.Format
+	}
+	if field.Pattern != "" {
+		schema.Pattern = field.Pattern
+	}
+	schema.ReadOnly = field.ReadOnly
+	schema.WriteOnly = field.WriteOnly
+}
+
+// applyXmlName sets the name a field is encoded by in XML on the field's schema.
+// The elements of arrays are named individually and their schema is amended instead
+func applyXmlName(schema *openapi3.Schema, name string, isAttribute bool) {
+	if schema.Type.Is("array") && schema.Items != nil {
+		if schema.Items.Ref != "" {
+			schema.Items = &openapi3.SchemaRef{
+				Value: &openapi3.Schema{AllOf: openapi3.SchemaRefs{schema.Items}},
+			}
+		}
+		schema = schema.Items.Value
+	}
+
+	schema.XML = &openapi3.XML{Name: name, Attribute: isAttribute}
+}
+
+// isPrimitiveSchema checks whether the given schema describes a single, non-container type
+func isPrimitiveSchema(schema *openapi3.Schema) bool {
+	return schema.Type != nil && len(*schema.Type) == 1 && !schema.Type.Is("object") && !schema.Type.Is("array")
+}
+
+// composeWithEmbeddedModels combines references to the given embedded models with the model's own schema via 'allOf'
+func composeWithEmbeddedModels(openapi *openapi3.T, typeMappings definitions.TypeMappings, schema *openapi3.Schema, embeddedModels []string) *openapi3.Schema {
+	composed := &openapi3.Schema{
+		Title:       schema.Title,
+		Description: schema.Description,
+		Deprecated:  schema.Deprecated,
+	}
+
+	for _, embeddedModel := range embeddedModels {
+		composed.AllOf = append(composed.AllOf, InterfaceToSchemaRef(openapi, typeMappings, embeddedModel))
+	}
+
+	ownSchema := &openapi3.Schema{
+		Type:       schema.Type,
+		Properties: schema.Properties,
+		Required:   schema.Required,
+	}
+	composed.AllOf = append(composed.AllOf, &openapi3.SchemaRef{Value: ownSchema})
+
+	return composed
+}
+
+// toNullableSchemaRef marks the given schema as nullable.
+// As OpenAPI 3.0 ignores any siblings of a '$ref', references are wrapped in an 'allOf'
+func toNullableSchemaRef(schemaRef *openapi3.SchemaRef) *openapi3.SchemaRef {
+	if schemaRef.Ref == "" {
+		schemaRef.Value.Nullable = true
+		return schemaRef
+	}
+
+	return &openapi3.SchemaRef{
+		Value: &openapi3.Schema{
+			Nullable: true,
+			AllOf:    openapi3.SchemaRefs{schemaRef},
+		},
+	}
+}
+
 // Fill the schema references in the components
 func fillSchemaRef(openapi *openapi3.T) {
 	// Iterate over all EmptyRefSchemas
@@ -71,9 +252,9 @@ func fillSchemaRef(openapi *openapi3.T) {
 	}
 }
 
-func GenerateModelsSpec(openapi *openapi3.T, models []definitions.ModelMetadata) error {
+func GenerateModelsSpec(openapi *openapi3.T, typeMappings definitions.TypeMappings, models []definitions.ModelMetadata) error {
 	for _, model := range models {
-		generateModelSpec(openapi, model)
+		generateModelSpec(openapi, typeMappings, model)
 	}
 	fillSchemaRef(openapi)
 	return nil
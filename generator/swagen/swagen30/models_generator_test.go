@@ -28,7 +28,7 @@ var _ = Describe("Swagen", func() {
 						Name:        "Field1",
 						Type:        "string",
 						Description: "A string field",
-						Tag:         `json:"field1,omitempty" validate:"required"`,
+						Tag:         `json:"field1" validate:"required"`,
 					},
 					{
 						Name:        "field2",
@@ -39,7 +39,7 @@ var _ = Describe("Swagen", func() {
 				},
 			}
 
-			generateModelSpec(openapi, model)
+			generateModelSpec(openapi, nil, model)
 
 			schemaRef := openapi.Components.Schemas["TestModel"]
 			Expect(schemaRef).NotTo(BeNil())
@@ -56,6 +56,24 @@ var _ = Describe("Swagen", func() {
 			Expect(schemaRef.Value.Properties["field2"].Value.ExclusiveMin).To(Equal(true))
 		})
 
+		It("should honor the 'omitempty' and 'string' json tag options", func() {
+			model := definitions.ModelMetadata{
+				Name: "JsonTagsModel",
+				Fields: []definitions.FieldMetadata{
+					{Name: "Name", Type: "string", Tag: `json:"name" validate:"required"`},
+					{Name: "Nickname", Type: "string", Tag: `json:"nickname,omitempty" validate:"required"`},
+					{Name: "Count", Type: "int", Tag: `json:"count,string" validate:"gt=10"`},
+				},
+			}
+
+			generateModelSpec(openapi, nil, model)
+
+			schema := openapi.Components.Schemas["JsonTagsModel"].Value
+			Expect(schema.Required).To(ConsistOf("name"))
+			Expect(schema.Properties["count"].Value.Type).To(Equal(&openapi3.Types{"string"}))
+			Expect(schema.Properties["count"].Value.Min).To(BeNil())
+		})
+
 		It("should generate a model with references to other models", func() {
 			model1 := definitions.ModelMetadata{
 				Name:        "ModelA",
@@ -73,8 +91,8 @@ var _ = Describe("Swagen", func() {
 				},
 			}
 
-			generateModelSpec(openapi, model1)
-			generateModelSpec(openapi, model2)
+			generateModelSpec(openapi, nil, model1)
+			generateModelSpec(openapi, nil, model2)
 
 			schemaRef1 := openapi.Components.Schemas["ModelA"]
 			Expect(schemaRef1).NotTo(BeNil())
@@ -86,6 +104,177 @@ var _ = Describe("Swagen", func() {
 			Expect(schemaRef2.Value.Properties).To(HaveKey("modelA"))
 			Expect(schemaRef2.Value.Properties["modelA"].Ref).To(Equal("#/components/schemas/ModelA"))
 		})
+
+		It("should generate nullable schemas for pointer fields", func() {
+			referenced := definitions.ModelMetadata{
+				Name: "Referenced",
+				Fields: []definitions.FieldMetadata{
+					{Name: "id", Type: "string"},
+				},
+			}
+
+			model := definitions.ModelMetadata{
+				Name: "WithPointers",
+				Fields: []definitions.FieldMetadata{
+					{Name: "optionalName", Type: "string", Description: "An optional name", IsByAddress: true},
+					{Name: "requiredName", Type: "string", Tag: `validate:"required"`, IsByAddress: true},
+					{Name: "optionalRef", Type: "Referenced", Description: "An optional reference", IsByAddress: true},
+					{Name: "plainName", Type: "string"},
+				},
+			}
+
+			generateModelSpec(openapi, nil, referenced)
+			generateModelSpec(openapi, nil, model)
+
+			schemaRef := openapi.Components.Schemas["WithPointers"]
+			Expect(schemaRef).NotTo(BeNil())
+
+			optionalName := schemaRef.Value.Properties["optionalName"]
+			Expect(optionalName.Value.Nullable).To(BeTrue())
+			Expect(optionalName.Value.Type).To(Equal(&openapi3.Types{"string"}))
+			Expect(optionalName.Value.Description).To(Equal("An optional name"))
+
+			optionalRef := schemaRef.Value.Properties["optionalRef"]
+			Expect(optionalRef.Ref).To(BeEmpty())
+			Expect(optionalRef.Value.Nullable).To(BeTrue())
+			Expect(optionalRef.Value.Description).To(Equal("An optional reference"))
+			Expect(optionalRef.Value.AllOf).To(HaveLen(1))
+			Expect(optionalRef.Value.AllOf[0].Ref).To(Equal("#/components/schemas/Referenced"))
+
+			Expect(schemaRef.Value.Properties["plainName"].Value.Nullable).To(BeFalse())
+			Expect(schemaRef.Value.Required).To(Equal([]string{"requiredName"}))
+
+			// The referenced schema itself must remain non-nullable
+			Expect(openapi.Components.Schemas["Referenced"].Value.Nullable).To(BeFalse())
+		})
+
+		It("should apply the annotated values of fields", func() {
+			model := definitions.ModelMetadata{
+				Name: "WithValues",
+				Fields: []definitions.FieldMetadata{
+					{Name: "pageSize", Type: "int", Example: int64(42), Default: int64(10), ReadOnly: true},
+					{Name: "code", Type: "string", Format: "iso-3166", Pattern: "^[A-Z]{2}$", WriteOnly: true},
+					{Name: "ref", Type: "Referenced", Default: map[string]any{"name": "a"}},
+				},
+			}
+
+			generateModelSpec(openapi, nil, model)
+
+			schema := openapi.Components.Schemas["WithValues"].Value
+
+			pageSize := schema.Properties["pageSize"].Value
+			Expect(pageSize.Example).To(Equal(int64(42)))
+			Expect(pageSize.Default).To(Equal(int64(10)))
+			Expect(pageSize.ReadOnly).To(BeTrue())
+			Expect(pageSize.WriteOnly).To(BeFalse())
+
+			code := schema.Properties["code"].Value
+			Expect(code.Format).To(Equal("iso-3166"))
+			Expect(code.Pattern).To(Equal("^[A-Z]{2}$"))
+			Expect(code.WriteOnly).To(BeTrue())
+
+			ref := schema.Properties["ref"]
+			Expect(ref.Ref).To(BeEmpty())
+			Expect(ref.Value.AllOf).To(HaveLen(1))
+			Expect(ref.Value.AllOf[0].Ref).To(Equal("#/components/schemas/Referenced"))
+			Expect(ref.Value.Default).To(Equal(map[string]any{"name": "a"}))
+		})
+
+		It("should generate a string enum specification", func() {
+			model := definitions.ModelMetadata{
+				Name:           "OrderStatus",
+				Description:    "The status of an order",
+				UnderlyingType: "string",
+				EnumValues:     []string{"pending", "shipped", "delivered"},
+			}
+
+			generateModelSpec(openapi, nil, model)
+
+			schemaRef := openapi.Components.Schemas["OrderStatus"]
+			Expect(schemaRef).NotTo(BeNil())
+			Expect(schemaRef.Value.Title).To(Equal("OrderStatus"))
+			Expect(schemaRef.Value.Description).To(Equal("The status of an order"))
+			Expect(schemaRef.Value.Type).To(Equal(&openapi3.Types{"string"}))
+			Expect(schemaRef.Value.Properties).To(BeEmpty())
+			Expect(schemaRef.Value.Enum).To(Equal([]any{"pending", "shipped", "delivered"}))
+		})
+
+		It("should generate an integer enum specification", func() {
+			model := definitions.ModelMetadata{
+				Name:           "Priority",
+				UnderlyingType: "int",
+				EnumValues:     []string{"0", "1", "2"},
+			}
+
+			generateModelSpec(openapi, nil, model)
+
+			schemaRef := openapi.Components.Schemas["Priority"]
+			Expect(schemaRef).NotTo(BeNil())
+			Expect(schemaRef.Value.Type).To(Equal(&openapi3.Types{"integer"}))
+			Expect(schemaRef.Value.Enum).To(Equal([]any{int64(0), int64(1), int64(2)}))
+		})
+
+		It("should generate a named primitive specification", func() {
+			model := definitions.ModelMetadata{
+				Name:           "Cents",
+				Description:    "An amount of money, in cents",
+				UnderlyingType: "int64",
+			}
+
+			generateModelSpec(openapi, nil, model)
+
+			schemaRef := openapi.Components.Schemas["Cents"]
+			Expect(schemaRef).NotTo(BeNil())
+			Expect(schemaRef.Value.Description).To(Equal("An amount of money, in cents"))
+			Expect(schemaRef.Value.Type).To(Equal(&openapi3.Types{"integer"}))
+			Expect(schemaRef.Value.Enum).To(BeEmpty())
+		})
+
+		It("should generate a polymorphic interface specification", func() {
+			model := definitions.ModelMetadata{
+				Name:          "Pet",
+				Description:   "A pet",
+				Discriminator: "kind",
+				OneOf: []definitions.OneOfMember{
+					{Name: "Cat", DiscriminatorValue: "cat", SchemaName: "Cat"},
+					{Name: "Dog", DiscriminatorValue: "dog", SchemaName: "Dog"},
+				},
+			}
+
+			generateModelSpec(openapi, nil, model)
+
+			schemaRef := openapi.Components.Schemas["Pet"]
+			Expect(schemaRef).NotTo(BeNil())
+			Expect(schemaRef.Value.Description).To(Equal("A pet"))
+			Expect(schemaRef.Value.Type).To(BeNil())
+			Expect(schemaRef.Value.OneOf).To(HaveLen(2))
+			Expect(schemaRef.Value.OneOf[0].Ref).To(Equal("#/components/schemas/Cat"))
+			Expect(schemaRef.Value.OneOf[1].Ref).To(Equal("#/components/schemas/Dog"))
+			Expect(schemaRef.Value.Discriminator.PropertyName).To(Equal("kind"))
+			Expect(schemaRef.Value.Discriminator.Mapping).To(Equal(map[string]string{
+				"cat": "#/components/schemas/Cat",
+				"dog": "#/components/schemas/Dog",
+			}))
+		})
+
+		It("should retain the description of polymorphic interfaces referenced by fields", func() {
+			pet := definitions.ModelMetadata{
+				Name:          "Pet",
+				Description:   "A pet",
+				Discriminator: "kind",
+				OneOf:         []definitions.OneOfMember{{Name: "Cat", DiscriminatorValue: "cat", SchemaName: "Cat"}},
+			}
+			owner := definitions.ModelMetadata{
+				Name:   "Owner",
+				Fields: []definitions.FieldMetadata{{Name: "Pet", Type: "Pet", Tag: `json:"pet" validate:"required"`}},
+			}
+
+			generateModelSpec(openapi, nil, pet)
+			generateModelSpec(openapi, nil, owner)
+
+			Expect(openapi.Components.Schemas["Owner"].Value.Properties["pet"].Ref).To(Equal("#/components/schemas/Pet"))
+			Expect(openapi.Components.Schemas["Pet"].Value.Description).To(Equal("A pet"))
+		})
 	})
 
 	Describe("GenerateModelsSpec", func() {
@@ -117,7 +306,7 @@ var _ = Describe("Swagen", func() {
 				},
 			}
 
-			err := GenerateModelsSpec(openapi, models)
+			err := GenerateModelsSpec(openapi, nil, models)
 			Expect(err).To(BeNil())
 
 			schemaRef1 := openapi.Components.Schemas["TestModel1"]
@@ -147,7 +336,7 @@ var _ = Describe("Swagen", func() {
 				},
 			}
 
-			err := GenerateModelsSpec(openapi, models)
+			err := GenerateModelsSpec(openapi, nil, models)
 			Expect(err).To(BeNil())
 
 			schemaRefC := openapi.Components.Schemas["ModelC"]
@@ -179,7 +368,7 @@ var _ = Describe("Swagen", func() {
 				},
 			}
 
-			err := GenerateModelsSpec(openapi, models)
+			err := GenerateModelsSpec(openapi, nil, models)
 			Expect(err).To(BeNil())
 
 			schemaRefC := openapi.Components.Schemas["ModelC"]
@@ -23,7 +23,7 @@ func createOperation(def definitions.ControllerMetadata, route definitions.Route
 	}
 }
 
-func createErrorResponse(openapi *openapi3.T, route definitions.RouteMetadata, errResp definitions.ErrorResponse) *openapi3.ResponseRef {
+func createErrorResponse(openapi *openapi3.T, typeMappings definitions.TypeMappings, route definitions.RouteMetadata, errResp definitions.ErrorResponse) *openapi3.ResponseRef {
 	errorReturnType := route.GetErrorReturnType()
 
 	// Every vanilla error should be RFC7807
@@ -32,7 +32,7 @@ func createErrorResponse(openapi *openapi3.T, route definitions.RouteMetadata, e
 		errorReturnType.Name = definitions.Rfc7807ErrorName
 	}
 
-	content := createContentWithSchemaRef(openapi, "", errorReturnType.Name)
+	content := createContentWithSchemaRef(openapi, typeMappings, "", errorReturnType.Name, []definitions.ContentType{definitions.ContentTypeJSON})
 	errResString := errResp.Description
 	response := &openapi3.Response{
 		Description: &errResString,
@@ -43,13 +43,26 @@ func createErrorResponse(openapi *openapi3.T, route definitions.RouteMetadata, e
 	}
 }
 
-func createContentWithSchemaRef(openapi *openapi3.T, validationString string, interfaceType string) openapi3.Content {
-	schemaRef := InterfaceToSchemaRef(openapi, interfaceType)
-	BuildSchemaValidation(schemaRef, validationString, interfaceType)
-	return openapi3.NewContentWithJSONSchemaRef(schemaRef)
+// createContentWithSchemaRef describes content of the given type in each of the given media types.
+// Content without any media types is described as application/json
+func createContentWithSchemaRef(
+	openapi *openapi3.T,
+	typeMappings definitions.TypeMappings,
+	validationString string,
+	interfaceType string,
+	contentTypes []definitions.ContentType,
+) openapi3.Content {
+	schemaRef := InterfaceToSchemaRef(openapi, typeMappings, interfaceType)
+	BuildSchemaValidation(schemaRef, typeMappings, validationString, interfaceType)
+
+	content := openapi3.Content{}
+	for _, contentType := range swagtool.GetContentTypesOrDefault(contentTypes) {
+		content[string(contentType)] = openapi3.NewMediaType().WithSchemaRef(schemaRef)
+	}
+	return content
 }
 
-func createResponseSuccess(openapi *openapi3.T, route definitions.RouteMetadata) *openapi3.ResponseRef {
+func createResponseSuccess(openapi *openapi3.T, typeMappings definitions.TypeMappings, route definitions.RouteMetadata) *openapi3.ResponseRef {
 
 	valueReturnType := route.GetValueReturnType()
 	if valueReturnType == nil {
@@ -59,7 +72,7 @@ func createResponseSuccess(openapi *openapi3.T, route definitions.RouteMetadata)
 			},
 		}
 	}
-	content := createContentWithSchemaRef(openapi, "", valueReturnType.Name)
+	content := createContentWithSchemaRef(openapi, typeMappings, "", valueReturnType.SchemaTypeName(), route.Produces)
 	return &openapi3.ResponseRef{
 		Value: &openapi3.Response{
 			Description: &route.ResponseDescription,
@@ -128,24 +141,30 @@ func handleRouteParamDeprecation(routeParam definitions.FuncParam, specParam *op
 	}
 }
 
-func createRouteParam(openapi *openapi3.T, param definitions.FuncParam) *openapi3.ParameterRef {
-	schemaRef := InterfaceToSchemaRef(openapi, param.TypeMeta.Name)
-	BuildSchemaValidation(schemaRef, param.Validator, param.TypeMeta.Name)
+func createRouteParam(openapi *openapi3.T, typeMappings definitions.TypeMappings, param definitions.FuncParam) *openapi3.ParameterRef {
+	schemaRef := InterfaceToSchemaRef(openapi, typeMappings, param.TypeMeta.SchemaTypeName())
+	BuildSchemaValidation(schemaRef, typeMappings, param.Validator, param.TypeMeta.SchemaTypeName())
+	schemaRef = withParamDefault(schemaRef, param)
 	specParam := &openapi3.ParameterRef{
 		Value: &openapi3.Parameter{
 			Name:        param.NameInSchema,
 			In:          strings.ToLower(string(param.PassedIn)),
 			Description: param.Description,
-			Required:    swagtool.IsFieldRequired(param.Validator),
+			Required:    swagtool.IsParamRequired(param),
 			Schema:      schemaRef,
 		},
 	}
+	if param.Style != "" {
+		explode := param.Explode
+		specParam.Value.Style = string(param.Style)
+		specParam.Value.Explode = &explode
+	}
 	handleRouteParamDeprecation(param, specParam)
 	return specParam
 }
 
-func createRequestBodyParam(openapi *openapi3.T, param definitions.FuncParam) *openapi3.RequestBodyRef {
-	content := createContentWithSchemaRef(openapi, param.Validator, param.TypeMeta.Name)
+func createRequestBodyParam(openapi *openapi3.T, typeMappings definitions.TypeMappings, param definitions.FuncParam, consumes []definitions.ContentType) *openapi3.RequestBodyRef {
+	content := createContentWithSchemaRef(openapi, typeMappings, param.Validator, param.TypeMeta.SchemaTypeName(), consumes)
 	return &openapi3.RequestBodyRef{
 		Value: &openapi3.RequestBody{
 			Description: param.Description,
@@ -155,7 +174,15 @@ func createRequestBodyParam(openapi *openapi3.T, param definitions.FuncParam) *o
 	}
 }
 
-func createRequestFormParam(openapi *openapi3.T, param definitions.FuncParam, operation *openapi3.Operation) {
+// createRequestFormParam describes a form parameter as a property of the form sent in the request body,
+// as the given media type (i.e., a URL encoded or multipart form)
+func createRequestFormParam(
+	openapi *openapi3.T,
+	typeMappings definitions.TypeMappings,
+	param definitions.FuncParam,
+	operation *openapi3.Operation,
+	contentType definitions.ContentType,
+) {
 	// Form parameters are always passed in the body, so we need to create a request body if it doesn't exist
 	if operation.RequestBody == nil {
 		// The body will be a object with the form parameters as properties
@@ -163,7 +190,7 @@ func createRequestFormParam(openapi *openapi3.T, param definitions.FuncParam, op
 		operation.RequestBody = &openapi3.RequestBodyRef{
 			Value: &openapi3.RequestBody{
 				Content: openapi3.Content{
-					string(definitions.ContentTypeFormURLEncoded): &openapi3.MediaType{
+					string(contentType): &openapi3.MediaType{
 						Schema: schemaRef,
 					},
 				},
@@ -172,30 +199,55 @@ func createRequestFormParam(openapi *openapi3.T, param definitions.FuncParam, op
 	}
 
 	// Get the schema from the request body
-	formSchema := operation.RequestBody.Value.Content[string(definitions.ContentTypeFormURLEncoded)].Schema
+	formSchema := operation.RequestBody.Value.Content[string(contentType)].Schema
 	// Create a new schema for the form parameter
-	propertySchemaRef := InterfaceToSchemaRef(openapi, param.TypeMeta.Name)
+	propertySchemaRef := InterfaceToSchemaRef(openapi, typeMappings, param.TypeMeta.SchemaTypeName())
 	// Add the validation to the schema
-	BuildSchemaValidation(propertySchemaRef, param.Validator, param.TypeMeta.Name)
+	BuildSchemaValidation(propertySchemaRef, typeMappings, param.Validator, param.TypeMeta.SchemaTypeName())
+	propertySchemaRef = withParamDefault(propertySchemaRef, param)
 	// Add the form parameter to the schema
 	formSchema.Value.Properties[param.NameInSchema] = propertySchemaRef
 	// Add the form parameter to the required list if it is required
-	if swagtool.IsFieldRequired(param.Validator) {
+	if swagtool.IsParamRequired(param) {
 		formSchema.Value.Required = append(formSchema.Value.Required, param.NameInSchema)
 	}
 }
 
-func generateParams(openapi *openapi3.T, route definitions.RouteMetadata, operation *openapi3.Operation) {
+// withParamDefault sets the default value of the given parameter, if any, on its schema.
+// As siblings of a '$ref' are ignored, references (e.g. enums) are wrapped in an 'allOf'
+func withParamDefault(schemaRef *openapi3.SchemaRef, param definitions.FuncParam) *openapi3.SchemaRef {
+	defaultValue := swagtool.GetParamDefault(param)
+	if defaultValue == nil {
+		return schemaRef
+	}
+
+	if schemaRef.Ref != "" {
+		schemaRef = &openapi3.SchemaRef{
+			Value: &openapi3.Schema{AllOf: openapi3.SchemaRefs{schemaRef}},
+		}
+	}
+	schemaRef.Value.Default = defaultValue
+	return schemaRef
+}
+
+func generateParams(openapi *openapi3.T, typeMappings definitions.TypeMappings, route definitions.RouteMetadata, operation *openapi3.Operation) {
 	// Iterate over FuncParams and create parameters
 	for _, param := range route.FuncParams {
 
 		switch param.PassedIn {
 		case definitions.PassedInBody:
-			operation.RequestBody = createRequestBodyParam(openapi, param)
-		case definitions.PassedInForm:
-			createRequestFormParam(openapi, param, operation)
+			operation.RequestBody = createRequestBodyParam(openapi, typeMappings, param, route.Consumes)
+		case definitions.PassedInForm, definitions.PassedInFormFile:
+			createRequestFormParam(openapi, typeMappings, param, operation, swagtool.GetFormContentType(route))
 		default:
-			operation.Parameters = append(operation.Parameters, createRouteParam(openapi, param))
+			if len(param.QueryFields) > 0 {
+				// Struct-typed query parameters are expanded to a parameter per field
+				for _, field := range param.QueryFields {
+					operation.Parameters = append(operation.Parameters, createRouteParam(openapi, typeMappings, field))
+				}
+				continue
+			}
+			operation.Parameters = append(operation.Parameters, createRouteParam(openapi, typeMappings, param))
 		}
 	}
 }
@@ -216,12 +268,12 @@ func generateControllerSpec(openapi *openapi3.T, config *definitions.OpenAPIGene
 		// Iterate over the error responses
 		for _, errResp := range route.ErrorResponses {
 			// Set the response using the Set method
-			operation.Responses.Set(swagtool.HttpStatusCodeToString(errResp.HttpStatusCode), createErrorResponse(openapi, route, errResp))
+			operation.Responses.Set(swagtool.HttpStatusCodeToString(errResp.HttpStatusCode), createErrorResponse(openapi, config.TypeMappings, route, errResp))
 		}
 
-		operation.Responses.Set(swagtool.HttpStatusCodeToString(route.ResponseSuccessCode), createResponseSuccess(openapi, route))
+		operation.Responses.Set(swagtool.HttpStatusCodeToString(route.ResponseSuccessCode), createResponseSuccess(openapi, config.TypeMappings, route))
 
-		generateParams(openapi, route, operation)
+		generateParams(openapi, config.TypeMappings, route, operation)
 
 		// Add the security requirement to the operation
 		if err := generateOperationSecurity(operation, config, route); err != nil {
@@ -68,7 +68,7 @@ var _ = Describe("Swagen", func() {
 				Description:    "Error occurred",
 				HttpStatusCode: 500,
 			}
-			responseRef := createErrorResponse(openapi, route, errResp)
+			responseRef := createErrorResponse(openapi, nil, route, errResp)
 			Expect(*responseRef.Value.Description).To(Equal("Error occurred"))
 			Expect(responseRef.Value.Content).To(Equal(openapi3.NewContentWithJSONSchema(openapi3.NewIntegerSchema())))
 		})
@@ -93,7 +93,7 @@ var _ = Describe("Swagen", func() {
 				},
 				ResponseSuccessCode: 200,
 			}
-			responseRef := createResponseSuccess(openapi, route)
+			responseRef := createResponseSuccess(openapi, nil, route)
 
 			Expect(*responseRef.Value.Description).To(Equal("Success1"))
 			Expect(responseRef.Value.Content).To(Equal(openapi3.NewContentWithJSONSchemaRef(ToOpenApiSchemaRef("integer"))))
@@ -323,7 +323,7 @@ var _ = Describe("Swagen", func() {
 				Validator: "required",
 			}
 
-			createRequestFormParam(openapi, param, operation)
+			createRequestFormParam(openapi, nil, param, operation, definitions.ContentTypeFormURLEncoded)
 
 			// Check if request body was created
 			Expect(operation.RequestBody).NotTo(BeNil())
@@ -349,7 +349,7 @@ var _ = Describe("Swagen", func() {
 					Name: "string",
 				},
 			}
-			createRequestFormParam(openapi, firstParam, operation)
+			createRequestFormParam(openapi, nil, firstParam, operation, definitions.ContentTypeFormURLEncoded)
 
 			// Add second parameter
 			secondParam := definitions.FuncParam{
@@ -359,7 +359,7 @@ var _ = Describe("Swagen", func() {
 				},
 				Validator: "required",
 			}
-			createRequestFormParam(openapi, secondParam, operation)
+			createRequestFormParam(openapi, nil, secondParam, operation, definitions.ContentTypeFormURLEncoded)
 
 			// Check if both parameters exist in schema
 			mediaType := operation.RequestBody.Value.Content[string(definitions.ContentTypeFormURLEncoded)]
@@ -381,7 +381,7 @@ var _ = Describe("Swagen", func() {
 				Validator: "required,min=5,max=10",
 			}
 
-			createRequestFormParam(openapi, param, operation)
+			createRequestFormParam(openapi, nil, param, operation, definitions.ContentTypeFormURLEncoded)
 
 			mediaType := operation.RequestBody.Value.Content[string(definitions.ContentTypeFormURLEncoded)]
 			propertySchema := mediaType.Schema.Value.Properties["validatedField"]
@@ -389,5 +389,71 @@ var _ = Describe("Swagen", func() {
 			// Check if required validation was applied
 			Expect(mediaType.Schema.Value.Required).To(ContainElement("validatedField"))
 		})
+
+		It("should describe form files as binary strings in a multipart form", func() {
+			operation := &openapi3.Operation{}
+			param := definitions.FuncParam{
+				NameInSchema: "avatar",
+				PassedIn:     definitions.PassedInFormFile,
+				ParamMeta: definitions.ParamMeta{
+					Name: "avatar",
+					TypeMeta: definitions.TypeMetadata{
+						Name:                  "FileHeader",
+						FullyQualifiedPackage: "mime/multipart",
+						IsByAddress:           true,
+					},
+				},
+			}
+
+			createRequestFormParam(openapi, nil, param, operation, definitions.ContentTypeMultipartForm)
+
+			Expect(operation.RequestBody.Value.Content).To(HaveKey(string(definitions.ContentTypeMultipartForm)))
+			mediaType := operation.RequestBody.Value.Content[string(definitions.ContentTypeMultipartForm)]
+			propertySchema := mediaType.Schema.Value.Properties["avatar"]
+			Expect(propertySchema.Value.Type.Is("string")).To(BeTrue())
+			Expect(propertySchema.Value.Format).To(Equal("binary"))
+			Expect(mediaType.Schema.Value.Required).To(BeEmpty())
+		})
+	})
+
+	Describe("createRouteParam", func() {
+		It("should set the style and explode of slice parameters", func() {
+			element := definitions.TypeMetadata{Name: "int", IsUniverseType: true}
+			param := definitions.FuncParam{
+				NameInSchema: "ids",
+				PassedIn:     definitions.PassedInQuery,
+				ParamMeta: definitions.ParamMeta{
+					Name:     "ids",
+					TypeMeta: definitions.TypeMetadata{Name: "[]int", ElementType: &element},
+				},
+				Style:   definitions.ParamStylePipeDelimited,
+				Explode: false,
+			}
+
+			specParam := createRouteParam(openapi, nil, param)
+
+			Expect(specParam.Value.In).To(Equal("query"))
+			Expect(specParam.Value.Style).To(Equal("pipeDelimited"))
+			Expect(specParam.Value.Explode).NotTo(BeNil())
+			Expect(*specParam.Value.Explode).To(BeFalse())
+			Expect(specParam.Value.Schema.Value.Type.Is("array")).To(BeTrue())
+			Expect(specParam.Value.Schema.Value.Items.Value.Type.Is("integer")).To(BeTrue())
+		})
+
+		It("should not set a style for non-slice parameters", func() {
+			param := definitions.FuncParam{
+				NameInSchema: "id",
+				PassedIn:     definitions.PassedInQuery,
+				ParamMeta: definitions.ParamMeta{
+					Name:     "id",
+					TypeMeta: definitions.TypeMetadata{Name: "int", IsUniverseType: true},
+				},
+			}
+
+			specParam := createRouteParam(openapi, nil, param)
+
+			Expect(specParam.Value.Style).To(BeEmpty())
+			Expect(specParam.Value.Explode).To(BeNil())
+		})
 	})
 })
@@ -1,7 +1,10 @@
 package swagen30
 
 import (
+	"encoding/json"
+
 	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gopher-fleece/gleece/definitions"
 	"github.com/gopher-fleece/gleece/generator/swagen/swagtool"
 	"github.com/gopher-fleece/gleece/infrastructure/logger"
 )
@@ -14,11 +17,21 @@ type SchemaRefMap struct {
 // Create map of SchemaRefMap to store the schema references
 var schemaRefMap = []SchemaRefMap{} // Initialize as an empty slice
 
-func InterfaceToSchemaRef(openapi *openapi3.T, interfaceType string) *openapi3.SchemaRef {
-	openapiType := swagtool.ToOpenApiType(interfaceType)
+func InterfaceToSchemaRef(openapi *openapi3.T, typeMappings definitions.TypeMappings, interfaceType string) *openapi3.SchemaRef {
+	if wellKnownType, isWellKnown := typeMappings.GetWellKnownType(interfaceType); isWellKnown {
+		return &openapi3.SchemaRef{Value: toWellKnownTypeSchema(wellKnownType)}
+	}
+
+	openapiType := swagtool.ToOpenApiType(typeMappings, interfaceType)
 	fieldSchemaRef := ToOpenApiSchemaRef(openapiType)
 
-	if openapiType == "object" && !swagtool.IsMapObject(interfaceType) { // For now, ignore map objects, they will be handled later
+	if swagtool.IsMapObject(interfaceType) {
+		// Maps are objects whose properties are all described by the schema of the map's values
+		valueType := swagtool.GetMapValueType(interfaceType)
+		if !swagtool.IsAnyType(valueType) {
+			fieldSchemaRef.Value.AdditionalProperties = openapi3.AdditionalProperties{Schema: InterfaceToSchemaRef(openapi, typeMappings, valueType)}
+		}
+	} else if openapiType == "object" {
 		// Handle other types or complex types as references to other schemas
 		fieldSchemaRef = &openapi3.SchemaRef{
 			Ref: "#/components/schemas/" + interfaceType,
@@ -35,7 +48,7 @@ func InterfaceToSchemaRef(openapi *openapi3.T, interfaceType string) *openapi3.S
 		// Handle array types
 		itemType := swagtool.GetArrayItemType(interfaceType)
 		// Once the item type is determined, create a schema reference for it in a recursive manner
-		itemSchemaRef := InterfaceToSchemaRef(openapi, itemType)
+		itemSchemaRef := InterfaceToSchemaRef(openapi, typeMappings, itemType)
 		fieldSchemaRef = &openapi3.SchemaRef{
 			Value: &openapi3.Schema{
 				Type:  arrayType,
@@ -67,6 +80,36 @@ func ToOpenApiSchema(typeName string) *openapi3.Schema {
 	}
 }
 
+// toWellKnownTypeSchema creates the dedicated schema of a well-known type such as 'time.Time'
+func toWellKnownTypeSchema(wellKnownType definitions.WellKnownType) *openapi3.Schema {
+	if wellKnownType.Schema != nil {
+		return fragmentToSchema(wellKnownType.Schema)
+	}
+
+	schema := openapi3.NewSchema()
+	if wellKnownType.OpenApiType != "" {
+		schema = ToOpenApiSchema(wellKnownType.OpenApiType)
+	}
+
+	schema.Format = wellKnownType.OpenApiFormat
+	return schema
+}
+
+// fragmentToSchema converts a user-provided schema fragment (see 'typeMappings') to a schema
+func fragmentToSchema(fragment map[string]any) *openapi3.Schema {
+	schema := openapi3.NewSchema()
+
+	fragmentJson, err := json.Marshal(fragment)
+	if err == nil {
+		err = json.Unmarshal(fragmentJson, schema)
+	}
+
+	if err != nil {
+		logger.Warn("Could not convert type mapping schema %v - %v", fragment, err)
+	}
+	return schema
+}
+
 func ToOpenApiSchemaRef(typeName string) *openapi3.SchemaRef {
 	schema := ToOpenApiSchema(typeName)
 	return &openapi3.SchemaRef{
@@ -20,35 +20,52 @@ var _ = Describe("Spec Common", func() {
 		})
 
 		It("should return a schema ref for a string type", func() {
-			schemaRef := InterfaceToSchemaRef(openapi, "string")
+			schemaRef := InterfaceToSchemaRef(openapi, nil, "string")
 			Expect(schemaRef.Value).To(Equal(openapi3.NewStringSchema()))
 		})
 
 		It("should return a schema ref for an object type", func() {
 			openapi.Components.Schemas["testObject"] = &openapi3.SchemaRef{Value: openapi3.NewObjectSchema()}
-			schemaRef := InterfaceToSchemaRef(openapi, "testObject")
+			schemaRef := InterfaceToSchemaRef(openapi, nil, "testObject")
 			Expect(schemaRef.Ref).To(Equal("#/components/schemas/testObject"))
 		})
 
 		It("should handle nested schema references", func() {
-			schemaRef := InterfaceToSchemaRef(openapi, "[]string")
+			schemaRef := InterfaceToSchemaRef(openapi, nil, "[]string")
 			Expect(schemaRef.Value.Items.Value).To(Equal(openapi3.NewStringSchema()))
 		})
 
 		It("should handle nested-nested schema references", func() {
-			schemaRef := InterfaceToSchemaRef(openapi, "[][]string")
+			schemaRef := InterfaceToSchemaRef(openapi, nil, "[][]string")
 			Expect(schemaRef.Value.Items.Value.Items.Value).To(Equal(openapi3.NewStringSchema()))
 		})
 
 		It("should handle nested-nested-nested int references", func() {
-			schemaRef := InterfaceToSchemaRef(openapi, "[][][]int")
+			schemaRef := InterfaceToSchemaRef(openapi, nil, "[][][]int")
 			Expect(schemaRef.Value.Items.Value.Items.Value.Items.Value).To(Equal(openapi3.NewIntegerSchema()))
 		})
 
 		It("should handle nested schema references", func() {
-			schemaRef := InterfaceToSchemaRef(openapi, "[]testObject")
+			schemaRef := InterfaceToSchemaRef(openapi, nil, "[]testObject")
 			Expect(schemaRef.Value.Items.Ref).To(Equal("#/components/schemas/testObject"))
 		})
+
+		It("should describe map values via additional properties", func() {
+			schemaRef := InterfaceToSchemaRef(openapi, nil, "map[string]testObject")
+			Expect(schemaRef.Value.Type).To(Equal(&openapi3.Types{"object"}))
+			Expect(schemaRef.Value.AdditionalProperties.Schema.Ref).To(Equal("#/components/schemas/testObject"))
+		})
+
+		It("should describe nested map values via additional properties", func() {
+			schemaRef := InterfaceToSchemaRef(openapi, nil, "map[string][]map[string]int")
+			itemsRef := schemaRef.Value.AdditionalProperties.Schema.Value.Items
+			Expect(itemsRef.Value.AdditionalProperties.Schema.Value).To(Equal(openapi3.NewIntegerSchema()))
+		})
+
+		It("should not restrict the values of maps holding any value", func() {
+			schemaRef := InterfaceToSchemaRef(openapi, nil, "map[string]any")
+			Expect(schemaRef.Value.AdditionalProperties.Schema).To(BeNil())
+		})
 	})
 
 	Describe("ToOpenApiSchema", func() {
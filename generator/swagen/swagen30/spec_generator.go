@@ -53,7 +53,7 @@ func GenerateSpec(config *definitions.OpenAPIGeneratorConfig, defs []definitions
 	}
 	logger.Info("Security spec generated successfully")
 
-	if err := GenerateModelsSpec(openapi, models); err != nil {
+	if err := GenerateModelsSpec(openapi, config.TypeMappings, models); err != nil {
 		logger.Error("Failed to generate models spec - %v", err)
 		return nil, err
 	}
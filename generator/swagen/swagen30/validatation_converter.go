@@ -5,11 +5,12 @@ import (
 	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gopher-fleece/gleece/definitions"
 	"github.com/gopher-fleece/gleece/generator/swagen/swagtool"
 	"github.com/gopher-fleece/gleece/infrastructure/logger"
 )
 
-func BuildSchemaValidation(schema *openapi3.SchemaRef, validationString string, fieldInterface string) {
+func BuildSchemaValidation(schema *openapi3.SchemaRef, typeMappings definitions.TypeMappings, validationString string, fieldInterface string) {
 	// Parse and apply validation rules from the Validator field
 	validationRules := strings.Split(validationString, ",")
 	for _, rule := range validationRules {
@@ -20,7 +21,7 @@ func BuildSchemaValidation(schema *openapi3.SchemaRef, validationString string,
 			ruleValue = parts[1]
 		}
 
-		specType := swagtool.ToOpenApiType(fieldInterface)
+		specType := swagtool.ToOpenApiType(typeMappings, fieldInterface)
 		switch ruleName {
 		case "email":
 			if specType == "string" {
@@ -1,14 +1,77 @@
 package swagen31
 
 import (
+	"slices"
+
 	"github.com/gopher-fleece/gleece/definitions"
 	"github.com/gopher-fleece/gleece/generator/swagen/swagtool"
 	highbase "github.com/pb33f/libopenapi/datamodel/high/base"
 	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
 	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
 )
 
-func generateModelSpec(doc *v3.Document, model definitions.ModelMetadata) {
+// generateNamedPrimitiveSpec describes a named primitive model by the universe type it's declared over.
+// Enums additionally list their values
+func generateNamedPrimitiveSpec(doc *v3.Document, model definitions.ModelMetadata) {
+	isDeprecated := swagtool.IsDeprecated(&model.Deprecation)
+	openapiType := swagtool.ToOpenApiType(nil, model.UnderlyingType)
+	highbaseSchema := &highbase.Schema{
+		Title:       model.Name,
+		Description: model.Description,
+		Type:        []string{openapiType},
+		Deprecated:  &isDeprecated,
+	}
+
+	valueTag := "!!str"
+	if openapiType == "integer" {
+		valueTag = "!!int"
+	}
+
+	for _, value := range model.EnumValues {
+		highbaseSchema.Enum = append(highbaseSchema.Enum, &yaml.Node{
+			Kind:  yaml.ScalarNode,
+			Value: value,
+			Tag:   valueTag,
+		})
+	}
+
+	doc.Components.Schemas.Set(model.Name, highbase.CreateSchemaProxy(highbaseSchema))
+}
+
+// generatePolymorphicSpec describes an interface model as a 'oneOf' its members, discriminated by the model's discriminator property
+func generatePolymorphicSpec(doc *v3.Document, typeMappings definitions.TypeMappings, model definitions.ModelMetadata) {
+	isDeprecated := swagtool.IsDeprecated(&model.Deprecation)
+	highbaseSchema := &highbase.Schema{
+		Title:       model.Name,
+		Description: model.Description,
+		Deprecated:  &isDeprecated,
+		Discriminator: &highbase.Discriminator{
+			PropertyName: model.Discriminator,
+			Mapping:      orderedmap.New[string, string](),
+		},
+	}
+
+	for _, member := range model.OneOf {
+		memberSchemaRef := InterfaceToSchemaV3(doc, typeMappings, member.SchemaName)
+		highbaseSchema.OneOf = append(highbaseSchema.OneOf, memberSchemaRef)
+		highbaseSchema.Discriminator.Mapping.Set(member.DiscriminatorValue, memberSchemaRef.GetReference())
+	}
+
+	doc.Components.Schemas.Set(model.Name, highbase.CreateSchemaProxy(highbaseSchema))
+}
+
+func generateModelSpec(doc *v3.Document, typeMappings definitions.TypeMappings, model definitions.ModelMetadata) {
+	if model.IsNamedPrimitive() {
+		generateNamedPrimitiveSpec(doc, model)
+		return
+	}
+
+	if model.IsPolymorphic() {
+		generatePolymorphicSpec(doc, typeMappings, model)
+		return
+	}
+
 	isDeprecated := swagtool.IsDeprecated(&model.Deprecation)
 	highbaseSchema := &highbase.Schema{
 		Title:       model.Name,
@@ -24,30 +87,145 @@ func generateModelSpec(doc *v3.Document, model definitions.ModelMetadata) {
 		fName := swagtool.GetJsonNameFromTag(field.Tag, field.Name)
 		validationTag := swagtool.GetTagValue(field.Tag, "validate", "")
 
-		if swagtool.IsFieldRequired(validationTag) {
+		if swagtool.IsJsonFieldRequired(field.Tag) {
 			requiredFields = append(requiredFields, fName)
 		}
 
-		fieldSchemaRef := InterfaceToSchemaV3(doc, field.Type)
+		// Fields tagged ',string' are encoded as JSON strings. Their validations apply to the decoded value and are not described
+		isStringEncoded := swagtool.IsJsonStringEncoded(field.Tag, field.Type)
+		schemaType := field.Type
+		if isStringEncoded {
+			schemaType = "string"
+		}
+
+		fieldSchemaRef := InterfaceToSchemaV3(doc, typeMappings, schemaType)
+		if field.IsByAddress {
+			fieldSchemaRef = toNullableSchemaProxy(fieldSchemaRef)
+		}
+
+		xmlName, isXmlAttribute := swagtool.GetXmlNameFromTag(field.Tag, field.Name)
+		hasXmlName := xmlName != "" || isXmlAttribute
+
+		// References cannot be amended and the field's annotated values are instead set on an 'allOf' wrapping it
+		if (field.HasValueAnnotations() || hasXmlName) && fieldSchemaRef.IsReference() {
+			fieldSchemaRef = highbase.CreateSchemaProxy(&highbase.Schema{
+				AllOf: []*highbase.SchemaProxy{fieldSchemaRef},
+			})
+		}
 
 		innerSchema := fieldSchemaRef.Schema()
 
 		if innerSchema != nil {
-			BuildSchemaValidationV31(innerSchema, validationTag, field.Type)
+			if !isStringEncoded {
+				BuildSchemaValidationV31(innerSchema, typeMappings, validationTag, field.Type)
+			}
 			innerSchema.Description = field.Description
 			isFieldDeprecated := swagtool.IsDeprecated(field.Deprecation)
 			innerSchema.Deprecated = &isFieldDeprecated
+			applyValueAnnotations(innerSchema, field)
+
+			if hasXmlName {
+				applyXmlName(innerSchema, xmlName, isXmlAttribute)
+			}
 		}
 		highbaseSchema.Properties.Set(fName, fieldSchemaRef)
 	}
 
 	highbaseSchema.Required = requiredFields
+	if len(model.EmbeddedModels) > 0 {
+		highbaseSchema = composeWithEmbeddedModels(doc, typeMappings, highbaseSchema, model.EmbeddedModels)
+	}
+
+	if model.XmlName != "" {
+		highbaseSchema.XML = &highbase.XML{Name: model.XmlName}
+	}
+
 	doc.Components.Schemas.Set(model.Name, highbase.CreateSchemaProxy(highbaseSchema))
 }
 
-func GenerateModelsSpec(doc *v3.Document, models []definitions.ModelMetadata) error {
+// applyValueAnnotations sets the example and default values, the format, the pattern and the access mode
+// given by the annotations of a field on the field's schema
+func applyValueAnnotations(schema *highbase.Schema, field definitions.FieldMetadata) {
+	if field.Example != nil {
+		schema.Examples = []*yaml.Node{toYamlNode(field.Example)}
+	}
+	if field.Default != nil {
+		schema.Default = toYamlNode(field.Default)
+	}
+	if field.Format != "" {
+		schema.Format = field.Format
+	}
+	if field.Pattern != "" {
+		schema.Pattern = field.Pattern
+	}
+	if field.ReadOnly {
+		schema.ReadOnly = &field.ReadOnly
+	}
+	if field.WriteOnly {
+		schema.WriteOnly = &field.WriteOnly
+	}
+}
+
+// applyXmlName sets the name a field is encoded by in XML on the field's schema.
+// The elements of arrays are named individually and their schema is amended instead
+func applyXmlName(schema *highbase.Schema, name string, isAttribute bool) {
+	if slices.Contains(schema.Type, "array") && schema.Items != nil && schema.Items.IsA() {
+		if schema.Items.A.IsReference() {
+			schema.Items.A = highbase.CreateSchemaProxy(&highbase.Schema{
+				AllOf: []*highbase.SchemaProxy{schema.Items.A},
+			})
+		}
+		schema = schema.Items.A.Schema()
+	}
+
+	schema.XML = &highbase.XML{Name: name, Attribute: isAttribute}
+}
+
+// composeWithEmbeddedModels combines references to the given embedded models with the model's own schema via 'allOf'
+func composeWithEmbeddedModels(doc *v3.Document, typeMappings definitions.TypeMappings, schema *highbase.Schema, embeddedModels []string) *highbase.Schema {
+	composed := &highbase.Schema{
+		Title:       schema.Title,
+		Description: schema.Description,
+		Deprecated:  schema.Deprecated,
+	}
+
+	for _, embeddedModel := range embeddedModels {
+		composed.AllOf = append(composed.AllOf, InterfaceToSchemaV3(doc, typeMappings, embeddedModel))
+	}
+
+	ownSchema := &highbase.Schema{
+		Type:       schema.Type,
+		Properties: schema.Properties,
+		Required:   schema.Required,
+	}
+	composed.AllOf = append(composed.AllOf, highbase.CreateSchemaProxy(ownSchema))
+
+	return composed
+}
+
+// toNullableSchemaProxy adds 'null' to the types allowed by the given schema.
+// References cannot be amended and are instead combined with a 'null' schema via 'oneOf'
+func toNullableSchemaProxy(schemaProxy *highbase.SchemaProxy) *highbase.SchemaProxy {
+	if !schemaProxy.IsReference() {
+		// A schema without a type already allows any value, including 'null'
+		schema := schemaProxy.Schema()
+		if len(schema.Type) > 0 {
+			schema.Type = append(schema.Type, "null")
+		}
+		return schemaProxy
+	}
+
+	return highbase.CreateSchemaProxy(&highbase.Schema{
+		OneOf: []*highbase.SchemaProxy{
+			schemaProxy,
+			highbase.CreateSchemaProxy(&highbase.Schema{Type: []string{"null"}}),
+		},
+	})
+}
+
+func GenerateModelsSpec(doc *v3.Document, typeMappings definitions.TypeMappings, models []definitions.ModelMetadata) error {
 	for _, model := range models {
-		generateModelSpec(doc, model)
+		generateModelSpec(doc, typeMappings, model)
 	}
 	return nil
 }
@@ -30,7 +30,7 @@ var _ = Describe("swagen31", func() {
 						Name:        "Field1",
 						Type:        "string",
 						Description: "A string field",
-						Tag:         `json:"field1,omitempty" validate:"required"`,
+						Tag:         `json:"field1" validate:"required"`,
 					},
 					{
 						Name:        "field2",
@@ -41,7 +41,7 @@ var _ = Describe("swagen31", func() {
 				},
 			}
 
-			generateModelSpec(doc, model)
+			generateModelSpec(doc, nil, model)
 
 			schemaRef, found := doc.Components.Schemas.Get("TestModel")
 			Expect(found).To(BeTrue())
@@ -64,6 +64,28 @@ var _ = Describe("swagen31", func() {
 			Expect(schema.Required).NotTo(ContainElement("field2"))
 		})
 
+		It("should honor the 'omitempty' and 'string' json tag options", func() {
+			model := definitions.ModelMetadata{
+				Name: "JsonTagsModel",
+				Fields: []definitions.FieldMetadata{
+					{Name: "Name", Type: "string", Tag: `json:"name" validate:"required"`},
+					{Name: "Nickname", Type: "string", Tag: `json:"nickname,omitempty" validate:"required"`},
+					{Name: "Count", Type: "int", Tag: `json:"count,string" validate:"gt=10"`},
+				},
+			}
+
+			generateModelSpec(doc, nil, model)
+
+			schemaRef, found := doc.Components.Schemas.Get("JsonTagsModel")
+			Expect(found).To(BeTrue())
+			schema := schemaRef.Schema()
+			Expect(schema.Required).To(ConsistOf("name"))
+			countSchema, found := schema.Properties.Get("count")
+			Expect(found).To(BeTrue())
+			Expect(countSchema.Schema().Type).To(Equal([]string{"string"}))
+			Expect(countSchema.Schema().ExclusiveMinimum).To(BeNil())
+		})
+
 		It("should generate a model with references to other models", func() {
 			model1 := definitions.ModelMetadata{
 				Name:        "ModelA",
@@ -81,8 +103,8 @@ var _ = Describe("swagen31", func() {
 				},
 			}
 
-			generateModelSpec(doc, model1)
-			generateModelSpec(doc, model2)
+			generateModelSpec(doc, nil, model1)
+			generateModelSpec(doc, nil, model2)
 
 			schemaRef1, found := doc.Components.Schemas.Get("ModelA")
 			Expect(found).To(BeTrue())
@@ -96,6 +118,165 @@ var _ = Describe("swagen31", func() {
 			Expect(found).To(BeTrue())
 			Expect(modelARef.GetReference()).To(Equal("#/components/schemas/ModelA"))
 		})
+
+		It("should generate nullable schemas for pointer fields", func() {
+			model := definitions.ModelMetadata{
+				Name: "WithPointers",
+				Fields: []definitions.FieldMetadata{
+					{Name: "optionalName", Type: "string", Description: "An optional name", IsByAddress: true},
+					{Name: "requiredName", Type: "string", Tag: `validate:"required"`, IsByAddress: true},
+					{Name: "optionalRef", Type: "Referenced", Description: "An optional reference", IsByAddress: true},
+					{Name: "plainName", Type: "string"},
+				},
+			}
+
+			generateModelSpec(doc, nil, model)
+
+			schemaRef, found := doc.Components.Schemas.Get("WithPointers")
+			Expect(found).To(BeTrue())
+			schema := schemaRef.Schema()
+
+			optionalName, found := schema.Properties.Get("optionalName")
+			Expect(found).To(BeTrue())
+			Expect(optionalName.Schema().Type).To(Equal([]string{"string", "null"}))
+			Expect(optionalName.Schema().Description).To(Equal("An optional name"))
+
+			optionalRef, found := schema.Properties.Get("optionalRef")
+			Expect(found).To(BeTrue())
+			Expect(optionalRef.IsReference()).To(BeFalse())
+			Expect(optionalRef.Schema().Description).To(Equal("An optional reference"))
+			Expect(optionalRef.Schema().OneOf).To(HaveLen(2))
+			Expect(optionalRef.Schema().OneOf[0].GetReference()).To(Equal("#/components/schemas/Referenced"))
+			Expect(optionalRef.Schema().OneOf[1].Schema().Type).To(Equal([]string{"null"}))
+
+			plainName, found := schema.Properties.Get("plainName")
+			Expect(found).To(BeTrue())
+			Expect(plainName.Schema().Type).To(Equal([]string{"string"}))
+
+			Expect(schema.Required).To(Equal([]string{"requiredName"}))
+		})
+
+		It("should apply the annotated values of fields", func() {
+			model := definitions.ModelMetadata{
+				Name: "WithValues",
+				Fields: []definitions.FieldMetadata{
+					{Name: "pageSize", Type: "int", Example: int64(42), Default: int64(10), ReadOnly: true},
+					{Name: "code", Type: "string", Format: "iso-3166", Pattern: "^[A-Z]{2}$", WriteOnly: true},
+					{Name: "ref", Type: "Referenced", Default: map[string]any{"name": "a"}},
+				},
+			}
+
+			generateModelSpec(doc, nil, model)
+
+			schemaRef, found := doc.Components.Schemas.Get("WithValues")
+			Expect(found).To(BeTrue())
+			schema := schemaRef.Schema()
+
+			pageSize, _ := schema.Properties.Get("pageSize")
+			Expect(pageSize.Schema().Examples).To(HaveLen(1))
+			Expect(pageSize.Schema().Examples[0].Value).To(Equal("42"))
+			Expect(pageSize.Schema().Default.Value).To(Equal("10"))
+			Expect(*pageSize.Schema().ReadOnly).To(BeTrue())
+			Expect(pageSize.Schema().WriteOnly).To(BeNil())
+
+			code, _ := schema.Properties.Get("code")
+			Expect(code.Schema().Format).To(Equal("iso-3166"))
+			Expect(code.Schema().Pattern).To(Equal("^[A-Z]{2}$"))
+			Expect(*code.Schema().WriteOnly).To(BeTrue())
+
+			ref, _ := schema.Properties.Get("ref")
+			Expect(ref.IsReference()).To(BeFalse())
+			Expect(ref.Schema().AllOf).To(HaveLen(1))
+			Expect(ref.Schema().AllOf[0].GetReference()).To(Equal("#/components/schemas/Referenced"))
+			Expect(ref.Schema().Default).NotTo(BeNil())
+		})
+
+		It("should generate a string enum specification", func() {
+			model := definitions.ModelMetadata{
+				Name:           "OrderStatus",
+				Description:    "The status of an order",
+				UnderlyingType: "string",
+				EnumValues:     []string{"pending", "shipped", "delivered"},
+			}
+
+			generateModelSpec(doc, nil, model)
+
+			schemaRef, found := doc.Components.Schemas.Get("OrderStatus")
+			Expect(found).To(BeTrue())
+			schema := schemaRef.Schema()
+			Expect(schema.Title).To(Equal("OrderStatus"))
+			Expect(schema.Description).To(Equal("The status of an order"))
+			Expect(schema.Type).To(Equal([]string{"string"}))
+			Expect(schema.Properties).To(BeNil())
+			Expect(schema.Enum).To(HaveLen(3))
+			Expect(schema.Enum[0].Value).To(Equal("pending"))
+			Expect(schema.Enum[0].Tag).To(Equal("!!str"))
+			Expect(schema.Enum[2].Value).To(Equal("delivered"))
+		})
+
+		It("should generate an integer enum specification", func() {
+			model := definitions.ModelMetadata{
+				Name:           "Priority",
+				UnderlyingType: "int",
+				EnumValues:     []string{"0", "1", "2"},
+			}
+
+			generateModelSpec(doc, nil, model)
+
+			schemaRef, found := doc.Components.Schemas.Get("Priority")
+			Expect(found).To(BeTrue())
+			schema := schemaRef.Schema()
+			Expect(schema.Type).To(Equal([]string{"integer"}))
+			Expect(schema.Enum).To(HaveLen(3))
+			Expect(schema.Enum[1].Value).To(Equal("1"))
+			Expect(schema.Enum[1].Tag).To(Equal("!!int"))
+		})
+
+		It("should generate a named primitive specification", func() {
+			model := definitions.ModelMetadata{
+				Name:           "Cents",
+				Description:    "An amount of money, in cents",
+				UnderlyingType: "int64",
+			}
+
+			generateModelSpec(doc, nil, model)
+
+			schemaRef, found := doc.Components.Schemas.Get("Cents")
+			Expect(found).To(BeTrue())
+			schema := schemaRef.Schema()
+			Expect(schema.Description).To(Equal("An amount of money, in cents"))
+			Expect(schema.Type).To(Equal([]string{"integer"}))
+			Expect(schema.Enum).To(BeEmpty())
+		})
+
+		It("should generate a polymorphic interface specification", func() {
+			model := definitions.ModelMetadata{
+				Name:          "Pet",
+				Description:   "A pet",
+				Discriminator: "kind",
+				OneOf: []definitions.OneOfMember{
+					{Name: "Cat", DiscriminatorValue: "cat", SchemaName: "Cat"},
+					{Name: "Dog", DiscriminatorValue: "dog", SchemaName: "Dog"},
+				},
+			}
+
+			generateModelSpec(doc, nil, model)
+
+			schemaRef, found := doc.Components.Schemas.Get("Pet")
+			Expect(found).To(BeTrue())
+			schema := schemaRef.Schema()
+			Expect(schema.Description).To(Equal("A pet"))
+			Expect(schema.Type).To(BeEmpty())
+			Expect(schema.OneOf).To(HaveLen(2))
+			Expect(schema.OneOf[0].GetReference()).To(Equal("#/components/schemas/Cat"))
+			Expect(schema.OneOf[1].GetReference()).To(Equal("#/components/schemas/Dog"))
+			Expect(schema.Discriminator.PropertyName).To(Equal("kind"))
+
+			catRef, _ := schema.Discriminator.Mapping.Get("cat")
+			Expect(catRef).To(Equal("#/components/schemas/Cat"))
+			dogRef, _ := schema.Discriminator.Mapping.Get("dog")
+			Expect(dogRef).To(Equal("#/components/schemas/Dog"))
+		})
 	})
 
 	Describe("GenerateModelsSpec", func() {
@@ -127,7 +308,7 @@ var _ = Describe("swagen31", func() {
 				},
 			}
 
-			err := GenerateModelsSpec(doc, models)
+			err := GenerateModelsSpec(doc, nil, models)
 			Expect(err).To(BeNil())
 
 			schemaRef1, found := doc.Components.Schemas.Get("TestModel1")
@@ -157,7 +338,7 @@ var _ = Describe("swagen31", func() {
 				},
 			}
 
-			err := GenerateModelsSpec(doc, models)
+			err := GenerateModelsSpec(doc, nil, models)
 			Expect(err).To(BeNil())
 
 			schemaRefC, found := doc.Components.Schemas.Get("ModelC")
@@ -191,7 +372,7 @@ var _ = Describe("swagen31", func() {
 				},
 			}
 
-			err := GenerateModelsSpec(doc, models)
+			err := GenerateModelsSpec(doc, nil, models)
 			Expect(err).To(BeNil())
 
 			schemaRefC, found := doc.Components.Schemas.Get("ModelC")
@@ -28,7 +28,7 @@ func createOperation(def definitions.ControllerMetadata, route definitions.Route
 	}
 }
 
-func createErrorResponse(doc *v3.Document, route definitions.RouteMetadata, errResp definitions.ErrorResponse) *v3.Response {
+func createErrorResponse(doc *v3.Document, typeMappings definitions.TypeMappings, route definitions.RouteMetadata, errResp definitions.ErrorResponse) *v3.Response {
 	errorReturnType := route.GetErrorReturnType()
 
 	// Every vanilla error should be RFC7807
@@ -37,7 +37,7 @@ func createErrorResponse(doc *v3.Document, route definitions.RouteMetadata, errR
 		errorReturnType.Name = definitions.Rfc7807ErrorName
 	}
 
-	content := createContentWithSchemaRef(doc, "", errorReturnType.Name)
+	content := createContentWithSchemaRef(doc, typeMappings, "", errorReturnType.Name, []definitions.ContentType{definitions.ContentTypeJSON})
 
 	return &v3.Response{
 		Description: ToResponseDescription(errResp.Description),
@@ -45,20 +45,30 @@ func createErrorResponse(doc *v3.Document, route definitions.RouteMetadata, errR
 	}
 }
 
-func createContentWithSchemaRef(doc *v3.Document, validationString string, interfaceType string) *orderedmap.Map[string, *v3.MediaType] {
-	schemaRef := InterfaceToSchemaV3(doc, interfaceType)
+// createContentWithSchemaRef describes content of the given type in each of the given media types.
+// Content without any media types is described as application/json
+func createContentWithSchemaRef(
+	doc *v3.Document,
+	typeMappings definitions.TypeMappings,
+	validationString string,
+	interfaceType string,
+	contentTypes []definitions.ContentType,
+) *orderedmap.Map[string, *v3.MediaType] {
+	schemaRef := InterfaceToSchemaV3(doc, typeMappings, interfaceType)
 	if schemaRef.Schema() != nil {
-		BuildSchemaValidationV31(schemaRef.Schema(), validationString, interfaceType)
+		BuildSchemaValidationV31(schemaRef.Schema(), typeMappings, validationString, interfaceType)
 	}
 
 	content := orderedmap.New[string, *v3.MediaType]()
-	content.Set(string(definitions.ContentTypeJSON), &v3.MediaType{
-		Schema: schemaRef,
-	})
+	for _, contentType := range swagtool.GetContentTypesOrDefault(contentTypes) {
+		content.Set(string(contentType), &v3.MediaType{
+			Schema: schemaRef,
+		})
+	}
 	return content
 }
 
-func createResponseSuccess(doc *v3.Document, route definitions.RouteMetadata) *v3.Response {
+func createResponseSuccess(doc *v3.Document, typeMappings definitions.TypeMappings, route definitions.RouteMetadata) *v3.Response {
 	valueReturnType := route.GetValueReturnType()
 
 	if valueReturnType == nil {
@@ -67,7 +77,7 @@ func createResponseSuccess(doc *v3.Document, route definitions.RouteMetadata) *v
 		}
 	}
 
-	content := createContentWithSchemaRef(doc, "", valueReturnType.Name)
+	content := createContentWithSchemaRef(doc, typeMappings, "", valueReturnType.SchemaTypeName(), route.Produces)
 	return &v3.Response{
 		Description: ToResponseDescription(route.ResponseDescription),
 		Content:     content,
@@ -166,12 +176,13 @@ func handleRouteParamDeprecation(routeParam definitions.FuncParam, specParam *v3
 	}
 }
 
-func createRouteParam(doc *v3.Document, param definitions.FuncParam) *v3.Parameter {
-	schemaRef := InterfaceToSchemaV3(doc, param.TypeMeta.Name)
+func createRouteParam(doc *v3.Document, typeMappings definitions.TypeMappings, param definitions.FuncParam) *v3.Parameter {
+	schemaRef := InterfaceToSchemaV3(doc, typeMappings, param.TypeMeta.SchemaTypeName())
 	if schemaRef.Schema() != nil {
-		BuildSchemaValidationV31(schemaRef.Schema(), param.Validator, param.TypeMeta.Name)
+		BuildSchemaValidationV31(schemaRef.Schema(), typeMappings, param.Validator, param.TypeMeta.SchemaTypeName())
 	}
-	isParamRequired := swagtool.IsFieldRequired(param.Validator)
+	schemaRef = withParamDefault(schemaRef, param)
+	isParamRequired := swagtool.IsParamRequired(param)
 
 	specParam := &v3.Parameter{
 		Name:        param.NameInSchema,
@@ -180,12 +191,17 @@ func createRouteParam(doc *v3.Document, param definitions.FuncParam) *v3.Paramet
 		Required:    &isParamRequired,
 		Schema:      schemaRef,
 	}
+	if param.Style != "" {
+		explode := param.Explode
+		specParam.Style = string(param.Style)
+		specParam.Explode = &explode
+	}
 	handleRouteParamDeprecation(param, specParam)
 	return specParam
 }
 
-func createRequestBodyParam(doc *v3.Document, param definitions.FuncParam) *v3.RequestBody {
-	content := createContentWithSchemaRef(doc, param.Validator, param.TypeMeta.Name)
+func createRequestBodyParam(doc *v3.Document, typeMappings definitions.TypeMappings, param definitions.FuncParam, consumes []definitions.ContentType) *v3.RequestBody {
+	content := createContentWithSchemaRef(doc, typeMappings, param.Validator, param.TypeMeta.SchemaTypeName(), consumes)
 	isBodyRequired := swagtool.IsFieldRequired(param.Validator)
 	return &v3.RequestBody{
 		Description: param.Description,
@@ -194,14 +210,16 @@ func createRequestBodyParam(doc *v3.Document, param definitions.FuncParam) *v3.R
 	}
 }
 
-func createRequestFormParam(doc *v3.Document, param definitions.FuncParam, operation *v3.Operation) {
+// createRequestFormParam describes a form parameter as a property of the form sent in the request body,
+// as the given media type (i.e., a URL encoded or multipart form)
+func createRequestFormParam(doc *v3.Document, typeMappings definitions.TypeMappings, param definitions.FuncParam, operation *v3.Operation, contentType definitions.ContentType) {
 	// Form parameters are always passed in the body, so we need to create a request body if it doesn't exist
 	if operation.RequestBody == nil {
 		// The body will be a object with the form parameters as properties
 		schemaRef := ToOpenApiSchemaV3("object")
 		schemaRef.Properties = orderedmap.New[string, *highbase.SchemaProxy]()
 		content := orderedmap.New[string, *v3.MediaType]()
-		content.Set(string(definitions.ContentTypeFormURLEncoded), &v3.MediaType{
+		content.Set(string(contentType), &v3.MediaType{
 			Schema: highbase.CreateSchemaProxy(schemaRef),
 		})
 		operation.RequestBody = &v3.RequestBody{
@@ -211,31 +229,56 @@ func createRequestFormParam(doc *v3.Document, param definitions.FuncParam, opera
 	}
 
 	// Get the schema from the request body
-	formMedia, _ := operation.RequestBody.Content.Get(string(definitions.ContentTypeFormURLEncoded))
+	formMedia, _ := operation.RequestBody.Content.Get(string(contentType))
 	formSchema := formMedia.Schema.Schema()
 	// Create a new schema for the form parameter
-	propertySchemaRef := InterfaceToSchemaV3(doc, param.TypeMeta.Name)
+	propertySchemaRef := InterfaceToSchemaV3(doc, typeMappings, param.TypeMeta.SchemaTypeName())
 	// Add the validation to the schema
-	BuildSchemaValidationV31(propertySchemaRef.Schema(), param.Validator, param.TypeMeta.Name)
+	BuildSchemaValidationV31(propertySchemaRef.Schema(), typeMappings, param.Validator, param.TypeMeta.SchemaTypeName())
+	propertySchemaRef = withParamDefault(propertySchemaRef, param)
 	// Add the form parameter to the schema
 	formSchema.Properties.Set(param.NameInSchema, propertySchemaRef)
 	// Add the form parameter to the required list if it is required
-	if swagtool.IsFieldRequired(param.Validator) {
+	if swagtool.IsParamRequired(param) {
 		formSchema.Required = append(formSchema.Required, param.NameInSchema)
 	}
 }
 
-func generateParams(doc *v3.Document, route definitions.RouteMetadata, operation *v3.Operation) {
+// withParamDefault sets the default value of the given parameter, if any, on its schema.
+// As references (e.g. enums) cannot be amended, they are wrapped in an 'allOf'
+func withParamDefault(schemaRef *highbase.SchemaProxy, param definitions.FuncParam) *highbase.SchemaProxy {
+	defaultValue := swagtool.GetParamDefault(param)
+	if defaultValue == nil {
+		return schemaRef
+	}
+
+	if schemaRef.IsReference() {
+		schemaRef = highbase.CreateSchemaProxy(&highbase.Schema{
+			AllOf: []*highbase.SchemaProxy{schemaRef},
+		})
+	}
+	schemaRef.Schema().Default = toYamlNode(defaultValue)
+	return schemaRef
+}
+
+func generateParams(doc *v3.Document, typeMappings definitions.TypeMappings, route definitions.RouteMetadata, operation *v3.Operation) {
 	// Iterate over FuncParams and create parameters
 	for _, param := range route.FuncParams {
 
 		switch param.PassedIn {
 		case definitions.PassedInBody:
-			operation.RequestBody = createRequestBodyParam(doc, param)
-		case definitions.PassedInForm:
-			createRequestFormParam(doc, param, operation)
+			operation.RequestBody = createRequestBodyParam(doc, typeMappings, param, route.Consumes)
+		case definitions.PassedInForm, definitions.PassedInFormFile:
+			createRequestFormParam(doc, typeMappings, param, operation, swagtool.GetFormContentType(route))
 		default:
-			operation.Parameters = append(operation.Parameters, createRouteParam(doc, param))
+			if len(param.QueryFields) > 0 {
+				// Struct-typed query parameters are expanded to a parameter per field
+				for _, field := range param.QueryFields {
+					operation.Parameters = append(operation.Parameters, createRouteParam(doc, typeMappings, field))
+				}
+				continue
+			}
+			operation.Parameters = append(operation.Parameters, createRouteParam(doc, typeMappings, param))
 		}
 	}
 }
@@ -256,15 +299,15 @@ func generateControllerSpec(doc *v3.Document, config *definitions.OpenAPIGenerat
 		// Iterate over the error responses
 		for _, errResp := range route.ErrorResponses {
 			// Set the response using the Set method
-			operation.Responses.Codes.Set(swagtool.HttpStatusCodeToString(errResp.HttpStatusCode), createErrorResponse(doc, route, errResp))
+			operation.Responses.Codes.Set(swagtool.HttpStatusCodeToString(errResp.HttpStatusCode), createErrorResponse(doc, config.TypeMappings, route, errResp))
 		}
 
-		successResponse := createResponseSuccess(doc, route)
+		successResponse := createResponseSuccess(doc, config.TypeMappings, route)
 		operation.Responses.Codes.Set(swagtool.HttpStatusCodeToString(route.ResponseSuccessCode), successResponse)
 
 		// operation.Responses.Default - for now, we do not support "default" response
 
-		generateParams(doc, route, operation)
+		generateParams(doc, config.TypeMappings, route, operation)
 
 		// Add the security requirement to the operation
 		if err := generateOperationSecurity(operation, config, route); err != nil {
@@ -1,12 +1,20 @@
 package swagen31
 
 import (
+	"context"
+	"encoding/json"
 	"strings"
 
+	"github.com/gopher-fleece/gleece/definitions"
 	"github.com/gopher-fleece/gleece/generator/swagen/swagtool"
+	"github.com/gopher-fleece/gleece/infrastructure/logger"
 	"github.com/pb33f/libopenapi-validator/errors"
 	highbase "github.com/pb33f/libopenapi/datamodel/high/base"
 	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/datamodel/low"
+	lowbase "github.com/pb33f/libopenapi/datamodel/low/base"
+	"github.com/pb33f/libopenapi/index"
+	"gopkg.in/yaml.v3"
 )
 
 func ToOpenApiSchemaV3(typeName string) *highbase.Schema {
@@ -15,19 +23,88 @@ func ToOpenApiSchemaV3(typeName string) *highbase.Schema {
 	}
 }
 
-func InterfaceToSchemaV3(doc *v3.Document, interfaceType string) *highbase.SchemaProxy {
+// toWellKnownTypeSchema creates the dedicated schema of a well-known type such as 'time.Time'
+func toWellKnownTypeSchema(wellKnownType definitions.WellKnownType) *highbase.Schema {
+	if wellKnownType.Schema != nil {
+		return fragmentToSchema(wellKnownType.Schema)
+	}
+
+	schema := &highbase.Schema{Format: wellKnownType.OpenApiFormat}
+	if wellKnownType.OpenApiType != "" {
+		schema.Type = []string{wellKnownType.OpenApiType}
+	}
+	return schema
+}
+
+// fragmentToSchema converts a user-provided schema fragment (see 'typeMappings') to a schema
+func fragmentToSchema(fragment map[string]any) *highbase.Schema {
+	schema, err := buildSchemaFromFragment(fragment)
+	if err != nil {
+		logger.Warn("Could not convert type mapping schema %v - %v", fragment, err)
+		return &highbase.Schema{}
+	}
+	return schema
+}
+
+func buildSchemaFromFragment(fragment map[string]any) (*highbase.Schema, error) {
+	// JSON is a subset of YAML and, as opposed to a YAML encoding of the map, retains the fragment's value types
+	fragmentJson, err := json.Marshal(fragment)
+	if err != nil {
+		return nil, err
+	}
 
-	openapiType := swagtool.ToOpenApiType(interfaceType)
+	var document yaml.Node
+	if err := yaml.Unmarshal(fragmentJson, &document); err != nil {
+		return nil, err
+	}
+
+	root := document.Content[0]
+	var lowSchema lowbase.Schema
+	if err := low.BuildModel(root, &lowSchema); err != nil {
+		return nil, err
+	}
+
+	specIndex := index.NewSpecIndexWithConfig(root, index.CreateOpenAPIIndexConfig())
+	if err := lowSchema.Build(context.Background(), root, specIndex); err != nil {
+		return nil, err
+	}
+
+	return highbase.NewSchema(&lowSchema), nil
+}
+
+// toYamlNode converts a value, e.g. a field's example value, to the node it's rendered as
+func toYamlNode(value any) *yaml.Node {
+	node := &yaml.Node{}
+	if err := node.Encode(value); err != nil {
+		logger.Warn("Could not convert value %v - %v", value, err)
+	}
+	return node
+}
+
+func InterfaceToSchemaV3(doc *v3.Document, typeMappings definitions.TypeMappings, interfaceType string) *highbase.SchemaProxy {
+	if wellKnownType, isWellKnown := typeMappings.GetWellKnownType(interfaceType); isWellKnown {
+		return highbase.CreateSchemaProxy(toWellKnownTypeSchema(wellKnownType))
+	}
+
+	openapiType := swagtool.ToOpenApiType(typeMappings, interfaceType)
 	fieldSchema := ToOpenApiSchemaV3(openapiType)
 
-	if openapiType == "object" && !swagtool.IsMapObject(interfaceType) { // For now, ignore map objects, they will be handled later
+	if swagtool.IsMapObject(interfaceType) {
+		// Maps are objects whose properties are all described by the schema of the map's values
+		valueType := swagtool.GetMapValueType(interfaceType)
+		if !swagtool.IsAnyType(valueType) {
+			fieldSchema.AdditionalProperties = &highbase.DynamicValue[*highbase.SchemaProxy, bool]{
+				A: InterfaceToSchemaV3(doc, typeMappings, valueType),
+			}
+		}
+	} else if openapiType == "object" {
 		return highbase.CreateSchemaProxyRef("#/components/schemas/" + interfaceType)
 	}
 	if openapiType == "array" {
 		// Handle array types
 		itemType := swagtool.GetArrayItemType(interfaceType)
 		// Once the item type is determined, create a schema reference for it in a recursive manner
-		itemSchemaRef := InterfaceToSchemaV3(doc, itemType)
+		itemSchemaRef := InterfaceToSchemaV3(doc, typeMappings, itemType)
 		fieldSchema.Items = &highbase.DynamicValue[*highbase.SchemaProxy, bool]{
 			A: itemSchemaRef,
 		}
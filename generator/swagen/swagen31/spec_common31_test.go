@@ -22,24 +22,24 @@ var _ = Describe("Spec V3.1 Common", func() {
 		})
 
 		It("should return a schema for a string type", func() {
-			schema := InterfaceToSchemaV3(doc, "string")
+			schema := InterfaceToSchemaV3(doc, nil, "string")
 			Expect(schema.Schema().Type).To(Equal([]string{"string"}))
 		})
 
 		It("should return a schema ref for an object type", func() {
-			schemaProxy := InterfaceToSchemaV3(doc, "testObject")
+			schemaProxy := InterfaceToSchemaV3(doc, nil, "testObject")
 			Expect(schemaProxy.GetReference()).To(Equal("#/components/schemas/testObject"))
 		})
 
 		It("should handle nested schema references", func() {
-			schemaProxy := InterfaceToSchemaV3(doc, "[]string")
+			schemaProxy := InterfaceToSchemaV3(doc, nil, "[]string")
 			arraySchema := schemaProxy.Schema()
 			Expect(arraySchema.Type).To(Equal([]string{"array"}))
 			Expect(arraySchema.Items.A.Schema().Type).To(Equal([]string{"string"}))
 		})
 
 		It("should handle nested-nested schema references", func() {
-			schemaProxy := InterfaceToSchemaV3(doc, "[][]string")
+			schemaProxy := InterfaceToSchemaV3(doc, nil, "[][]string")
 			arraySchema := schemaProxy.Schema()
 			Expect(arraySchema.Type).To(Equal([]string{"array"}))
 			nestedArraySchema := arraySchema.Items.A.Schema()
@@ -48,7 +48,7 @@ var _ = Describe("Spec V3.1 Common", func() {
 		})
 
 		It("should handle nested-nested-nested int references", func() {
-			schemaProxy := InterfaceToSchemaV3(doc, "[][][]int")
+			schemaProxy := InterfaceToSchemaV3(doc, nil, "[][][]int")
 			arraySchema := schemaProxy.Schema()
 			Expect(arraySchema.Type).To(Equal([]string{"array"}))
 			nestedArraySchema := arraySchema.Items.A.Schema()
@@ -59,11 +59,28 @@ var _ = Describe("Spec V3.1 Common", func() {
 		})
 
 		It("should handle nested schema references with objects", func() {
-			schemaProxy := InterfaceToSchemaV3(doc, "[]testObject")
+			schemaProxy := InterfaceToSchemaV3(doc, nil, "[]testObject")
 			arraySchema := schemaProxy.Schema()
 			Expect(arraySchema.Type).To(Equal([]string{"array"}))
 			Expect(arraySchema.Items.A.GetReference()).To(Equal("#/components/schemas/testObject"))
 		})
+
+		It("should describe map values via additional properties", func() {
+			schema := InterfaceToSchemaV3(doc, nil, "map[string]testObject").Schema()
+			Expect(schema.Type).To(Equal([]string{"object"}))
+			Expect(schema.AdditionalProperties.A.GetReference()).To(Equal("#/components/schemas/testObject"))
+		})
+
+		It("should describe nested map values via additional properties", func() {
+			schema := InterfaceToSchemaV3(doc, nil, "map[string][]map[string]int").Schema()
+			itemsSchema := schema.AdditionalProperties.A.Schema().Items.A.Schema()
+			Expect(itemsSchema.AdditionalProperties.A.Schema().Type).To(Equal([]string{"integer"}))
+		})
+
+		It("should not restrict the values of maps holding any value", func() {
+			schema := InterfaceToSchemaV3(doc, nil, "map[string]any").Schema()
+			Expect(schema.AdditionalProperties).To(BeNil())
+		})
 	})
 
 	Describe("ToResponseDescription", func() {
@@ -57,7 +57,7 @@ func GenerateSpec(config *definitions.OpenAPIGeneratorConfig, defs []definitions
 	}
 	logger.Info("Security spec v3.1 generated successfully")
 
-	if err := GenerateModelsSpec(doc, models); err != nil {
+	if err := GenerateModelsSpec(doc, config.TypeMappings, models); err != nil {
 		logger.Error("Failed to generate models v3.1 spec - %v", err)
 		return nil, err
 	}
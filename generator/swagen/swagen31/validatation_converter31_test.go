@@ -17,92 +17,92 @@ var _ = Describe("Validation Converter Spec", func() {
 		})
 
 		It("should apply email format validation", func() {
-			BuildSchemaValidationV31(schema, "email", "string")
+			BuildSchemaValidationV31(schema, nil, "email", "string")
 			Expect(schema.Format).To(Equal("email"))
 		})
 
 		It("should apply email format validation while other irrelevant exists", func() {
-			BuildSchemaValidationV31(schema, "email,required,other=5", "string")
+			BuildSchemaValidationV31(schema, nil, "email,required,other=5", "string")
 			Expect(schema.Format).To(Equal("email"))
 		})
 
 		Context("String format validations", func() {
 			It("should apply uuid format validation", func() {
-				BuildSchemaValidationV31(schema, "uuid", "string")
+				BuildSchemaValidationV31(schema, nil, "uuid", "string")
 				Expect(schema.Format).To(Equal("uuid"))
 			})
 
 			It("should apply ip format validation", func() {
-				BuildSchemaValidationV31(schema, "ip", "string")
+				BuildSchemaValidationV31(schema, nil, "ip", "string")
 				Expect(schema.Format).To(Equal("ipv4"))
 			})
 
 			It("should apply ipv4 format validation", func() {
-				BuildSchemaValidationV31(schema, "ipv4", "string")
+				BuildSchemaValidationV31(schema, nil, "ipv4", "string")
 				Expect(schema.Format).To(Equal("ipv4"))
 			})
 
 			It("should apply ipv6 format validation", func() {
-				BuildSchemaValidationV31(schema, "ipv6", "string")
+				BuildSchemaValidationV31(schema, nil, "ipv6", "string")
 				Expect(schema.Format).To(Equal("ipv6"))
 			})
 
 			It("should apply hostname format validation", func() {
-				BuildSchemaValidationV31(schema, "hostname", "string")
+				BuildSchemaValidationV31(schema, nil, "hostname", "string")
 				Expect(schema.Format).To(Equal("hostname"))
 			})
 
 			It("should apply date format validation", func() {
-				BuildSchemaValidationV31(schema, "date", "string")
+				BuildSchemaValidationV31(schema, nil, "date", "string")
 				Expect(schema.Format).To(Equal("date"))
 			})
 
 			It("should apply datetime format validation", func() {
-				BuildSchemaValidationV31(schema, "datetime", "string")
+				BuildSchemaValidationV31(schema, nil, "datetime", "string")
 				Expect(schema.Format).To(Equal("date-time"))
 			})
 		})
 
 		Context("Numeric validations", func() {
 			It("should apply greater than validation", func() {
-				BuildSchemaValidationV31(schema, "gt=10", "int")
+				BuildSchemaValidationV31(schema, nil, "gt=10", "int")
 				val := float64(10)
 				Expect(schema.ExclusiveMinimum.B).To(Equal(val))
 				Expect(schema.ExclusiveMinimum.N).To(Equal(1))
 			})
 
 			It("should apply greater than or equal validation", func() {
-				BuildSchemaValidationV31(schema, "gte=10", "int")
+				BuildSchemaValidationV31(schema, nil, "gte=10", "int")
 				val := float64(10)
 				Expect(schema.Minimum).To(Equal(&val))
 			})
 
 			It("should apply less than validation", func() {
-				BuildSchemaValidationV31(schema, "lt=20", "int")
+				BuildSchemaValidationV31(schema, nil, "lt=20", "int")
 				val := float64(20)
 				Expect(schema.ExclusiveMaximum.B).To(Equal(val))
 			})
 
 			It("should apply less than or equal validation", func() {
-				BuildSchemaValidationV31(schema, "lte=20", "int")
+				BuildSchemaValidationV31(schema, nil, "lte=20", "int")
 				val := float64(20)
 				Expect(schema.Maximum).To(Equal(&val))
 			})
 
 			It("should apply min value validation for numbers", func() {
-				BuildSchemaValidationV31(schema, "min=5", "int")
+				BuildSchemaValidationV31(schema, nil, "min=5", "int")
 				val := float64(5)
 				Expect(schema.Minimum).To(Equal(&val))
 			})
 
 			It("should apply max value validation for numbers", func() {
-				BuildSchemaValidationV31(schema, "max=10", "int")
+				BuildSchemaValidationV31(schema, nil, "max=10", "int")
 				val := float64(10)
 				Expect(schema.Maximum).To(Equal(&val))
 			})
 
 			It("should handle multiple numeric validations", func() {
-				BuildSchemaValidationV31(schema, "gt=5,lt=10", "int")
+				BuildSchemaValidationV31(schema, nil, "gt=5,lt=10", "int")
 				valMin := float64(5)
 				valMax := float64(10)
 				Expect(schema.ExclusiveMinimum.B).To(Equal(valMin))
@@ -112,31 +112,31 @@ var _ = Describe("Validation Converter Spec", func() {
 
 		Context("String validations", func() {
 			It("should apply min length validation for strings", func() {
-				BuildSchemaValidationV31(schema, "min=5", "string")
+				BuildSchemaValidationV31(schema, nil, "min=5", "string")
 				val := int64(5)
 				Expect(schema.MinLength).To(Equal(&val))
 			})
 
 			It("should apply max length validation for strings", func() {
-				BuildSchemaValidationV31(schema, "max=10", "string")
+				BuildSchemaValidationV31(schema, nil, "max=10", "string")
 				val := int64(10)
 				Expect(schema.MaxLength).To(Equal(&val))
 			})
 
 			It("should apply len validation for strings", func() {
-				BuildSchemaValidationV31(schema, "len=8", "string")
+				BuildSchemaValidationV31(schema, nil, "len=8", "string")
 				val := int64(8)
 				Expect(schema.MinLength).To(Equal(&val))
 				Expect(schema.MaxLength).To(Equal(&val))
 			})
 
 			It("should apply pattern validation for strings", func() {
-				BuildSchemaValidationV31(schema, "pattern=^[a-z]+$", "string")
+				BuildSchemaValidationV31(schema, nil, "pattern=^[a-z]+$", "string")
 				Expect(schema.Pattern).To(Equal("^[a-z]+$"))
 			})
 
 			It("should not apply numeric validations to strings", func() {
-				BuildSchemaValidationV31(schema, "gt=10", "string")
+				BuildSchemaValidationV31(schema, nil, "gt=10", "string")
 				Expect(schema.Minimum).To(BeNil())
 				Expect(schema.ExclusiveMinimum).To(BeNil())
 			})
@@ -144,25 +144,25 @@ var _ = Describe("Validation Converter Spec", func() {
 
 		Context("Array validations", func() {
 			It("should apply min items validation for arrays", func() {
-				BuildSchemaValidationV31(schema, "minItems=2", "[]string")
+				BuildSchemaValidationV31(schema, nil, "minItems=2", "[]string")
 				val := int64(2)
 				Expect(schema.MinItems).To(Equal(&val))
 			})
 
 			It("should apply max items validation for arrays", func() {
-				BuildSchemaValidationV31(schema, "maxItems=5", "[]int")
+				BuildSchemaValidationV31(schema, nil, "maxItems=5", "[]int")
 				val := int64(5)
 				Expect(schema.MaxItems).To(Equal(&val))
 			})
 
 			It("should apply unique items validation for arrays", func() {
-				BuildSchemaValidationV31(schema, "uniqueItems=true", "[]something")
+				BuildSchemaValidationV31(schema, nil, "uniqueItems=true", "[]something")
 				val := true
 				Expect(schema.UniqueItems).To(Equal(&val))
 			})
 
 			It("should not apply numeric validations to arrays", func() {
-				BuildSchemaValidationV31(schema, "gt=10", "[]int")
+				BuildSchemaValidationV31(schema, nil, "gt=10", "[]int")
 				Expect(schema.Minimum).To(BeNil())
 				Expect(schema.ExclusiveMinimum).To(BeNil())
 			})
@@ -170,7 +170,7 @@ var _ = Describe("Validation Converter Spec", func() {
 
 		Context("Enum validations", func() {
 			It("should apply enum validation for strings", func() {
-				BuildSchemaValidationV31(schema, "enum=a|b|c", "string")
+				BuildSchemaValidationV31(schema, nil, "enum=a|b|c", "string")
 				expectedEnums := []*yaml.Node{
 					{Kind: yaml.ScalarNode, Value: "a"},
 					{Kind: yaml.ScalarNode, Value: "b"},
@@ -184,14 +184,14 @@ var _ = Describe("Validation Converter Spec", func() {
 			})
 
 			It("should handle empty enum values", func() {
-				BuildSchemaValidationV31(schema, "enum=", "string")
+				BuildSchemaValidationV31(schema, nil, "enum=", "string")
 				Expect(schema.Enum).To(BeEmpty())
 			})
 		})
 
 		Context("Multiple validations", func() {
 			It("should handle multiple validation rules correctly", func() {
-				BuildSchemaValidationV31(schema, "min=5,max=10,pattern=^[a-z]+$", "string")
+				BuildSchemaValidationV31(schema, nil, "min=5,max=10,pattern=^[a-z]+$", "string")
 				valMin := int64(5)
 				valMax := int64(10)
 				Expect(schema.MinLength).To(Equal(&valMin))
@@ -202,7 +202,7 @@ var _ = Describe("Validation Converter Spec", func() {
 
 		Context("OneOf validations", func() {
 			It("should apply oneof validation for strings", func() {
-				BuildSchemaValidationV31(schema, "oneof=pending active completed", "string")
+				BuildSchemaValidationV31(schema, nil, "oneof=pending active completed", "string")
 				expectedEnums := []*yaml.Node{
 					{Kind: yaml.ScalarNode, Value: "pending"},
 					{Kind: yaml.ScalarNode, Value: "active"},
@@ -216,7 +216,7 @@ var _ = Describe("Validation Converter Spec", func() {
 			})
 
 			It("should apply oneof validation for integers", func() {
-				BuildSchemaValidationV31(schema, "oneof=1 2 3", "int")
+				BuildSchemaValidationV31(schema, nil, "oneof=1 2 3", "int")
 				expectedEnums := []*yaml.Node{
 					{Kind: yaml.ScalarNode, Value: "1", Tag: "!!int"},
 					{Kind: yaml.ScalarNode, Value: "2", Tag: "!!int"},
@@ -231,7 +231,7 @@ var _ = Describe("Validation Converter Spec", func() {
 			})
 
 			It("should apply oneof validation for numbers", func() {
-				BuildSchemaValidationV31(schema, "oneof=1.5 2.5 3.5", "float64")
+				BuildSchemaValidationV31(schema, nil, "oneof=1.5 2.5 3.5", "float64")
 				expectedEnums := []*yaml.Node{
 					{Kind: yaml.ScalarNode, Value: "1.5", Tag: "!!float"},
 					{Kind: yaml.ScalarNode, Value: "2.5", Tag: "!!float"},
@@ -246,12 +246,12 @@ var _ = Describe("Validation Converter Spec", func() {
 			})
 
 			It("should handle empty oneof values", func() {
-				BuildSchemaValidationV31(schema, "oneof=", "string")
+				BuildSchemaValidationV31(schema, nil, "oneof=", "string")
 				Expect(schema.Enum).To(BeEmpty())
 			})
 
 			It("should handle oneof with single value", func() {
-				BuildSchemaValidationV31(schema, "oneof=single", "string")
+				BuildSchemaValidationV31(schema, nil, "oneof=single", "string")
 				expectedEnum := &yaml.Node{Kind: yaml.ScalarNode, Value: "single"}
 				Expect(schema.Enum).To(HaveLen(1))
 				Expect(schema.Enum[0].Kind).To(Equal(expectedEnum.Kind))
@@ -259,7 +259,7 @@ var _ = Describe("Validation Converter Spec", func() {
 			})
 
 			It("should handle oneof with multiple spaces", func() {
-				BuildSchemaValidationV31(schema, "oneof=a   b     c", "string")
+				BuildSchemaValidationV31(schema, nil, "oneof=a   b     c", "string")
 				expectedEnums := []*yaml.Node{
 					{Kind: yaml.ScalarNode, Value: "a"},
 					{Kind: yaml.ScalarNode, Value: "b"},
@@ -273,7 +273,7 @@ var _ = Describe("Validation Converter Spec", func() {
 			})
 
 			It("should handle oneof with mixed numeric values", func() {
-				BuildSchemaValidationV31(schema, "oneof=1 invalid 3", "int")
+				BuildSchemaValidationV31(schema, nil, "oneof=1 invalid 3", "int")
 				expectedEnums := []*yaml.Node{
 					{Kind: yaml.ScalarNode, Value: "1", Tag: "!!int"},
 					{Kind: yaml.ScalarNode, Value: "3", Tag: "!!int"},
@@ -287,7 +287,7 @@ var _ = Describe("Validation Converter Spec", func() {
 			})
 
 			It("should handle oneof while other validations exist", func() {
-				BuildSchemaValidationV31(schema, "required,oneof=a b c,min=1", "string")
+				BuildSchemaValidationV31(schema, nil, "required,oneof=a b c,min=1", "string")
 				expectedEnums := []*yaml.Node{
 					{Kind: yaml.ScalarNode, Value: "a"},
 					{Kind: yaml.ScalarNode, Value: "b"},
@@ -302,7 +302,7 @@ var _ = Describe("Validation Converter Spec", func() {
 
 			It("should handle oneof validation for non-standard types", func() {
 				// Using a type that doesn't match string/integer/number
-				BuildSchemaValidationV31(schema, "oneof=true false maybe", "bool")
+				BuildSchemaValidationV31(schema, nil, "oneof=true false maybe", "bool")
 
 				expectedEnums := []*yaml.Node{
 					{Kind: yaml.ScalarNode, Value: "true"},
@@ -5,12 +5,13 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/gopher-fleece/gleece/definitions"
 	"github.com/gopher-fleece/gleece/generator/swagen/swagtool"
 	"github.com/pb33f/libopenapi/datamodel/high/base"
 	"gopkg.in/yaml.v3"
 )
 
-func BuildSchemaValidationV31(schema *base.Schema, validationString string, fieldInterface string) {
+func BuildSchemaValidationV31(schema *base.Schema, typeMappings definitions.TypeMappings, validationString string, fieldInterface string) {
 	// Parse and apply validation rules from the Validator field
 	validationRules := strings.Split(validationString, ",")
 	for _, rule := range validationRules {
@@ -21,7 +22,7 @@ func BuildSchemaValidationV31(schema *base.Schema, validationString string, fiel
 			ruleValue = parts[1]
 		}
 
-		specType := swagtool.ToOpenApiType(fieldInterface)
+		specType := swagtool.ToOpenApiType(typeMappings, fieldInterface)
 		switch ruleName {
 		case "email", "uuid", "ip", "ipv4", "ipv6", "hostname", "date", "datetime":
 			if specType == "string" {
@@ -1,6 +1,9 @@
 package swagtool
 
 import (
+	"reflect"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/gopher-fleece/gleece/definitions"
@@ -65,7 +68,11 @@ func IsPrimitiveType(typeName string) bool {
 	}
 }
 
-func ToOpenApiType(typeName string) string {
+func ToOpenApiType(typeMappings definitions.TypeMappings, typeName string) string {
+	if wellKnownType, isWellKnown := typeMappings.GetWellKnownType(typeName); isWellKnown {
+		return wellKnownType.OpenApiType
+	}
+
 	switch typeName {
 	case "string":
 		return "string"
@@ -145,16 +152,76 @@ func GetTagValue(tagStr string, tagName string, defaultValue string) string {
 }
 
 // Since the json tag can have multiple values (e.g. `json: "name,omitempty"`), this function returns the first value as the name, only.
+// As with encoding/json, a tag without a name (e.g. `json:",omitempty"`) yields the default name
 func GetJsonNameFromTag(tag string, defaultName string) string {
-	fullTagValue := GetTagValue(tag, "json", defaultName)
-	// Split by comma and return the first value
-	return strings.Split(fullTagValue, ",")[0]
+	name, _, _ := strings.Cut(reflect.StructTag(tag).Get("json"), ",")
+	if name == "" {
+		return defaultName
+	}
+	return name
+}
+
+// HasJsonTagOption returns whether the json tag has the given option, e.g. 'omitempty' for `json:"name,omitempty"`
+func HasJsonTagOption(tag string, option string) bool {
+	_, options, hasOptions := strings.Cut(reflect.StructTag(tag).Get("json"), ",")
+	return hasOptions && slices.Contains(strings.Split(options, ","), option)
+}
+
+// IsJsonStringEncoded returns whether a field of the given type is encoded as a JSON string via the ',string' tag option.
+// As with encoding/json, the option only applies to string, numeric and boolean fields
+func IsJsonStringEncoded(tag string, fieldType string) bool {
+	return IsPrimitiveType(fieldType) && HasJsonTagOption(tag, "string")
+}
+
+// GetXmlNameFromTag returns the name of the XML element or attribute a field is encoded as, per its 'xml' tag,
+// and whether the field is encoded as an attribute. Like encoding/xml, fields whose tag does not name them, e.g. 'xml:",attr"',
+// are named after the field itself.
+// Returns an empty name for fields without an 'xml' tag, and for fields nested by their tag, e.g. 'xml:"a>b"'
+func GetXmlNameFromTag(tag string, fieldName string) (string, bool) {
+	xmlTag, hasXmlTag := reflect.StructTag(tag).Lookup("xml")
+	if !hasXmlTag || xmlTag == "-" {
+		return "", false
+	}
+
+	name, options, _ := strings.Cut(xmlTag, ",")
+	isAttribute := slices.Contains(strings.Split(options, ","), "attr")
+	if strings.Contains(name, ">") {
+		return "", isAttribute
+	}
+
+	// Namespaced names, e.g. 'http://example.com/ns name', are described without their namespace
+	nameParts := strings.Fields(name)
+	if len(nameParts) == 0 {
+		return fieldName, isAttribute
+	}
+	return nameParts[len(nameParts)-1], isAttribute
+}
+
+// IsJsonFieldRequired returns whether a model field is required, i.e., is validated as such and is never omitted by encoding/json
+func IsJsonFieldRequired(tag string) bool {
+	return IsFieldRequired(GetTagValue(tag, "validate", "")) && !HasJsonTagOption(tag, "omitempty")
 }
 
 func IsMapObject(typeName string) bool {
 	return strings.HasPrefix(typeName, "map[")
 }
 
+// GetMapValueType returns the type of a map's values, e.g. 'User' for 'map[string]User'
+func GetMapValueType(typeName string) string {
+	_, valueType, _ := strings.Cut(strings.TrimPrefix(typeName, "map["), "]")
+	return valueType
+}
+
+// IsAnyType returns whether the given type may hold any JSON value
+func IsAnyType(typeName string) bool {
+	switch typeName {
+	case "any", "interface{}", "interface {}":
+		return true
+	default:
+		return false
+	}
+}
+
 func IsFieldRequired(validationString string) bool {
 	validationRules := strings.Split(validationString, ",")
 	for _, rule := range validationRules {
@@ -165,6 +232,61 @@ func IsFieldRequired(validationString string) bool {
 	return false
 }
 
+// GetContentTypesOrDefault returns the given media types, or application/json if none are given
+func GetContentTypesOrDefault(contentTypes []definitions.ContentType) []definitions.ContentType {
+	if len(contentTypes) == 0 {
+		return []definitions.ContentType{definitions.ContentTypeJSON}
+	}
+	return contentTypes
+}
+
+// GetFormContentType returns the media type a route's form parameters are sent as -
+// a multipart form if the route consumes one (i.e., has form file parameters) or a URL encoded form otherwise
+func GetFormContentType(route definitions.RouteMetadata) definitions.ContentType {
+	if route.RequestContentType == definitions.ContentTypeMultipartForm {
+		return definitions.ContentTypeMultipartForm
+	}
+	return definitions.ContentTypeFormURLEncoded
+}
+
+// IsParamRequired returns whether the given parameter must be sent in the request.
+// Parameters with a default value may always be omitted
+func IsParamRequired(param definitions.FuncParam) bool {
+	return param.DefaultValue == "" && IsFieldRequired(param.Validator)
+}
+
+// GetParamDefault returns the default value of the given parameter, typed as described in the spec.
+// Returns nil if the parameter has no default value
+func GetParamDefault(param definitions.FuncParam) any {
+	if param.DefaultValue == "" {
+		return nil
+	}
+
+	typeName := param.TypeMeta.UnderlyingType
+	if typeName == "" {
+		typeName = param.TypeMeta.Name
+	}
+
+	var value any
+	var err error
+	// Only primitives are typed; the defaults of well-known and mapped types are given as-is
+	switch ToOpenApiType(nil, typeName) {
+	case "integer":
+		value, err = strconv.ParseInt(param.DefaultValue, 10, 64)
+	case "number":
+		value, err = strconv.ParseFloat(param.DefaultValue, 64)
+	case "boolean":
+		value, err = strconv.ParseBool(param.DefaultValue)
+	default:
+		return param.DefaultValue
+	}
+
+	if err != nil {
+		return param.DefaultValue
+	}
+	return value
+}
+
 // Helper function to determine the item type of an array
 func GetArrayItemType(fieldType string) string {
 	// Implement logic to extract the item type from the array type
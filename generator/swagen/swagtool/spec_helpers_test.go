@@ -35,12 +35,20 @@ var _ = Describe("Spec Helpers", func() {
 
 	Describe("ToOpenApiType", func() {
 		It("should convert Go types to OpenAPI types", func() {
-			Expect(ToOpenApiType("string")).To(Equal("string"))
-			Expect(ToOpenApiType("int")).To(Equal("integer"))
-			Expect(ToOpenApiType("bool")).To(Equal("boolean"))
-			Expect(ToOpenApiType("float64")).To(Equal("number"))
-			Expect(ToOpenApiType("[]string")).To(Equal("array"))
-			Expect(ToOpenApiType("customType")).To(Equal("object"))
+			Expect(ToOpenApiType(nil, "string")).To(Equal("string"))
+			Expect(ToOpenApiType(nil, "int")).To(Equal("integer"))
+			Expect(ToOpenApiType(nil, "bool")).To(Equal("boolean"))
+			Expect(ToOpenApiType(nil, "float64")).To(Equal("number"))
+			Expect(ToOpenApiType(nil, "[]string")).To(Equal("array"))
+			Expect(ToOpenApiType(nil, "customType")).To(Equal("object"))
+		})
+
+		It("should convert well-known types to their OpenAPI types", func() {
+			Expect(ToOpenApiType(nil, "time.Time")).To(Equal("string"))
+			Expect(ToOpenApiType(nil, "time.Duration")).To(Equal("integer"))
+			Expect(ToOpenApiType(nil, "[]byte")).To(Equal("string"))
+			Expect(ToOpenApiType(nil, "github.com/google/uuid.UUID")).To(Equal("string"))
+			Expect(ToOpenApiType(nil, "encoding/json.RawMessage")).To(BeEmpty())
 		})
 	})
 
@@ -186,6 +194,31 @@ var _ = Describe("Spec Helpers", func() {
 		})
 	})
 
+	Describe("GetMapValueType", func() {
+		It("should return the value type of a map", func() {
+			Expect(GetMapValueType("map[string]int")).To(Equal("int"))
+			Expect(GetMapValueType("map[int]User")).To(Equal("User"))
+		})
+
+		It("should return the nested value type of a map", func() {
+			Expect(GetMapValueType("map[string]map[string]User")).To(Equal("map[string]User"))
+			Expect(GetMapValueType("map[string][]User")).To(Equal("[]User"))
+		})
+	})
+
+	Describe("IsAnyType", func() {
+		It("should return true for types that may hold any value", func() {
+			Expect(IsAnyType("any")).To(BeTrue())
+			Expect(IsAnyType("interface{}")).To(BeTrue())
+			Expect(IsAnyType("interface {}")).To(BeTrue())
+		})
+
+		It("should return false for other types", func() {
+			Expect(IsAnyType("string")).To(BeFalse())
+			Expect(IsAnyType("User")).To(BeFalse())
+		})
+	})
+
 	Describe("GetJsonNameFromTag", func() {
 		It("should extract simple json name correctly", func() {
 			tag := `json:"userName"`
@@ -234,5 +267,135 @@ var _ = Describe("Spec Helpers", func() {
 			value := GetJsonNameFromTag(tag, "default")
 			Expect(value).To(Equal("user_name-123"))
 		})
+
+		It("should return default name when json tag only has options", func() {
+			tag := `json:",omitempty"`
+			value := GetJsonNameFromTag(tag, "default")
+			Expect(value).To(Equal("default"))
+		})
+
+		It("should return a literal dash name when the dash is followed by a comma", func() {
+			tag := `json:"-,"`
+			value := GetJsonNameFromTag(tag, "default")
+			Expect(value).To(Equal("-"))
+		})
+	})
+
+	Describe("HasJsonTagOption", func() {
+		It("should return true if the option is present", func() {
+			Expect(HasJsonTagOption(`json:"name,omitempty"`, "omitempty")).To(BeTrue())
+			Expect(HasJsonTagOption(`json:",string,omitempty"`, "string")).To(BeTrue())
+		})
+
+		It("should return false if the option is absent", func() {
+			Expect(HasJsonTagOption(`json:"name"`, "omitempty")).To(BeFalse())
+			Expect(HasJsonTagOption(`json:"omitempty"`, "omitempty")).To(BeFalse())
+			Expect(HasJsonTagOption(`validate:"omitempty"`, "omitempty")).To(BeFalse())
+		})
+	})
+
+	Describe("IsJsonStringEncoded", func() {
+		It("should return true for primitive fields with the 'string' option", func() {
+			Expect(IsJsonStringEncoded(`json:"count,string"`, "int")).To(BeTrue())
+			Expect(IsJsonStringEncoded(`json:",string"`, "bool")).To(BeTrue())
+		})
+
+		It("should return false for non-primitive fields or fields without the 'string' option", func() {
+			Expect(IsJsonStringEncoded(`json:"count"`, "int")).To(BeFalse())
+			Expect(IsJsonStringEncoded(`json:"items,string"`, "[]int")).To(BeFalse())
+			Expect(IsJsonStringEncoded(`json:"model,string"`, "SomeModel")).To(BeFalse())
+		})
+	})
+
+	Describe("GetXmlNameFromTag", func() {
+		It("should return the name and kind given by the xml tag", func() {
+			name, isAttribute := GetXmlNameFromTag(`json:"id" xml:"id,attr"`, "Id")
+			Expect(name).To(Equal("id"))
+			Expect(isAttribute).To(BeTrue())
+
+			name, isAttribute = GetXmlNameFromTag(`xml:"title,omitempty"`, "Title")
+			Expect(name).To(Equal("title"))
+			Expect(isAttribute).To(BeFalse())
+		})
+
+		It("should name fields after themselves when the xml tag only has options", func() {
+			name, isAttribute := GetXmlNameFromTag(`xml:",attr"`, "Id")
+			Expect(name).To(Equal("Id"))
+			Expect(isAttribute).To(BeTrue())
+		})
+
+		It("should drop the namespace of namespaced names", func() {
+			name, _ := GetXmlNameFromTag(`xml:"http://example.com/ns title"`, "Title")
+			Expect(name).To(Equal("title"))
+		})
+
+		It("should return an empty name for fields without an xml tag or nested by it", func() {
+			name, _ := GetXmlNameFromTag(`json:"title"`, "Title")
+			Expect(name).To(BeEmpty())
+
+			name, _ = GetXmlNameFromTag(`xml:"-"`, "Title")
+			Expect(name).To(BeEmpty())
+
+			name, _ = GetXmlNameFromTag(`xml:"notes>note"`, "Notes")
+			Expect(name).To(BeEmpty())
+		})
+	})
+
+	Describe("IsJsonFieldRequired", func() {
+		It("should return true for required fields without 'omitempty'", func() {
+			Expect(IsJsonFieldRequired(`json:"name" validate:"required"`)).To(BeTrue())
+			Expect(IsJsonFieldRequired(`validate:"min=1,required"`)).To(BeTrue())
+		})
+
+		It("should return false for fields with 'omitempty' or without 'required'", func() {
+			Expect(IsJsonFieldRequired(`json:"name,omitempty" validate:"required"`)).To(BeFalse())
+			Expect(IsJsonFieldRequired(`json:"name" validate:"min=1"`)).To(BeFalse())
+		})
+	})
+
+	Describe("GetFormContentType", func() {
+		It("should return a multipart form for routes consuming one", func() {
+			route := definitions.RouteMetadata{RequestContentType: definitions.ContentTypeMultipartForm}
+			Expect(GetFormContentType(route)).To(Equal(definitions.ContentTypeMultipartForm))
+		})
+
+		It("should return a URL encoded form otherwise", func() {
+			Expect(GetFormContentType(definitions.RouteMetadata{})).To(Equal(definitions.ContentTypeFormURLEncoded))
+		})
+	})
+
+	Describe("IsParamRequired", func() {
+		It("should return true for required params without a default value", func() {
+			Expect(IsParamRequired(definitions.FuncParam{Validator: "required"})).To(BeTrue())
+		})
+
+		It("should return false for params with a default value or without 'required'", func() {
+			Expect(IsParamRequired(definitions.FuncParam{Validator: "required", DefaultValue: "20"})).To(BeFalse())
+			Expect(IsParamRequired(definitions.FuncParam{Validator: "min=1"})).To(BeFalse())
+		})
+	})
+
+	Describe("GetParamDefault", func() {
+		paramOfType := func(typeName string, underlyingType string, defaultValue string) definitions.FuncParam {
+			return definitions.FuncParam{
+				ParamMeta: definitions.ParamMeta{
+					TypeMeta: definitions.TypeMetadata{Name: typeName, UnderlyingType: underlyingType},
+				},
+				DefaultValue: defaultValue,
+			}
+		}
+
+		It("should type default values per the schemas of their params", func() {
+			Expect(GetParamDefault(paramOfType("int", "", "20"))).To(Equal(int64(20)))
+			Expect(GetParamDefault(paramOfType("float32", "", "0.5"))).To(Equal(0.5))
+			Expect(GetParamDefault(paramOfType("bool", "", "true"))).To(Equal(true))
+			Expect(GetParamDefault(paramOfType("string", "", "eu"))).To(Equal("eu"))
+			Expect(GetParamDefault(paramOfType("Cents", "int64", "100"))).To(Equal(int64(100)))
+			Expect(GetParamDefault(paramOfType("SortOrder", "string", "asc"))).To(Equal("asc"))
+		})
+
+		It("should return nil for params without a default value", func() {
+			Expect(GetParamDefault(paramOfType("int", "", ""))).To(BeNil())
+		})
 	})
 })
@@ -31,12 +31,33 @@ var RequestArgsParsing string
 //go:embed partials/request.switch.param.type.hbs
 var RequestSwitchParamType string
 
+//go:embed partials/request.slice.param.hbs
+var RequestSliceParam string
+
+//go:embed partials/request.query.object.param.hbs
+var RequestQueryObjectParam string
+
+//go:embed partials/param.imports.hbs
+var ParamImports string
+
 //go:embed partials/reply.response.hbs
 var ReplyResponse string
 
 //go:embed partials/json.response.hbs
 var JsonResponse string
 
+//go:embed partials/xml.response.hbs
+var XmlResponse string
+
+//go:embed partials/text.response.hbs
+var TextResponse string
+
+//go:embed partials/stream.response.hbs
+var StreamResponse string
+
+//go:embed partials/event.stream.response.hbs
+var EventStreamResponse string
+
 //go:embed partials/json.validation.error.response.hbs
 var JsonValidationErrorResponse string
 
@@ -72,6 +93,10 @@ var TemplateExtensions = map[string]string{
 	"TypeDeclarationsExtension":                "// type declarations extension placeholder \n",
 	"FunctionDeclarationsExtension":            "// function declarations extension placeholder \n",
 	"JsonResponseExtension":                    "// json response extension placeholder \n",
+	"XmlResponseExtension":                     "// xml response extension placeholder \n",
+	"TextResponseExtension":                    "// text response extension placeholder \n",
+	"StreamResponseExtension":                  "// stream response extension placeholder \n",
+	"EventStreamResponseExtension":             "// event stream response extension placeholder \n",
 	"JsonValidationErrorResponseExtension":     "// json validation error response extension placeholder \n",
 	"JsonBodyValidationErrorResponseExtension": "// json body validation error response extension placeholder \n",
 	"JsonErrorResponseExtension":               "// json error response extension placeholder \n",
@@ -85,12 +110,19 @@ var Partials = map[string]string{
 	"AuthorizationCall":               AuthorizationCall,
 	"RequestArgsParsing":              RequestArgsParsing,
 	"JsonResponse":                    JsonResponse,
+	"XmlResponse":                     XmlResponse,
+	"TextResponse":                    TextResponse,
+	"StreamResponse":                  StreamResponse,
+	"EventStreamResponse":             EventStreamResponse,
 	"JsonValidationErrorResponse":     JsonValidationErrorResponse,
 	"JsonBodyValidationErrorResponse": JsonBodyValidationErrorResponse,
 	"JsonErrorResponse":               JsonErrorResponse,
 	"ReplyResponse":                   ReplyResponse,
 	"ResponseHeaders":                 ResponseHeaders,
 	"RequestSwitchParamType":          RequestSwitchParamType,
+	"RequestSliceParam":               RequestSliceParam,
+	"RequestQueryObjectParam":         RequestQueryObjectParam,
+	"ParamImports":                    ParamImports,
 	"RunValidator":                    RunValidator,
 	"Middleware":                      Middleware,
 	"RegisterMiddleware":              RegisterMiddleware,
@@ -0,0 +1,18 @@
+package responses
+
+import "io"
+
+// FileResponse is a file returned by a route. Its content is streamed as the response body and offered for download
+// under its file name. The response is sent as the file's content type, if given, or as the route's media type otherwise.
+//
+// File responses must be returned by value
+type FileResponse struct {
+	// The content of the file. Closed once sent if it's an io.Closer
+	Content io.Reader
+
+	// The name the file is offered for download under. The response is not offered for download if empty
+	FileName string
+
+	// The media type of the file, e.g. 'text/csv'
+	ContentType string
+}
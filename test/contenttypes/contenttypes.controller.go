@@ -0,0 +1,38 @@
+package contenttypes_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+type Note struct {
+	Text string `json:"text"`
+}
+
+// @Route(/test/content-types)
+// @Consumes(application/json)
+// @Produces(application/json)
+type ContentTypesController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(POST)
+// @Route(/notes)
+// @Body(note)
+func (ec *ContentTypesController) CreateNote(note Note) (Note, error) {
+	return note, nil
+}
+
+// @Method(POST)
+// @Route(/notes/form)
+// @FormField(text)
+func (ec *ContentTypesController) CreateNoteFromForm(text string) (Note, error) {
+	return Note{Text: text}, nil
+}
+
+// @Method(GET)
+// @Route(/notes/count)
+// @Produces(text/plain)
+// @Produces(application/json)
+func (ec *ContentTypesController) CountNotes() (int, error) {
+	return 0, nil
+}
@@ -0,0 +1,88 @@
+package contenttypes_test
+
+import (
+	"testing"
+
+	"github.com/gopher-fleece/gleece/definitions"
+	"github.com/gopher-fleece/gleece/infrastructure/logger"
+	"github.com/gopher-fleece/gleece/test/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Content Types", func() {
+	var config *definitions.GleeceConfig
+	var metadata []definitions.ControllerMetadata
+	var models []definitions.ModelMetadata
+	var hasStdError bool
+
+	BeforeEach(func() {
+		var err error
+		config, metadata, models, hasStdError, err = utils.GetConfigAndMetadata("gleece.test.config.json")
+		Expect(err).To(BeNil())
+	})
+
+	It("Inherits the media types of the controller", func() {
+		Expect(metadata[0].Consumes).To(Equal([]definitions.ContentType{definitions.ContentTypeJSON}))
+		Expect(metadata[0].Produces).To(Equal([]definitions.ContentType{definitions.ContentTypeJSON}))
+
+		route := metadata[0].Routes[0]
+		Expect(route.Consumes).To(Equal([]definitions.ContentType{definitions.ContentTypeJSON}))
+		Expect(route.Produces).To(Equal([]definitions.ContentType{definitions.ContentTypeJSON}))
+		Expect(route.RequestContentType).To(Equal(definitions.ContentTypeJSON))
+		Expect(route.ResponseContentType).To(Equal(definitions.ContentTypeJSON))
+	})
+
+	It("Consumes URL encoded forms on routes with form parameters", func() {
+		route := metadata[0].Routes[1]
+		Expect(route.Consumes).To(Equal([]definitions.ContentType{definitions.ContentTypeFormURLEncoded}))
+		Expect(route.RequestContentType).To(Equal(definitions.ContentTypeFormURLEncoded))
+		Expect(route.Produces).To(Equal([]definitions.ContentType{definitions.ContentTypeJSON}))
+	})
+
+	It("Produces plain text for routes returning numbers", func() {
+		route := metadata[0].Routes[2]
+		Expect(route.Produces).To(Equal([]definitions.ContentType{definitions.ContentTypePlainText, definitions.ContentTypeJSON}))
+		Expect(route.ResponseContentType).To(Equal(definitions.ContentTypePlainText))
+	})
+
+	DescribeTable("Lists the media types of each operation in the spec",
+		func(version string) {
+			spec := utils.GetSpec(config, metadata, models, hasStdError, version)
+			operation := spec["paths"].(map[string]any)["/test/content-types/notes"].(map[string]any)["post"].(map[string]any)
+
+			requestContent := operation["requestBody"].(map[string]any)["content"].(map[string]any)
+			Expect(requestContent).To(HaveLen(1))
+			Expect(requestContent).To(HaveKey("application/json"))
+
+			responseContent := operation["responses"].(map[string]any)["200"].(map[string]any)["content"].(map[string]any)
+			Expect(responseContent).To(HaveLen(1))
+			Expect(responseContent).To(HaveKey("application/json"))
+
+			countOperation := spec["paths"].(map[string]any)["/test/content-types/notes/count"].(map[string]any)["get"].(map[string]any)
+			countContent := countOperation["responses"].(map[string]any)["200"].(map[string]any)["content"].(map[string]any)
+			Expect(countContent).To(HaveLen(2))
+			Expect(countContent["text/plain"]).To(HaveKeyWithValue("schema", HaveKeyWithValue("type", "integer")))
+		},
+		Entry("OpenAPI 3.0", "3.0.0"),
+		Entry("OpenAPI 3.1", "3.1.0"),
+	)
+
+	It("Fails for media types that cannot be produced", func() {
+		_, _, _, _, err := utils.GetConfigAndMetadata("gleece.invalid.config.json")
+		Expect(err).To(MatchError(ContainSubstring("media type 'text/html' given by @Produces is not supported")))
+	})
+
+	It("Fails for plain text produced by routes returning structs", func() {
+		_, _, _, _, err := utils.GetConfigAndMetadata("gleece.invalid.text.config.json")
+		Expect(err).To(MatchError(ContainSubstring(
+			"route 'GetNote' cannot produce 'text/plain' as its return value is not a string, a number or a boolean",
+		)))
+	})
+})
+
+func TestContentTypes(t *testing.T) {
+	logger.SetLogLevel(logger.LogLevelNone)
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Content Types")
+}
@@ -0,0 +1,17 @@
+package contenttypes_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+// @Route(/test/content-types-invalid)
+type InvalidContentTypesController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(GET)
+// @Route(/page)
+// @Produces(text/html)
+func (ec *InvalidContentTypesController) GetPage() (string, error) {
+	return "", nil
+}
@@ -0,0 +1,17 @@
+package contenttypes_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+// @Route(/test/content-types-invalid-text)
+type InvalidTextContentTypesController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(GET)
+// @Route(/note)
+// @Produces(text/plain)
+func (ec *InvalidTextContentTypesController) GetNote() (Note, error) {
+	return Note{}, nil
+}
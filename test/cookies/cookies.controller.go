@@ -0,0 +1,24 @@
+package cookies_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+type Preferences struct {
+	Theme  string `json:"theme"`
+	Visits int    `json:"visits"`
+}
+
+// @Route(/test/cookies)
+type CookiesController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(GET)
+// @Route(/preferences)
+// @Cookie(session, { name: "session_id", validate: "min=8" }) The session identifier
+// @Cookie(theme)
+// @Cookie(visits)
+func (ec *CookiesController) GetPreferences(session string, theme *string, visits int) (Preferences, error) {
+	return Preferences{Visits: visits}, nil
+}
@@ -0,0 +1,81 @@
+package cookies_test
+
+import (
+	"testing"
+
+	"github.com/gopher-fleece/gleece/definitions"
+	"github.com/gopher-fleece/gleece/infrastructure/logger"
+	"github.com/gopher-fleece/gleece/test/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cookie Parameters", func() {
+	var config *definitions.GleeceConfig
+	var metadata []definitions.ControllerMetadata
+	var models []definitions.ModelMetadata
+	var hasStdError bool
+
+	BeforeEach(func() {
+		var err error
+		config, metadata, models, hasStdError, err = utils.GetConfigAndMetadata("gleece.test.config.json")
+		Expect(err).To(BeNil())
+	})
+
+	It("Extracts cookie parameters", func() {
+		params := metadata[0].Routes[0].FuncParams
+		Expect(params).To(HaveLen(3))
+
+		Expect(params[0].PassedIn).To(Equal(definitions.PassedInCookie))
+		Expect(params[0].NameInSchema).To(Equal("session_id"))
+		Expect(params[0].Description).To(Equal("The session identifier"))
+		Expect(params[0].Validator).To(Equal("min=8,required"))
+
+		Expect(params[1].PassedIn).To(Equal(definitions.PassedInCookie))
+		Expect(params[1].NameInSchema).To(Equal("theme"))
+		Expect(params[1].Validator).To(BeEmpty())
+
+		Expect(params[2].PassedIn).To(Equal(definitions.PassedInCookie))
+		Expect(params[2].Validator).To(Equal("required"))
+	})
+
+	DescribeTable("Describes cookie parameters in the spec",
+		func(version string) {
+			spec := utils.GetSpec(config, metadata, models, hasStdError, version)
+			operation := spec["paths"].(map[string]any)["/test/cookies/preferences"].(map[string]any)["get"].(map[string]any)
+			params := operation["parameters"].([]any)
+
+			Expect(params).To(ConsistOf(
+				And(
+					HaveKeyWithValue("name", "session_id"),
+					HaveKeyWithValue("in", "cookie"),
+					HaveKeyWithValue("required", true),
+					HaveKeyWithValue("schema", HaveKeyWithValue("minLength", BeNumerically("==", 8))),
+				),
+				And(
+					HaveKeyWithValue("name", "theme"),
+					HaveKeyWithValue("in", "cookie"),
+					Not(HaveKeyWithValue("required", true)),
+				),
+				And(
+					HaveKeyWithValue("name", "visits"),
+					HaveKeyWithValue("in", "cookie"),
+					HaveKeyWithValue("schema", HaveKeyWithValue("type", "integer")),
+				),
+			))
+		},
+		Entry("OpenAPI 3.0", "3.0.0"),
+		Entry("OpenAPI 3.1", "3.1.0"),
+	)
+
+	It("Fails for non-primitive cookie parameters", func() {
+		_, _, _, _, err := utils.GetConfigAndMetadata("gleece.invalid.config.json")
+		Expect(err).To(MatchError(ContainSubstring("slices are only supported for header and query parameters but Cookie parameter 'tags'")))
+	})
+})
+
+func TestCookies(t *testing.T) {
+	logger.SetLogLevel(logger.LogLevelNone)
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Cookie Parameters")
+}
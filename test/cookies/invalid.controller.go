@@ -0,0 +1,17 @@
+package cookies_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+// @Route(/test/cookies-invalid)
+type InvalidCookiesController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(GET)
+// @Route(/preferences)
+// @Cookie(tags)
+func (ec *InvalidCookiesController) GetPreferences(tags []string) error {
+	return nil
+}
@@ -0,0 +1,46 @@
+package enums_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+// @Description The status of an order
+type OrderStatus string
+
+const (
+	OrderStatusPending   OrderStatus = "pending"
+	OrderStatusShipped   OrderStatus = "shipped"
+	OrderStatusDelivered OrderStatus = "delivered"
+
+	// An alias of an existing value should not yield a duplicate
+	OrderStatusDefault OrderStatus = OrderStatusPending
+)
+
+type OrderPriority int
+
+const (
+	OrderPriorityLow OrderPriority = iota + 1
+	OrderPriorityMedium
+	OrderPriorityHigh
+)
+
+// @Description An order
+type Order struct {
+	Status   OrderStatus   `json:"status"`
+	Priority OrderPriority `json:"priority"`
+}
+
+// @Tag(Enums Controller Tag)
+// @Route(/test/enums)
+// @Description Enums Controller
+type EnumsController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(GET)
+// @Route(/{status})
+// @Path(status)
+// @Query(priority)
+func (ec *EnumsController) GetOrder(status OrderStatus, priority OrderPriority) (Order, error) {
+	return Order{Status: status, Priority: priority}, nil
+}
@@ -0,0 +1,78 @@
+package enums_test
+
+import (
+	"testing"
+
+	"github.com/gopher-fleece/gleece/definitions"
+	"github.com/gopher-fleece/gleece/infrastructure/logger"
+	"github.com/gopher-fleece/gleece/test/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var metadata []definitions.ControllerMetadata
+var models []definitions.ModelMetadata
+
+var _ = BeforeSuite(func() {
+	controllers, flatModels, _ := utils.GetControllersAndModels()
+	metadata = controllers
+	models = flatModels
+})
+
+func getModelByName(name string) *definitions.ModelMetadata {
+	for _, model := range models {
+		if model.Name == name {
+			return &model
+		}
+	}
+	return nil
+}
+
+var _ = Describe("Enums Controller", func() {
+	It("Extracts enum information for route parameters", func() {
+		Expect(metadata).To(HaveLen(1))
+		Expect(metadata[0].Routes).To(HaveLen(1))
+
+		params := metadata[0].Routes[0].FuncParams
+		Expect(params).To(HaveLen(2))
+
+		Expect(params[0].TypeMeta.Name).To(Equal("OrderStatus"))
+		Expect(params[0].TypeMeta.IsUniverseType).To(BeFalse())
+		Expect(params[0].TypeMeta.UnderlyingType).To(Equal("string"))
+		Expect(params[0].TypeMeta.EnumValues).To(Equal([]string{"pending", "shipped", "delivered"}))
+
+		Expect(params[1].TypeMeta.Name).To(Equal("OrderPriority"))
+		Expect(params[1].TypeMeta.UnderlyingType).To(Equal("int"))
+		Expect(params[1].TypeMeta.EnumValues).To(Equal([]string{"1", "2", "3"}))
+	})
+
+	It("Produces enum models alongside the struct referencing them", func() {
+		Expect(models).To(HaveLen(3))
+
+		order := getModelByName("Order")
+		Expect(order).ToNot(BeNil())
+		Expect(order.IsEnum()).To(BeFalse())
+		Expect(order.Fields).To(HaveLen(2))
+		Expect(order.Fields[0].Type).To(Equal("OrderStatus"))
+		Expect(order.Fields[1].Type).To(Equal("OrderPriority"))
+
+		status := getModelByName("OrderStatus")
+		Expect(status).ToNot(BeNil())
+		Expect(status.IsEnum()).To(BeTrue())
+		Expect(status.FullyQualifiedPackage).To(Equal("github.com/gopher-fleece/gleece/test/enums"))
+		Expect(status.Description).To(Equal("The status of an order"))
+		Expect(status.UnderlyingType).To(Equal("string"))
+		Expect(status.EnumValues).To(Equal([]string{"pending", "shipped", "delivered"}))
+
+		priority := getModelByName("OrderPriority")
+		Expect(priority).ToNot(BeNil())
+		Expect(priority.UnderlyingType).To(Equal("int"))
+		Expect(priority.EnumValues).To(Equal([]string{"1", "2", "3"}))
+	})
+})
+
+func TestEnums(t *testing.T) {
+	logger.SetLogLevel(logger.LogLevelNone)
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Enums")
+}
@@ -69,10 +69,45 @@ var _ = Describe("Error-handling", func() {
 		Expect(err).To(MatchError(ContainSubstring("could not read given template ImportsExtension override at")))
 	})
 
-	It("Returns a clear error when type declared outside of global path", func() {
+	It("Returns a clear error when a type mapping's parse function is not fully qualified", func() {
+		configPath := utils.GetAbsPathByRelative("gleece.invalid.type.mapping.config.json")
+		_, _, _, _, err := cmd.GetConfigAndMetadata(arguments.CliArguments{ConfigPath: configPath})
+		Expect(err).To(MatchError(ContainSubstring(
+			"parse function 'Parse' of type mapping 'github.com/oklog/ulid/v2.ULID' must be fully qualified",
+		)))
+	})
+
+	It("Returns a clear error when a type mapping's parse function does not exist", func() {
+		configPath := utils.GetAbsPathByRelative("gleece.missing.parse.func.config.json")
+		_, _, _, _, err := cmd.GetConfigAndMetadata(arguments.CliArguments{ConfigPath: configPath})
+		Expect(err).To(MatchError(ContainSubstring(
+			"parse function 'github.com/gopher-fleece/gleece/test/models/contracts.ParseUlid' of type mapping " +
+				"'github.com/gopher-fleece/gleece/test/models/contracts.Ulid' does not exist or is not an exported function",
+		)))
+	})
+
+	It("Returns a clear error when a type mapping's parse function has the wrong signature", func() {
+		configPath := utils.GetAbsPathByRelative("gleece.mismatched.parse.func.config.json")
+		_, _, _, _, err := cmd.GetConfigAndMetadata(arguments.CliArguments{ConfigPath: configPath})
+		Expect(err).To(MatchError(ContainSubstring(
+			"parse function 'strconv.Itoa' of type mapping 'github.com/gopher-fleece/gleece/test/models/contracts.Ulid' " +
+				"must be a 'func(string) (contracts.Ulid, error)' but is a 'func(i int) string'",
+		)))
+	})
+
+	It("Returns a clear error when a generic type is instantiated with an unnamed type argument", func() {
+		configPath := utils.GetAbsPathByRelative("gleece.unnamed.type.arg.config.json")
+		_, _, _, _, err := cmd.GetConfigAndMetadata(arguments.CliArguments{ConfigPath: configPath})
+		Expect(err).To(MatchError(ContainSubstring(
+			"could not name instantiated generic type 'Page' - type argument 'struct{Name string}' is not supported",
+		)))
+	})
+
+	It("Does not return an error when type declared outside of global path", func() {
 		configPath := utils.GetAbsPathByRelative("gleece.unscanned.types.json")
-		err := cmd.GenerateRoutes(arguments.CliArguments{ConfigPath: configPath})
-		Expect(err).To(MatchError(ContainSubstring("encountered an error visiting controller UnScannedTypeController method EmptyMethod - could not find type 'HoldsVeryNestedStructs' in package 'github.com/gopher-fleece/gleece/test/errorhandling', are you sure it's included in the 'commonConfig->controllerGlobs' search paths?")))
+		_, _, models, _, err := cmd.GetConfigAndMetadata(arguments.CliArguments{ConfigPath: configPath})
+		Expect(err).To(BeNil())
+		Expect(models).To(ContainElement(HaveField("Name", "HoldsVeryNestedStructs")))
 	})
 })
 
@@ -0,0 +1,26 @@
+package errorhandling_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+type Page[T any] struct {
+	Items []T `json:"items"`
+}
+
+type HoldsAnonymousPage struct {
+	Page Page[struct{ Name string }] `json:"page"`
+}
+
+// @Tag(Dummy Controller Tag)
+// @Route(/test/sanity)
+// @Description Sanity Controller
+type UnnamedTypeArgController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(GET)
+// @Route(/some/method)
+func (ec *UnnamedTypeArgController) GetAnonymousPage() (HoldsAnonymousPage, error) {
+	return HoldsAnonymousPage{}, nil
+}
@@ -0,0 +1,24 @@
+package events_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+type Progress struct {
+	Percent int    `json:"percent"`
+	Stage   string `json:"stage"`
+}
+
+// @Route(/test/events)
+type EventsController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(GET)
+// @Route(/progress)
+// @Produces(text/event-stream)
+func (ec *EventsController) GetProgress() (<-chan Progress, error) {
+	progress := make(chan Progress)
+	close(progress)
+	return progress, nil
+}
@@ -0,0 +1,74 @@
+package events_test
+
+import (
+	"testing"
+
+	"github.com/gopher-fleece/gleece/definitions"
+	"github.com/gopher-fleece/gleece/infrastructure/logger"
+	"github.com/gopher-fleece/gleece/test/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Server-Sent Events", func() {
+	var config *definitions.GleeceConfig
+	var metadata []definitions.ControllerMetadata
+	var models []definitions.ModelMetadata
+	var hasStdError bool
+
+	BeforeEach(func() {
+		var err error
+		config, metadata, models, hasStdError, err = utils.GetConfigAndMetadata("gleece.test.config.json")
+		Expect(err).To(BeNil())
+	})
+
+	It("Recognizes receive-only channel return values", func() {
+		valueType := metadata[0].Routes[0].GetValueReturnType()
+		Expect(valueType.IsChannel()).To(BeTrue())
+		Expect(valueType.IsSlice()).To(BeFalse())
+		Expect(valueType.Name).To(Equal("<-chan Progress"))
+		Expect(valueType.ElementType.Name).To(Equal("Progress"))
+	})
+
+	It("Produces text/event-stream", func() {
+		route := metadata[0].Routes[0]
+		Expect(route.Produces).To(Equal([]definitions.ContentType{definitions.ContentTypeEventStream}))
+		Expect(route.ResponseContentType).To(Equal(definitions.ContentTypeEventStream))
+	})
+
+	It("Collects the type of the channel's values as a model", func() {
+		Expect(models).To(HaveLen(1))
+		Expect(models[0].Name).To(Equal("Progress"))
+	})
+
+	DescribeTable("Describes event streams as sequences of the channel's values in the spec",
+		func(version string) {
+			spec := utils.GetSpec(config, metadata, models, hasStdError, version)
+			operation := spec["paths"].(map[string]any)["/test/events/progress"].(map[string]any)["get"].(map[string]any)
+			content := operation["responses"].(map[string]any)["200"].(map[string]any)["content"].(map[string]any)
+			Expect(content).To(HaveLen(1))
+
+			schema := content["text/event-stream"].(map[string]any)["schema"].(map[string]any)
+			Expect(schema["type"]).To(Equal("array"))
+			Expect(schema["items"]).To(Equal(map[string]any{"$ref": "#/components/schemas/Progress"}))
+		},
+		Entry("OpenAPI 3.0", "3.0.0"),
+		Entry("OpenAPI 3.1", "3.1.0"),
+	)
+
+	It("Fails for channels returned by routes that do not produce text/event-stream", func() {
+		_, _, _, _, err := utils.GetConfigAndMetadata("gleece.invalid.config.json")
+		Expect(err).To(MatchError(ContainSubstring("routes returning channels must be annotated with a sole @Produces(text/event-stream)")))
+	})
+
+	It("Fails for routes producing text/event-stream without returning a channel", func() {
+		_, _, _, _, err := utils.GetConfigAndMetadata("gleece.nochannel.config.json")
+		Expect(err).To(MatchError(ContainSubstring("routes producing 'text/event-stream' must return a receive-only channel ('<-chan T')")))
+	})
+})
+
+func TestEvents(t *testing.T) {
+	logger.SetLogLevel(logger.LogLevelNone)
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Server-Sent Events")
+}
@@ -0,0 +1,16 @@
+package events_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+// @Route(/test/events-invalid)
+type InvalidEventsController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(GET)
+// @Route(/ticks)
+func (ec *InvalidEventsController) GetTicks() (<-chan int, error) {
+	return nil, nil
+}
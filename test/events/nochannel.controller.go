@@ -0,0 +1,17 @@
+package events_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+// @Route(/test/events-no-channel)
+type NoChannelEventsController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(GET)
+// @Route(/ticks)
+// @Produces(text/event-stream)
+func (ec *NoChannelEventsController) GetTicks() (int, error) {
+	return 0, nil
+}
@@ -0,0 +1,10 @@
+module example.com/consumer
+
+go 1.23.6
+
+require (
+	example.com/contracts v0.0.0
+	github.com/gopher-fleece/runtime v1.1.0
+)
+
+replace example.com/contracts => ../contracts
@@ -0,0 +1,26 @@
+package consumer
+
+import (
+	"example.com/contracts"
+	"example.com/contracts/ids"
+	"github.com/gopher-fleece/runtime"
+)
+
+// @Route(/orders)
+type OrdersController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(GET)
+// @Route(/{id})
+// @Path(id)
+func (ec *OrdersController) GetOrder(id ids.OrderID) (contracts.Order, error) {
+	return contracts.Order{}, nil
+}
+
+// @Method(POST)
+// @Route(/customers)
+// @Body(customer)
+func (ec *OrdersController) CreateCustomer(customer contracts.Customer) error {
+	return nil
+}
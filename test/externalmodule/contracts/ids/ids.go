@@ -0,0 +1,4 @@
+package ids
+
+// A hex-encoded order identifier
+type OrderID [12]byte
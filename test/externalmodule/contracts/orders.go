@@ -0,0 +1,24 @@
+package contracts
+
+import (
+	"example.com/contracts/ids"
+	"example.com/contracts/shared"
+)
+
+// An order placed by a customer
+type Order struct {
+	ID       ids.OrderID     `json:"id" validate:"required"`
+	Customer Customer        `json:"customer"`
+	Lines    []OrderLine     `json:"lines"`
+	Shipping *shared.Address `json:"shipping"`
+}
+
+type Customer struct {
+	Name    string         `json:"name" validate:"required"`
+	Address shared.Address `json:"address"`
+}
+
+type OrderLine struct {
+	Sku      string `json:"sku"`
+	Quantity int    `json:"quantity"`
+}
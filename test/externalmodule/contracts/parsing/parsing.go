@@ -0,0 +1,20 @@
+// Package parsing is not imported by the consumer's controllers, so Gleece must load it on demand
+package parsing
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"example.com/contracts/ids"
+)
+
+func ParseOrderID(value string) (ids.OrderID, error) {
+	var id ids.OrderID
+	decoded, err := hex.DecodeString(value)
+	if err != nil || len(decoded) != len(id) {
+		return id, fmt.Errorf("'%s' is not a valid order ID", value)
+	}
+
+	copy(id[:], decoded)
+	return id, nil
+}
@@ -0,0 +1,6 @@
+package shared
+
+type Address struct {
+	Street string `json:"street"`
+	City   string `json:"city" validate:"required"`
+}
@@ -0,0 +1,93 @@
+package externalmodule_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/gopher-fleece/gleece/definitions"
+	"github.com/gopher-fleece/gleece/infrastructure/logger"
+	"github.com/gopher-fleece/gleece/test/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func getModel(models []definitions.ModelMetadata, name string) *definitions.ModelMetadata {
+	for index := range models {
+		if models[index].Name == name {
+			return &models[index]
+		}
+	}
+	return nil
+}
+
+// The 'consumer' module's controller refers to models of the separate 'contracts' module, which it depends on.
+// Gleece is run from the consumer module's root, as it would be by a user
+var _ = Describe("External Modules", func() {
+	var config *definitions.GleeceConfig
+	var metadata []definitions.ControllerMetadata
+	var models []definitions.ModelMetadata
+	var hasStdError bool
+
+	BeforeEach(func() {
+		cwd, err := os.Getwd()
+		Expect(err).To(BeNil())
+		Expect(os.Chdir("consumer")).To(Succeed())
+		DeferCleanup(os.Chdir, cwd)
+
+		config, metadata, models, hasStdError, err = utils.GetConfigAndMetadata("gleece.test.config.json")
+		Expect(err).To(BeNil())
+	})
+
+	It("Resolves body and response models declared in a dependency module", func() {
+		routes := metadata[0].Routes
+		Expect(routes[0].Responses[0].FullyQualifiedPackage).To(Equal("example.com/contracts"))
+		Expect(routes[1].FuncParams[0].TypeMeta.FullyQualifiedPackage).To(Equal("example.com/contracts"))
+
+		order := getModel(models, "Order")
+		Expect(order).ToNot(BeNil())
+		Expect(order.FullyQualifiedPackage).To(Equal("example.com/contracts"))
+		Expect(order.Description).To(Equal("An order placed by a customer"))
+	})
+
+	It("Resolves the models of fields transitively, across the dependency module's packages", func() {
+		Expect(getModel(models, "Customer")).ToNot(BeNil())
+		Expect(getModel(models, "OrderLine")).ToNot(BeNil())
+
+		address := getModel(models, "Address")
+		Expect(address).ToNot(BeNil())
+		Expect(address.FullyQualifiedPackage).To(Equal("example.com/contracts/shared"))
+	})
+
+	It("Loads packages of a dependency module which the controllers do not import on demand", func() {
+		// The parse function's package is imported by neither the controllers nor the models they refer to
+		idType := metadata[0].Routes[0].FuncParams[0].TypeMeta
+		Expect(idType.FullName()).To(Equal("example.com/contracts/ids.OrderID"))
+		Expect(idType.Mapping).ToNot(BeNil())
+		Expect(idType.Mapping.ParseFuncPackage).To(Equal("example.com/contracts/parsing"))
+		Expect(idType.Mapping.ParseFuncName).To(Equal("ParseOrderID"))
+	})
+
+	DescribeTable("Describes models declared in a dependency module in the spec",
+		func(version string) {
+			spec := utils.GetSpec(config, metadata, models, hasStdError, version)
+			schemas := spec["components"].(map[string]any)["schemas"].(map[string]any)
+			Expect(schemas).To(HaveKey("Order"))
+			Expect(schemas).To(HaveKey("Customer"))
+			Expect(schemas).To(HaveKey("OrderLine"))
+
+			address := schemas["Address"].(map[string]any)
+			Expect(address["required"]).To(ConsistOf("city"))
+
+			orderProperties := schemas["Order"].(map[string]any)["properties"].(map[string]any)
+			Expect(orderProperties["id"]).To(HaveKeyWithValue("pattern", "^[0-9a-f]{24}$"))
+		},
+		Entry("OpenAPI 3.0", "3.0.0"),
+		Entry("OpenAPI 3.1", "3.1.0"),
+	)
+})
+
+func TestExternalModule(t *testing.T) {
+	logger.SetLogLevel(logger.LogLevelNone)
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "External Modules")
+}
@@ -0,0 +1,37 @@
+package fieldcomments_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+type Point struct {
+	// A coordinate of the point
+	X, Y float64
+
+	// @Description The point's label
+	// @Deprecated Use the tags instead
+	Label, Caption string
+
+	Tags   []string    `json:"tags"`   // @Description The point's tags
+	Weight float64     `json:"weight"` // The point's weight
+	Origin *Point      `json:"origin"` // @Deprecated
+	Pinned bool        `json:"pinned"`
+	Filter PointFilter `json:"-"`
+}
+
+type PointFilter struct {
+	Label string `query:"label"` // @Description Filters points by label
+	Limit int    `query:"limit"` // The maximal number of points to fetch
+}
+
+// @Route(/test/field-comments)
+type FieldCommentsController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(GET)
+// @Route(/points)
+// @Query(filter)
+func (ec *FieldCommentsController) GetPoints(filter PointFilter) (Point, error) {
+	return Point{}, nil
+}
@@ -0,0 +1,92 @@
+package fieldcomments_test
+
+import (
+	"testing"
+
+	"github.com/gopher-fleece/gleece/definitions"
+	"github.com/gopher-fleece/gleece/infrastructure/logger"
+	"github.com/gopher-fleece/gleece/test/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// getField returns the metadata of the given field of the given model
+func getField(models []definitions.ModelMetadata, modelName string, fieldName string) definitions.FieldMetadata {
+	for _, model := range models {
+		if model.Name != modelName {
+			continue
+		}
+		for _, field := range model.Fields {
+			if field.Name == fieldName {
+				return field
+			}
+		}
+	}
+
+	Fail("could not find field " + fieldName + " of model " + modelName)
+	return definitions.FieldMetadata{}
+}
+
+var _ = Describe("Field Comments", func() {
+	var config *definitions.GleeceConfig
+	var metadata []definitions.ControllerMetadata
+	var models []definitions.ModelMetadata
+	var hasStdError bool
+
+	BeforeEach(func() {
+		var err error
+		config, metadata, models, hasStdError, err = utils.GetConfigAndMetadata("gleece.test.config.json")
+		Expect(err).To(BeNil())
+	})
+
+	It("Applies the comments of multi-name declarations to each of the names", func() {
+		Expect(getField(models, "Point", "X").Description).To(Equal("A coordinate of the point"))
+		Expect(getField(models, "Point", "Y").Description).To(Equal("A coordinate of the point"))
+
+		for _, fieldName := range []string{"Label", "Caption"} {
+			field := getField(models, "Point", fieldName)
+			Expect(field.Description).To(Equal("The point's label"))
+			Expect(field.Deprecation).ToNot(BeNil())
+			Expect(field.Deprecation.Deprecated).To(BeTrue())
+			Expect(field.Deprecation.Description).To(Equal("Use the tags instead"))
+		}
+	})
+
+	It("Uses trailing line comments as annotation sources", func() {
+		Expect(getField(models, "Point", "Tags").Description).To(Equal("The point's tags"))
+		Expect(getField(models, "Point", "Weight").Description).To(Equal("The point's weight"))
+		Expect(getField(models, "Point", "Origin").Deprecation.Deprecated).To(BeTrue())
+		Expect(getField(models, "Point", "Pinned").Description).To(BeEmpty())
+	})
+
+	It("Uses trailing line comments to describe query object fields", func() {
+		params := metadata[0].Routes[0].FuncParams[0].QueryFields
+		Expect(params).To(HaveLen(2))
+		Expect(params[0].NameInSchema).To(Equal("label"))
+		Expect(params[0].Description).To(Equal("Filters points by label"))
+		Expect(params[1].NameInSchema).To(Equal("limit"))
+		Expect(params[1].Description).To(Equal("The maximal number of points to fetch"))
+	})
+
+	DescribeTable("Describes each name of multi-name declarations in the spec",
+		func(version string) {
+			spec := utils.GetSpec(config, metadata, models, hasStdError, version)
+			point := spec["components"].(map[string]any)["schemas"].(map[string]any)["Point"].(map[string]any)
+			properties := point["properties"].(map[string]any)
+
+			Expect(properties).To(HaveKeyWithValue("X", HaveKeyWithValue("description", "A coordinate of the point")))
+			Expect(properties).To(HaveKeyWithValue("Y", HaveKeyWithValue("description", "A coordinate of the point")))
+			Expect(properties).To(HaveKeyWithValue("Label", HaveKeyWithValue("deprecated", true)))
+			Expect(properties).To(HaveKeyWithValue("Caption", HaveKeyWithValue("deprecated", true)))
+			Expect(properties).To(HaveKeyWithValue("tags", HaveKeyWithValue("description", "The point's tags")))
+		},
+		Entry("OpenAPI 3.0", "3.0.0"),
+		Entry("OpenAPI 3.1", "3.1.0"),
+	)
+})
+
+func TestFieldComments(t *testing.T) {
+	logger.SetLogLevel(logger.LogLevelNone)
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Field Comments")
+}
@@ -0,0 +1,87 @@
+package fieldvalues_test
+
+import (
+	"time"
+
+	"github.com/gopher-fleece/runtime"
+)
+
+type Status string
+
+const (
+	StatusActive   Status = "active"
+	StatusInactive Status = "inactive"
+)
+
+type Audit struct {
+	// @Default system
+	CreatedBy string `json:"createdBy"`
+}
+
+type Location struct {
+	City  string `json:"city"`
+	Floor int    `json:"floor"`
+}
+
+type Policy struct {
+	// @Default 30
+	CancellationDays int `json:"cancellationDays"`
+
+	Refundable bool `json:"refundable"`
+}
+
+type Listing struct {
+	Audit
+
+	// @ReadOnly
+	ID string `json:"id"`
+
+	// @Example Cozy cabin
+	Title string `json:"title" validate:"required"`
+
+	// @Example 25
+	// @Default 10
+	PageSize int `json:"pageSize"`
+
+	// @Default 1.5
+	Ratio *float64 `json:"ratio"`
+
+	// @Default true
+	Visible bool `json:"visible"`
+
+	// @Default 7
+	Revision int64 `json:"revision,string"`
+
+	// @Default active
+	Status Status `json:"status"`
+
+	// @Default ["a", "b"]
+	Tags []string `json:"tags"`
+
+	// @Example 2024-01-01T00:00:00Z
+	PublishedAt time.Time `json:"publishedAt"`
+
+	// @Example {"city": "Lisbon", "floor": 2}
+	Location Location `json:"location"`
+
+	Policy Policy `json:"policy"`
+
+	// @Format email
+	// @Pattern ^[^@]+@example\.com$
+	Contact string `json:"contact"`
+
+	// @WriteOnly
+	Password string `json:"password"`
+}
+
+// @Route(/test/field-values)
+type FieldValuesController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(POST)
+// @Route(/listings)
+// @Body(listing)
+func (ec *FieldValuesController) CreateListing(listing Listing) (Listing, error) {
+	return listing, nil
+}
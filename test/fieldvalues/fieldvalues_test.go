@@ -0,0 +1,128 @@
+package fieldvalues_test
+
+import (
+	"testing"
+
+	"github.com/gopher-fleece/gleece/definitions"
+	"github.com/gopher-fleece/gleece/infrastructure/logger"
+	"github.com/gopher-fleece/gleece/test/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// getField returns the metadata of the given field of the given model
+func getField(models []definitions.ModelMetadata, modelName string, fieldName string) definitions.FieldMetadata {
+	for _, model := range models {
+		if model.Name != modelName {
+			continue
+		}
+		for _, field := range model.Fields {
+			if field.Name == fieldName {
+				return field
+			}
+		}
+	}
+
+	Fail("could not find field " + fieldName + " of model " + modelName)
+	return definitions.FieldMetadata{}
+}
+
+var _ = Describe("Field Values", func() {
+	var config *definitions.GleeceConfig
+	var metadata []definitions.ControllerMetadata
+	var models []definitions.ModelMetadata
+	var hasStdError bool
+
+	BeforeEach(func() {
+		var err error
+		config, metadata, models, hasStdError, err = utils.GetConfigAndMetadata("gleece.test.config.json")
+		Expect(err).To(BeNil())
+	})
+
+	It("Parses annotated values per the types of their fields", func() {
+		Expect(getField(models, "Listing", "Title").Example).To(Equal("Cozy cabin"))
+		Expect(getField(models, "Listing", "PageSize").Example).To(Equal(int64(25)))
+		Expect(getField(models, "Listing", "PageSize").Default).To(Equal(int64(10)))
+		Expect(getField(models, "Listing", "Ratio").Default).To(Equal(1.5))
+		Expect(getField(models, "Listing", "Visible").Default).To(Equal(true))
+		Expect(getField(models, "Listing", "Revision").Default).To(Equal("7"))
+		Expect(getField(models, "Listing", "Status").Default).To(Equal("active"))
+		Expect(getField(models, "Listing", "Tags").Default).To(Equal([]any{"a", "b"}))
+		Expect(getField(models, "Listing", "PublishedAt").Example).To(Equal("2024-01-01T00:00:00Z"))
+		Expect(getField(models, "Listing", "Location").Example).To(Equal(map[string]any{"city": "Lisbon", "floor": int64(2)}))
+	})
+
+	It("Extracts formats, patterns and access modes", func() {
+		contact := getField(models, "Listing", "Contact")
+		Expect(contact.Format).To(Equal("email"))
+		Expect(contact.Pattern).To(Equal(`^[^@]+@example\.com$`))
+		Expect(getField(models, "Listing", "ID").ReadOnly).To(BeTrue())
+		Expect(getField(models, "Listing", "Password").WriteOnly).To(BeTrue())
+	})
+
+	It("Collects the default values of body fields, including promoted and nested ones", func() {
+		body := metadata[0].Routes[0].FuncParams[0]
+		Expect(body.BodyDefaults).To(MatchJSON(`{
+			"createdBy": "system",
+			"policy": {"cancellationDays": 30},
+			"pageSize": 10,
+			"ratio": 1.5,
+			"visible": true,
+			"revision": "7",
+			"status": "active",
+			"tags": ["a", "b"]
+		}`))
+	})
+
+	DescribeTable("Describes annotated values in the spec",
+		func(version string) {
+			spec := utils.GetSpec(config, metadata, models, hasStdError, version)
+			listing := spec["components"].(map[string]any)["schemas"].(map[string]any)["Listing"].(map[string]any)
+			properties := listing["properties"].(map[string]any)
+
+			Expect(properties["pageSize"]).To(HaveKeyWithValue("default", BeNumerically("==", 10)))
+			Expect(properties["pageSize"]).To(Or(
+				HaveKeyWithValue("example", BeNumerically("==", 25)),
+				HaveKeyWithValue("examples", ConsistOf(BeNumerically("==", 25))),
+			))
+			Expect(properties["tags"]).To(HaveKeyWithValue("default", ConsistOf("a", "b")))
+			Expect(properties["contact"]).To(HaveKeyWithValue("format", "email"))
+			Expect(properties["contact"]).To(HaveKeyWithValue("pattern", `^[^@]+@example\.com$`))
+			Expect(properties["id"]).To(HaveKeyWithValue("readOnly", true))
+			Expect(properties["password"]).To(HaveKeyWithValue("writeOnly", true))
+			Expect(properties["status"]).To(HaveKeyWithValue("default", "active"))
+			Expect(properties["status"]).To(HaveKeyWithValue("allOf", ConsistOf(HaveKeyWithValue("$ref", "#/components/schemas/Status"))))
+		},
+		Entry("OpenAPI 3.0", "3.0.0"),
+		Entry("OpenAPI 3.1", "3.1.0"),
+	)
+
+	DescribeTable("Fails for values that do not match the types of their fields",
+		func(configName string, expectedError string) {
+			_, _, _, _, err := utils.GetConfigAndMetadata(configName)
+			Expect(err).To(MatchError(ContainSubstring(expectedError)))
+		},
+		Entry("Non-numeric integers", "gleece.invalid.config.json",
+			`field "Size" in struct "Page" - invalid @Default value 'ten'`),
+		Entry("Integers out of the range of their type", "gleece.invalid.range.config.json",
+			`field "Level" in struct "RangePage" - invalid @Default value '300' - expected a value of type 'int8'`),
+		Entry("Fields tagged ',string'", "gleece.invalid.string.config.json",
+			`field "Revision" in struct "StringPage" - invalid @Default value 'seven' - expected a value of type 'int64'`),
+		Entry("Well-known types serialized as strings", "gleece.invalid.time.config.json",
+			`field "PublishedAt" in struct "TimePage" - invalid @Example value 'yesterday' - expected a value of type 'time.Time'`),
+		Entry("Values that are not of their enum", "gleece.invalid.enum.config.json",
+			`field "Status" in struct "EnumPage" - invalid @Default value 'archived' - expected one of the values of enum 'Status' (active, inactive)`),
+		Entry("Slices of the wrong element type", "gleece.invalid.slice.config.json",
+			`field "Tags" in struct "SlicePage" - invalid @Default value '[1, 2]' - item 0 - expected a JSON string, got integer`),
+		Entry("Objects of the wrong shape", "gleece.invalid.object.config.json",
+			`invalid @Example value '{"city": "Lisbon", "floor": "2"}' - property 'floor' - expected a JSON number, got string`),
+		Entry("Defaults of models nested by pointer", "gleece.invalid.nested.config.json",
+			`could not apply the default values of body 'page' - field 'Policy' of model 'NestedPage' nests model 'Policy' by pointer`),
+	)
+})
+
+func TestFieldValues(t *testing.T) {
+	logger.SetLogLevel(logger.LogLevelNone)
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Field Values")
+}
@@ -0,0 +1,22 @@
+package fieldvalues_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+type Page struct {
+	// @Default ten
+	Size int `json:"size"`
+}
+
+// @Route(/test/field-values-invalid)
+type InvalidFieldValuesController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(POST)
+// @Route(/pages)
+// @Body(page)
+func (ec *InvalidFieldValuesController) CreatePage(page Page) (Page, error) {
+	return page, nil
+}
@@ -0,0 +1,22 @@
+package fieldvalues_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+type EnumPage struct {
+	// @Default archived
+	Status Status `json:"status"`
+}
+
+// @Route(/test/field-values-invalid-enum)
+type InvalidEnumFieldValuesController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(POST)
+// @Route(/pages)
+// @Body(page)
+func (ec *InvalidEnumFieldValuesController) CreatePage(page EnumPage) (EnumPage, error) {
+	return page, nil
+}
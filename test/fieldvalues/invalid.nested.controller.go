@@ -0,0 +1,21 @@
+package fieldvalues_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+type NestedPage struct {
+	Policy *Policy `json:"policy"`
+}
+
+// @Route(/test/field-values-invalid-nested)
+type InvalidNestedFieldValuesController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(POST)
+// @Route(/pages)
+// @Body(page)
+func (ec *InvalidNestedFieldValuesController) CreatePage(page NestedPage) (NestedPage, error) {
+	return page, nil
+}
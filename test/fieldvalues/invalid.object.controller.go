@@ -0,0 +1,25 @@
+package fieldvalues_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+type ObjectPage struct {
+	// @Example {"city": "Lisbon", "floor": "2"}
+	Location struct {
+		City  string `json:"city"`
+		Floor int    `json:"floor"`
+	} `json:"location"`
+}
+
+// @Route(/test/field-values-invalid-object)
+type InvalidObjectFieldValuesController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(POST)
+// @Route(/pages)
+// @Body(page)
+func (ec *InvalidObjectFieldValuesController) CreatePage(page ObjectPage) (ObjectPage, error) {
+	return page, nil
+}
@@ -0,0 +1,22 @@
+package fieldvalues_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+type RangePage struct {
+	// @Default 300
+	Level int8 `json:"level"`
+}
+
+// @Route(/test/field-values-invalid-range)
+type InvalidRangeFieldValuesController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(POST)
+// @Route(/pages)
+// @Body(page)
+func (ec *InvalidRangeFieldValuesController) CreatePage(page RangePage) (RangePage, error) {
+	return page, nil
+}
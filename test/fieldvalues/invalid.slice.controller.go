@@ -0,0 +1,22 @@
+package fieldvalues_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+type SlicePage struct {
+	// @Default [1, 2]
+	Tags []string `json:"tags"`
+}
+
+// @Route(/test/field-values-invalid-slice)
+type InvalidSliceFieldValuesController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(POST)
+// @Route(/pages)
+// @Body(page)
+func (ec *InvalidSliceFieldValuesController) CreatePage(page SlicePage) (SlicePage, error) {
+	return page, nil
+}
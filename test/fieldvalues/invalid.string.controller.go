@@ -0,0 +1,22 @@
+package fieldvalues_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+type StringPage struct {
+	// @Default seven
+	Revision int64 `json:"revision,string"`
+}
+
+// @Route(/test/field-values-invalid-string)
+type InvalidStringFieldValuesController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(POST)
+// @Route(/pages)
+// @Body(page)
+func (ec *InvalidStringFieldValuesController) CreatePage(page StringPage) (StringPage, error) {
+	return page, nil
+}
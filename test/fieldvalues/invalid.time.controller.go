@@ -0,0 +1,24 @@
+package fieldvalues_test
+
+import (
+	"time"
+
+	"github.com/gopher-fleece/runtime"
+)
+
+type TimePage struct {
+	// @Example yesterday
+	PublishedAt time.Time `json:"publishedAt"`
+}
+
+// @Route(/test/field-values-invalid-time)
+type InvalidTimeFieldValuesController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(POST)
+// @Route(/pages)
+// @Body(page)
+func (ec *InvalidTimeFieldValuesController) CreatePage(page TimePage) (TimePage, error) {
+	return page, nil
+}
@@ -0,0 +1,25 @@
+package formfiles_test
+
+import (
+	"mime/multipart"
+
+	"github.com/gopher-fleece/runtime"
+)
+
+type Upload struct {
+	Title string `json:"title"`
+}
+
+// @Route(/test/form-files)
+type FormFilesController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(POST)
+// @Route(/uploads)
+// @FormField(title)
+// @FormFile(avatar, { validate: "required", maxSize: 1048576, mimeTypes: ["image/png", "Image/JPEG"] }) The user's avatar
+// @FormFile(attachments, { name: "attachment" })
+func (ec *FormFilesController) CreateUpload(title string, avatar *multipart.FileHeader, attachments []*multipart.FileHeader) (Upload, error) {
+	return Upload{Title: title}, nil
+}
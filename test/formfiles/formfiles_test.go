@@ -0,0 +1,86 @@
+package formfiles_test
+
+import (
+	"testing"
+
+	"github.com/gopher-fleece/gleece/definitions"
+	"github.com/gopher-fleece/gleece/infrastructure/logger"
+	"github.com/gopher-fleece/gleece/test/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Form Files", func() {
+	var config *definitions.GleeceConfig
+	var metadata []definitions.ControllerMetadata
+	var models []definitions.ModelMetadata
+	var hasStdError bool
+
+	BeforeEach(func() {
+		var err error
+		config, metadata, models, hasStdError, err = utils.GetConfigAndMetadata("gleece.test.config.json")
+		Expect(err).To(BeNil())
+	})
+
+	It("Binds form files and consumes multipart forms", func() {
+		route := metadata[0].Routes[0]
+		Expect(route.Consumes).To(Equal([]definitions.ContentType{definitions.ContentTypeMultipartForm}))
+		Expect(route.RequestContentType).To(Equal(definitions.ContentTypeMultipartForm))
+
+		avatar := route.FuncParams[1]
+		Expect(avatar.PassedIn).To(Equal(definitions.PassedInFormFile))
+		Expect(avatar.MaxFileSize).To(Equal(int64(1048576)))
+		Expect(avatar.AllowedMimeTypes).To(Equal([]string{"image/png", "image/jpeg"}))
+
+		attachments := route.FuncParams[2]
+		Expect(attachments.PassedIn).To(Equal(definitions.PassedInFormFile))
+		Expect(attachments.NameInSchema).To(Equal("attachment"))
+		Expect(attachments.MaxFileSize).To(BeZero())
+		Expect(attachments.AllowedMimeTypes).To(BeEmpty())
+	})
+
+	It("Does not treat uploaded files as models", func() {
+		for _, model := range models {
+			Expect(model.Name).ToNot(Equal("FileHeader"))
+		}
+	})
+
+	DescribeTable("Describes form files as binary properties of a multipart form",
+		func(version string) {
+			spec := utils.GetSpec(config, metadata, models, hasStdError, version)
+			operation := spec["paths"].(map[string]any)["/test/form-files/uploads"].(map[string]any)["post"].(map[string]any)
+
+			content := operation["requestBody"].(map[string]any)["content"].(map[string]any)
+			Expect(content).To(HaveLen(1))
+			Expect(content).To(HaveKey("multipart/form-data"))
+
+			schema := content["multipart/form-data"].(map[string]any)["schema"].(map[string]any)
+			properties := schema["properties"].(map[string]any)
+			Expect(properties["title"]).To(HaveKeyWithValue("type", "string"))
+			Expect(properties["avatar"]).To(HaveKeyWithValue("type", "string"))
+			Expect(properties["avatar"]).To(HaveKeyWithValue("format", "binary"))
+
+			attachment := properties["attachment"].(map[string]any)
+			Expect(attachment).To(HaveKeyWithValue("type", "array"))
+			Expect(attachment["items"]).To(HaveKeyWithValue("type", "string"))
+			Expect(attachment["items"]).To(HaveKeyWithValue("format", "binary"))
+
+			Expect(schema["required"]).To(ConsistOf("title", "avatar", "attachment"))
+		},
+		Entry("OpenAPI 3.0", "3.0.0"),
+		Entry("OpenAPI 3.1", "3.1.0"),
+	)
+
+	It("Fails for form files that are not bound to file headers", func() {
+		_, _, _, _, err := utils.GetConfigAndMetadata("gleece.invalid.config.json")
+		Expect(err).To(MatchError(ContainSubstring(
+			"form file parameters must be of type '*multipart.FileHeader' or '[]*multipart.FileHeader' but parameter 'avatar'",
+		)))
+	})
+})
+
+func TestFormFiles(t *testing.T) {
+	logger.SetLogLevel(logger.LogLevelNone)
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Form Files")
+}
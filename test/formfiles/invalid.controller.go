@@ -0,0 +1,17 @@
+package formfiles_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+// @Route(/test/form-files-invalid)
+type InvalidFormFilesController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(POST)
+// @Route(/uploads)
+// @FormFile(avatar)
+func (ec *InvalidFormFilesController) CreateUpload(avatar string) error {
+	return nil
+}
@@ -0,0 +1,35 @@
+package jsontags_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+type auditInfo struct {
+	CreatedBy string `json:"createdBy" validate:"required"`
+}
+
+type internalCode int
+
+type Account struct {
+	auditInfo
+	*internalCode
+	ID       string `json:"id" validate:"required"`
+	Nickname string `json:"nickname,omitempty" validate:"required"`
+	Balance  int64  `json:"balance,string" validate:"gte=0"`
+	Password string `json:"-"`
+	Dash     string `json:"-,"`
+	Untagged string
+	secret   string
+}
+
+// @Route(/test/json-tags)
+type JsonTagsController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(POST)
+// @Route(/accounts)
+// @Body(account)
+func (ec *JsonTagsController) CreateAccount(account Account) (Account, error) {
+	return account, nil
+}
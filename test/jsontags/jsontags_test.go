@@ -0,0 +1,64 @@
+package jsontags_test
+
+import (
+	"testing"
+
+	"github.com/gopher-fleece/gleece/definitions"
+	"github.com/gopher-fleece/gleece/infrastructure/logger"
+	"github.com/gopher-fleece/gleece/test/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Json Tags", func() {
+	var config *definitions.GleeceConfig
+	var metadata []definitions.ControllerMetadata
+	var models []definitions.ModelMetadata
+	var hasStdError bool
+
+	BeforeEach(func() {
+		var err error
+		config, metadata, models, hasStdError, err = utils.GetConfigAndMetadata("gleece.test.config.json")
+		Expect(err).To(BeNil())
+	})
+
+	It("Only extracts fields serialized by encoding/json", func() {
+		Expect(models).To(HaveLen(1))
+
+		fieldNames := []string{}
+		for _, field := range models[0].Fields {
+			fieldNames = append(fieldNames, field.Name)
+		}
+		Expect(fieldNames).To(ConsistOf("CreatedBy", "ID", "Nickname", "Balance", "Dash", "Untagged"))
+	})
+
+	DescribeTable("Describes models as they are serialized by encoding/json",
+		func(version string) {
+			spec := utils.GetSpec(config, metadata, models, hasStdError, version)
+			account := spec["components"].(map[string]any)["schemas"].(map[string]any)["Account"].(map[string]any)
+			properties := account["properties"].(map[string]any)
+
+			Expect(properties).To(HaveLen(6))
+			Expect(properties).To(HaveKey("createdBy"))
+			Expect(properties).To(HaveKey("id"))
+			Expect(properties).To(HaveKey("nickname"))
+			Expect(properties).To(HaveKey("-"))
+			Expect(properties).To(HaveKey("Untagged"))
+			Expect(properties).ToNot(HaveKey("Password"))
+			Expect(properties).ToNot(HaveKey("secret"))
+
+			Expect(properties["balance"]).To(HaveKeyWithValue("type", Or(Equal("string"), ConsistOf("string"))))
+			Expect(properties["balance"]).ToNot(HaveKey("minimum"))
+
+			Expect(account["required"]).To(ConsistOf("createdBy", "id"))
+		},
+		Entry("OpenAPI 3.0", "3.0.0"),
+		Entry("OpenAPI 3.1", "3.1.0"),
+	)
+})
+
+func TestJsonTags(t *testing.T) {
+	logger.SetLogLevel(logger.LogLevelNone)
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Json Tags")
+}
@@ -0,0 +1,16 @@
+package maps_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+// @Route(/test/maps)
+type InvalidKeyController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(GET)
+// @Route(/ratios)
+func (ec *InvalidKeyController) GetRatios() (map[float64]string, error) {
+	return map[float64]string{}, nil
+}
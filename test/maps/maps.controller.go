@@ -0,0 +1,40 @@
+package maps_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+type Item struct {
+	Name string `json:"name"`
+}
+
+type Inventory struct {
+	ItemsBySku  map[string]Item            `json:"itemsBySku"`
+	CountsByBin map[int]map[string]int     `json:"countsByBin"`
+	TagsBySku   map[string][]string        `json:"tagsBySku"`
+	Metadata    map[string]any             `json:"metadata"`
+	Nested      map[string]map[string]Item `json:"nested"`
+}
+
+// @Route(/test/maps)
+type MapsController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(GET)
+// @Route(/items)
+func (ec *MapsController) GetItems() (map[string]Item, error) {
+	return map[string]Item{}, nil
+}
+
+// @Method(GET)
+// @Route(/counts)
+func (ec *MapsController) GetCounts() (map[int]int, error) {
+	return map[int]int{}, nil
+}
+
+// @Method(GET)
+// @Route(/inventory)
+func (ec *MapsController) GetInventory() (Inventory, error) {
+	return Inventory{}, nil
+}
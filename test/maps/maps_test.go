@@ -0,0 +1,99 @@
+package maps_test
+
+import (
+	"testing"
+
+	"github.com/gopher-fleece/gleece/definitions"
+	"github.com/gopher-fleece/gleece/infrastructure/logger"
+	"github.com/gopher-fleece/gleece/test/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// getResponseSchema returns the schema of the successful response of the GET operation at the given path
+func getResponseSchema(spec map[string]any, path string) map[string]any {
+	operation := spec["paths"].(map[string]any)[path].(map[string]any)["get"].(map[string]any)
+	response := operation["responses"].(map[string]any)["200"].(map[string]any)
+	return response["content"].(map[string]any)["application/json"].(map[string]any)["schema"].(map[string]any)
+}
+
+var _ = Describe("Maps", func() {
+	Context("Valid maps", func() {
+		var config *definitions.GleeceConfig
+		var metadata []definitions.ControllerMetadata
+		var models []definitions.ModelMetadata
+		var hasStdError bool
+
+		BeforeEach(func() {
+			var err error
+			config, metadata, models, hasStdError, err = utils.GetConfigAndMetadata("gleece.test.config.json")
+			Expect(err).To(BeNil())
+		})
+
+		It("Extracts the key and value types of map responses", func() {
+			response := metadata[0].Routes[0].Responses[0]
+			Expect(response.IsMap()).To(BeTrue())
+			Expect(response.Name).To(Equal("map[string]Item"))
+			Expect(response.KeyType.Name).To(Equal("string"))
+			Expect(response.ValueType.Name).To(Equal("Item"))
+			Expect(response.ValueType.SchemaName).To(Equal("Item"))
+		})
+
+		It("Produces models for the values of map responses", func() {
+			Expect(models).To(HaveLen(2))
+			Expect(models).To(ContainElement(HaveField("Name", "Item")))
+			Expect(models).To(ContainElement(HaveField("Name", "Inventory")))
+		})
+
+		DescribeTable("Describes maps via additionalProperties",
+			func(version string) {
+				spec := utils.GetSpec(config, metadata, models, hasStdError, version)
+
+				Expect(getResponseSchema(spec, "/test/maps/items")).To(HaveKeyWithValue(
+					"additionalProperties", HaveKeyWithValue("$ref", "#/components/schemas/Item"),
+				))
+				Expect(getResponseSchema(spec, "/test/maps/counts")).To(HaveKeyWithValue(
+					"additionalProperties", HaveKeyWithValue("type", Or(Equal("integer"), ConsistOf("integer"))),
+				))
+
+				schemas := spec["components"].(map[string]any)["schemas"].(map[string]any)
+				properties := schemas["Inventory"].(map[string]any)["properties"].(map[string]any)
+
+				Expect(properties["itemsBySku"]).To(HaveKeyWithValue(
+					"additionalProperties", HaveKeyWithValue("$ref", "#/components/schemas/Item"),
+				))
+				Expect(properties["countsByBin"]).To(HaveKeyWithValue(
+					"additionalProperties", HaveKeyWithValue(
+						"additionalProperties", HaveKeyWithValue("type", Or(Equal("integer"), ConsistOf("integer"))),
+					),
+				))
+				Expect(properties["tagsBySku"]).To(HaveKeyWithValue(
+					"additionalProperties", HaveKeyWithValue("items", HaveKeyWithValue("type", Or(Equal("string"), ConsistOf("string")))),
+				))
+				Expect(properties["nested"]).To(HaveKeyWithValue(
+					"additionalProperties", HaveKeyWithValue(
+						"additionalProperties", HaveKeyWithValue("$ref", "#/components/schemas/Item"),
+					),
+				))
+				Expect(properties["metadata"]).ToNot(HaveKey("additionalProperties"))
+			},
+			Entry("OpenAPI 3.0", "3.0.0"),
+			Entry("OpenAPI 3.1", "3.1.0"),
+		)
+	})
+
+	Context("Invalid maps", func() {
+		It("Fails for map responses whose keys are neither strings nor integers", func() {
+			_, _, _, _, err := utils.GetConfigAndMetadata("gleece.invalidkey.config.json")
+			Expect(err).To(MatchError(ContainSubstring(
+				"return type 'map[float64]string' is not supported - map keys must be strings or integers",
+			)))
+		})
+	})
+})
+
+func TestMaps(t *testing.T) {
+	logger.SetLogLevel(logger.LogLevelNone)
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Maps")
+}
@@ -0,0 +1,13 @@
+package contracts
+
+import "github.com/gopher-fleece/runtime"
+
+// @Description A contract shared by services, declared outside the controller globs
+type UserContract struct {
+	// The user's unique identifier
+	ID       string                `json:"id" validate:"required"`
+	Security runtime.SecurityCheck `json:"security"`
+}
+
+// Ulid is a lexicographically sortable identifier, serialized as a 26 characters string
+type Ulid [16]byte
@@ -0,0 +1,152 @@
+package models_test
+
+import (
+	"encoding/json"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gopher-fleece/gleece/test/models/contracts"
+	"github.com/gopher-fleece/runtime"
+)
+
+type Address struct {
+	Street string `json:"street" validate:"required"`
+}
+
+// @Description A model with pointer fields
+type PointersModel struct {
+	// An optional nickname
+	Nickname *string `json:"nickname"`
+	// A mandatory, yet nullable, age
+	Age     *int     `json:"age" validate:"required"`
+	Address *Address `json:"address"`
+	Name    string   `json:"name"`
+}
+
+type Timestamps struct {
+	// When the entity was created
+	CreatedAt string `json:"createdAt" validate:"required"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+type Identity struct {
+	ID string `json:"id" validate:"required"`
+	Timestamps
+}
+
+type auditInfo struct {
+	AuditedBy string `json:"auditedBy"`
+}
+
+type Categories struct {
+	Name string
+}
+
+type Tags struct {
+	Name string
+}
+
+// @Description A model embedding other structs
+type EmbeddingModel struct {
+	Identity
+	auditInfo
+	Address `json:"address"`
+	Categories
+	Tags
+	UpdatedAt string `json:"updatedAt"`
+	Title     string `json:"title"`
+}
+
+type WellKnownTypesModel struct {
+	ID        uuid.UUID       `json:"id"`
+	CreatedAt time.Time       `json:"createdAt"`
+	Timeout   time.Duration   `json:"timeout"`
+	Payload   json.RawMessage `json:"payload"`
+	Data      []byte          `json:"data"`
+	Address   net.IP          `json:"address"`
+	Website   *url.URL        `json:"website"`
+}
+
+type MappedTypesModel struct {
+	ID       contracts.Ulid   `json:"id" validate:"required"`
+	Related  []contracts.Ulid `json:"related"`
+	ParentID *contracts.Ulid  `json:"parentId"`
+}
+
+// @Description A generic envelope
+type Envelope[T any] struct {
+	// The wrapped payload
+	Data    T   `json:"data" validate:"required"`
+	Version int `json:"version"`
+}
+
+type Pair[K any, V any] struct {
+	Key   K `json:"key" validate:"required"`
+	Value V `json:"value"`
+}
+
+// @Tag(Models Controller Tag)
+// @Route(/test/models)
+// @Description Models Controller
+type ModelsController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(GET)
+// @Route(/pointers)
+func (ec *ModelsController) GetPointersModel() (PointersModel, error) {
+	return PointersModel{}, nil
+}
+
+// @Method(GET)
+// @Route(/embedding)
+func (ec *ModelsController) GetEmbeddingModel() (EmbeddingModel, error) {
+	return EmbeddingModel{}, nil
+}
+
+// @Method(GET)
+// @Route(/contract)
+func (ec *ModelsController) GetContract() (contracts.UserContract, error) {
+	return contracts.UserContract{}, nil
+}
+
+// @Method(POST)
+// @Route(/security-check)
+// @Body(check)
+func (ec *ModelsController) PostSecurityCheck(check runtime.Rfc7807Error) error {
+	return nil
+}
+
+// @Method(GET)
+// @Route(/well-known/{at})
+// @Path(at)
+func (ec *ModelsController) GetWellKnownTypesModel(at time.Time) (WellKnownTypesModel, error) {
+	return WellKnownTypesModel{}, nil
+}
+
+// @Method(GET)
+// @Route(/mapped)
+func (ec *ModelsController) GetMappedTypesModel() (MappedTypesModel, error) {
+	return MappedTypesModel{}, nil
+}
+
+// @Method(GET)
+// @Route(/envelope)
+func (ec *ModelsController) GetEnvelope() (Envelope[Address], error) {
+	return Envelope[Address]{}, nil
+}
+
+// @Method(GET)
+// @Route(/counts)
+func (ec *ModelsController) GetCounts() (Envelope[map[string][]int], error) {
+	return Envelope[map[string][]int]{}, nil
+}
+
+// @Method(POST)
+// @Route(/pair)
+// @Body(pair)
+func (ec *ModelsController) PostPair(pair Pair[string, *contracts.UserContract]) (Envelope[Pair[string, int]], error) {
+	return Envelope[Pair[string, int]]{}, nil
+}
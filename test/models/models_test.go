@@ -0,0 +1,391 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/gopher-fleece/gleece/definitions"
+	"github.com/gopher-fleece/gleece/infrastructure/logger"
+	"github.com/gopher-fleece/gleece/test/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var config *definitions.GleeceConfig
+var metadata []definitions.ControllerMetadata
+var models []definitions.ModelMetadata
+var hasStdError bool
+
+var _ = BeforeSuite(func() {
+	var err error
+	config, metadata, models, hasStdError, err = utils.GetConfigAndMetadata("gleece.test.config.json")
+	Expect(err).To(BeNil())
+})
+
+func getModelByName(name string) *definitions.ModelMetadata {
+	return findModel(models, name)
+}
+
+func findModel(models []definitions.ModelMetadata, name string) *definitions.ModelMetadata {
+	for _, model := range models {
+		if model.Name == name {
+			return &model
+		}
+	}
+	return nil
+}
+
+func getFieldByName(model *definitions.ModelMetadata, name string) *definitions.FieldMetadata {
+	for _, field := range model.Fields {
+		if field.Name == name {
+			return &field
+		}
+	}
+	return nil
+}
+
+// generateSpec generates an OpenAPI spec of the given version for the test controllers and returns its generic JSON form
+func generateSpec(version string) map[string]any {
+	return generateSpecFor(config, metadata, models, version)
+}
+
+func generateSpecFor(
+	specConfig *definitions.GleeceConfig,
+	specMetadata []definitions.ControllerMetadata,
+	specModels []definitions.ModelMetadata,
+	version string,
+) map[string]any {
+	return utils.GetSpec(specConfig, specMetadata, specModels, hasStdError, version)
+}
+
+func getSchemaFromSpec(spec map[string]any, schemaName string) map[string]any {
+	schemas := spec["components"].(map[string]any)["schemas"].(map[string]any)
+	return schemas[schemaName].(map[string]any)
+}
+
+var _ = Describe("Models", func() {
+	Context("Pointer fields", func() {
+		It("Follows pointer fields to their element type", func() {
+			model := getModelByName("PointersModel")
+			Expect(model).ToNot(BeNil())
+			Expect(model.Fields).To(HaveLen(4))
+
+			nickname := getFieldByName(model, "Nickname")
+			Expect(nickname.Type).To(Equal("string"))
+			Expect(nickname.IsByAddress).To(BeTrue())
+			Expect(nickname.Description).To(Equal("An optional nickname"))
+
+			address := getFieldByName(model, "Address")
+			Expect(address.Type).To(Equal("Address"))
+			Expect(address.IsByAddress).To(BeTrue())
+
+			name := getFieldByName(model, "Name")
+			Expect(name.IsByAddress).To(BeFalse())
+
+			Expect(getModelByName("Address")).ToNot(BeNil())
+		})
+
+		It("Emits nullable properties in an OpenAPI 3.0 spec", func() {
+			schema := getSchemaFromSpec(generateSpec("3.0.0"), "PointersModel")
+			properties := schema["properties"].(map[string]any)
+
+			nickname := properties["nickname"].(map[string]any)
+			Expect(nickname["nullable"]).To(BeTrue())
+			Expect(nickname["type"]).To(Equal("string"))
+
+			address := properties["address"].(map[string]any)
+			Expect(address["nullable"]).To(BeTrue())
+			Expect(address["allOf"]).To(HaveLen(1))
+
+			Expect(properties["name"].(map[string]any)).ToNot(HaveKey("nullable"))
+			Expect(schema["required"]).To(ConsistOf("age"))
+		})
+
+		It("Emits nullable properties in an OpenAPI 3.1 spec", func() {
+			schema := getSchemaFromSpec(generateSpec("3.1.0"), "PointersModel")
+			properties := schema["properties"].(map[string]any)
+
+			nickname := properties["nickname"].(map[string]any)
+			Expect(nickname["type"]).To(Equal([]any{"string", "null"}))
+
+			address := properties["address"].(map[string]any)
+			Expect(address["oneOf"]).To(HaveLen(2))
+
+			Expect(properties["name"].(map[string]any)["type"]).To(Equal("string"))
+			Expect(schema["required"]).To(ConsistOf("age"))
+		})
+	})
+
+	Context("Embedded structs", func() {
+		getFieldNames := func(model *definitions.ModelMetadata) []string {
+			names := []string{}
+			for _, field := range model.Fields {
+				names = append(names, field.Name)
+			}
+			return names
+		}
+
+		It("Promotes the fields of embedded structs using encoding/json's conflict rules", func() {
+			model := getModelByName("EmbeddingModel")
+			Expect(model).ToNot(BeNil())
+			Expect(model.EmbeddedModels).To(BeEmpty())
+
+			// 'UpdatedAt' is shadowed by the shallower field and the ambiguous 'Name' fields are dropped
+			Expect(getFieldNames(model)).To(Equal([]string{"ID", "CreatedAt", "AuditedBy", "Address", "UpdatedAt", "Title"}))
+
+			createdAt := getFieldByName(model, "CreatedAt")
+			Expect(createdAt.Description).To(Equal("When the entity was created"))
+			Expect(createdAt.Tag).To(ContainSubstring(`json:"createdAt"`))
+
+			address := getFieldByName(model, "Address")
+			Expect(address.Type).To(Equal("Address"))
+
+			Expect(getModelByName("Identity")).To(BeNil())
+			Expect(getModelByName("Timestamps")).To(BeNil())
+		})
+
+		It("Emits promoted fields as properties of the embedding schema", func() {
+			for _, version := range []string{"3.0.0", "3.1.0"} {
+				schema := getSchemaFromSpec(generateSpec(version), "EmbeddingModel")
+				Expect(schema["properties"]).To(HaveLen(6))
+				Expect(schema["properties"]).To(HaveKey("createdAt"))
+				Expect(schema["properties"]).ToNot(HaveKey("Name"))
+				Expect(schema["required"]).To(ConsistOf("id", "createdAt"))
+			}
+		})
+
+		Context("When composed via allOf", func() {
+			var allOfMetadata []definitions.ControllerMetadata
+			var allOfModels []definitions.ModelMetadata
+			var allOfConfig *definitions.GleeceConfig
+
+			BeforeEach(func() {
+				var err error
+				allOfConfig, allOfMetadata, allOfModels, _, err = utils.GetConfigAndMetadata("gleece.allof.config.json")
+				Expect(err).To(BeNil())
+			})
+
+			It("Lists embedded structs as models of their own", func() {
+				model := findModel(allOfModels, "EmbeddingModel")
+				Expect(model).ToNot(BeNil())
+				Expect(model.EmbeddedModels).To(Equal([]string{"Identity", "auditInfo", "Categories", "Tags"}))
+				Expect(getFieldNames(model)).To(Equal([]string{"Address", "UpdatedAt", "Title"}))
+
+				identity := findModel(allOfModels, "Identity")
+				Expect(identity).ToNot(BeNil())
+				Expect(identity.EmbeddedModels).To(Equal([]string{"Timestamps"}))
+				Expect(getFieldNames(identity)).To(Equal([]string{"ID"}))
+
+				Expect(findModel(allOfModels, "Timestamps")).ToNot(BeNil())
+			})
+
+			It("Composes the embedding schema using allOf", func() {
+				for _, version := range []string{"3.0.0", "3.1.0"} {
+					schema := getSchemaFromSpec(generateSpecFor(allOfConfig, allOfMetadata, allOfModels, version), "EmbeddingModel")
+					Expect(schema["description"]).To(Equal("A model embedding other structs"))
+					Expect(schema).ToNot(HaveKey("properties"))
+
+					allOf := schema["allOf"].([]any)
+					Expect(allOf).To(HaveLen(5))
+					Expect(allOf[0].(map[string]any)["$ref"]).To(Equal("#/components/schemas/Identity"))
+
+					ownSchema := allOf[4].(map[string]any)
+					Expect(ownSchema["properties"]).To(HaveLen(3))
+					Expect(ownSchema["properties"]).To(HaveKey("address"))
+				}
+			})
+		})
+	})
+
+	Context("External packages", func() {
+		It("Resolves models from packages outside the controller globs", func() {
+			model := getModelByName("UserContract")
+			Expect(model).ToNot(BeNil())
+			Expect(model.FullyQualifiedPackage).To(Equal("github.com/gopher-fleece/gleece/test/models/contracts"))
+			Expect(model.Description).To(Equal("A contract shared by services, declared outside the controller globs"))
+			Expect(getFieldByName(model, "ID").Description).To(Equal("The user's unique identifier"))
+			Expect(getFieldByName(model, "Security").Type).To(Equal("SecurityCheck"))
+		})
+
+		It("Resolves models from other modules, including transitive struct fields", func() {
+			securityCheck := getModelByName("SecurityCheck")
+			Expect(securityCheck).ToNot(BeNil())
+			Expect(securityCheck.FullyQualifiedPackage).To(Equal("github.com/gopher-fleece/runtime"))
+			Expect(securityCheck.Fields).To(HaveLen(2))
+
+			rfcError := getModelByName("Rfc7807Error")
+			Expect(rfcError).ToNot(BeNil())
+			Expect(rfcError.FullyQualifiedPackage).To(Equal("github.com/gopher-fleece/runtime"))
+		})
+
+		It("Generates schemas for external models", func() {
+			spec := generateSpec("3.0.0")
+			contract := getSchemaFromSpec(spec, "UserContract")
+			security := contract["properties"].(map[string]any)["security"].(map[string]any)
+			Expect(security["$ref"]).To(Equal("#/components/schemas/SecurityCheck"))
+			Expect(getSchemaFromSpec(spec, "SecurityCheck")["required"]).To(ConsistOf("name"))
+		})
+	})
+
+	Context("Well-known types", func() {
+		It("Refers to well-known types by their full name without creating models for them", func() {
+			model := getModelByName("WellKnownTypesModel")
+			Expect(model).ToNot(BeNil())
+			Expect(getFieldByName(model, "ID").Type).To(Equal("github.com/google/uuid.UUID"))
+			Expect(getFieldByName(model, "CreatedAt").Type).To(Equal("time.Time"))
+			Expect(getFieldByName(model, "Timeout").Type).To(Equal("time.Duration"))
+			Expect(getFieldByName(model, "Payload").Type).To(Equal("encoding/json.RawMessage"))
+			Expect(getFieldByName(model, "Data").Type).To(Equal("[]byte"))
+			Expect(getFieldByName(model, "Website").Type).To(Equal("net/url.URL"))
+
+			for _, name := range []string{"UUID", "Time", "Duration", "RawMessage", "IP", "URL"} {
+				Expect(getModelByName(name)).To(BeNil())
+			}
+		})
+
+		It("Maps well-known types to their dedicated schemas", func() {
+			for _, version := range []string{"3.0.0", "3.1.0"} {
+				schema := getSchemaFromSpec(generateSpec(version), "WellKnownTypesModel")
+				properties := schema["properties"].(map[string]any)
+
+				Expect(properties["id"]).To(HaveKeyWithValue("format", "uuid"))
+				Expect(properties["createdAt"]).To(HaveKeyWithValue("format", "date-time"))
+				Expect(properties["timeout"]).To(HaveKeyWithValue("format", "int64"))
+				Expect(properties["payload"]).ToNot(HaveKey("type"))
+				Expect(properties["data"]).To(HaveKeyWithValue("format", "byte"))
+				Expect(properties["address"]).ToNot(HaveKey("$ref"))
+				Expect(properties["website"]).To(HaveKeyWithValue("format", "uri"))
+			}
+		})
+
+		It("Maps well-known type parameters to their dedicated schemas", func() {
+			spec := generateSpec("3.0.0")
+			operation := spec["paths"].(map[string]any)["/test/models/well-known/{at}"].(map[string]any)["get"].(map[string]any)
+			parameter := operation["parameters"].([]any)[0].(map[string]any)
+			Expect(parameter["schema"]).To(HaveKeyWithValue("type", "string"))
+			Expect(parameter["schema"]).To(HaveKeyWithValue("format", "date-time"))
+		})
+	})
+
+	Context("Type mappings", func() {
+		It("Treats mapped types as well-known types", func() {
+			model := getModelByName("MappedTypesModel")
+			Expect(model).ToNot(BeNil())
+			Expect(getFieldByName(model, "ID").Type).To(Equal("github.com/gopher-fleece/gleece/test/models/contracts.Ulid"))
+			Expect(getModelByName("Ulid")).To(BeNil())
+		})
+
+		It("Uses the mapped schema fragment in an OpenAPI 3.0 spec", func() {
+			schema := getSchemaFromSpec(generateSpec("3.0.0"), "MappedTypesModel")
+			properties := schema["properties"].(map[string]any)
+
+			Expect(properties["id"]).To(HaveKeyWithValue("format", "ulid"))
+			Expect(properties["id"]).To(HaveKeyWithValue("minLength", BeNumerically("==", 26)))
+			Expect(properties["related"].(map[string]any)["items"]).To(HaveKeyWithValue("format", "ulid"))
+			Expect(properties["parentId"]).To(HaveKeyWithValue("nullable", true))
+			Expect(schema["required"]).To(ConsistOf("id"))
+		})
+
+		It("Uses the mapped schema fragment in an OpenAPI 3.1 spec", func() {
+			schema := getSchemaFromSpec(generateSpec("3.1.0"), "MappedTypesModel")
+			properties := schema["properties"].(map[string]any)
+
+			Expect(properties["id"]).To(HaveKeyWithValue("format", "ulid"))
+			Expect(properties["id"]).To(HaveKeyWithValue("maxLength", BeNumerically("==", 26)))
+			Expect(properties["related"].(map[string]any)["items"]).To(HaveKeyWithValue("format", "ulid"))
+			Expect(properties["parentId"]).To(HaveKeyWithValue("type", []any{"string", "null"}))
+		})
+	})
+
+	Context("Generic types", func() {
+		It("Emits instantiated generic structs as distinct models", func() {
+			Expect(getModelByName("Envelope")).To(BeNil())
+			Expect(getModelByName("Pair")).To(BeNil())
+
+			model := getModelByName("EnvelopeOfAddress")
+			Expect(model).ToNot(BeNil())
+			Expect(model.Description).To(Equal("A generic envelope"))
+			Expect(getFieldByName(model, "Data").Type).To(Equal("Address"))
+			Expect(getFieldByName(model, "Data").Description).To(Equal("The wrapped payload"))
+			Expect(getFieldByName(model, "Version").Type).To(Equal("int"))
+		})
+
+		It("Substitutes multiple and pointer type arguments", func() {
+			model := getModelByName("PairOfStringAndUserContract")
+			Expect(model).ToNot(BeNil())
+			Expect(getFieldByName(model, "Key").Type).To(Equal("string"))
+			Expect(getFieldByName(model, "Value").Type).To(Equal("UserContract"))
+			Expect(getFieldByName(model, "Value").IsByAddress).To(BeTrue())
+		})
+
+		It("Names nested instantiations after their type arguments", func() {
+			model := getModelByName("EnvelopeOfPairOfStringAndInt")
+			Expect(model).ToNot(BeNil())
+			Expect(getFieldByName(model, "Data").Type).To(Equal("PairOfStringAndInt"))
+
+			nested := getModelByName("PairOfStringAndInt")
+			Expect(nested).ToNot(BeNil())
+			Expect(getFieldByName(nested, "Value").Type).To(Equal("int"))
+		})
+
+		It("Names map type arguments as valid schema identifiers", func() {
+			model := getModelByName("EnvelopeOfMapOfStringAndIntList")
+			Expect(model).ToNot(BeNil())
+			Expect(getFieldByName(model, "Data").Type).To(Equal("map[string][]int"))
+
+			route := metadata[0].Routes[len(metadata[0].Routes)-2]
+			Expect(route.OperationId).To(Equal("GetCounts"))
+			Expect(route.Responses[0].SchemaTypeName()).To(Equal("EnvelopeOfMapOfStringAndIntList"))
+		})
+
+		It("Records the type arguments of generic parameters and responses", func() {
+			route := metadata[0].Routes[len(metadata[0].Routes)-1]
+			Expect(route.OperationId).To(Equal("PostPair"))
+
+			bodyType := route.FuncParams[0].TypeMeta
+			Expect(bodyType.Name).To(Equal("Pair"))
+			Expect(bodyType.TypeArgs).To(HaveLen(2))
+			Expect(bodyType.TypeArgs[1].FullyQualifiedPackage).To(Equal("github.com/gopher-fleece/gleece/test/models/contracts"))
+			Expect(bodyType.TypeArgs[1].IsByAddress).To(BeTrue())
+			Expect(bodyType.SchemaTypeName()).To(Equal("PairOfStringAndUserContract"))
+			Expect(route.Responses[0].SchemaTypeName()).To(Equal("EnvelopeOfPairOfStringAndInt"))
+		})
+
+		DescribeTable("References instantiated generic schemas from operations",
+			func(version string) {
+				spec := generateSpec(version)
+				paths := spec["paths"].(map[string]any)
+
+				getOperation := paths["/test/models/envelope"].(map[string]any)["get"].(map[string]any)
+				getResponse := getOperation["responses"].(map[string]any)["200"].(map[string]any)
+				Expect(getResponse["content"]).To(HaveKeyWithValue("application/json",
+					HaveKeyWithValue("schema", HaveKeyWithValue("$ref", "#/components/schemas/EnvelopeOfAddress")),
+				))
+
+				countsOperation := paths["/test/models/counts"].(map[string]any)["get"].(map[string]any)
+				countsResponse := countsOperation["responses"].(map[string]any)["200"].(map[string]any)
+				Expect(countsResponse["content"]).To(HaveKeyWithValue("application/json",
+					HaveKeyWithValue("schema", HaveKeyWithValue("$ref", "#/components/schemas/EnvelopeOfMapOfStringAndIntList")),
+				))
+
+				postOperation := paths["/test/models/pair"].(map[string]any)["post"].(map[string]any)
+				requestBody := postOperation["requestBody"].(map[string]any)
+				Expect(requestBody["content"]).To(HaveKeyWithValue("application/json",
+					HaveKeyWithValue("schema", HaveKeyWithValue("$ref", "#/components/schemas/PairOfStringAndUserContract")),
+				))
+
+				schema := getSchemaFromSpec(spec, "EnvelopeOfPairOfStringAndInt")
+				Expect(schema["properties"]).To(HaveKeyWithValue("data",
+					HaveKeyWithValue("$ref", "#/components/schemas/PairOfStringAndInt"),
+				))
+			},
+			Entry("OpenAPI 3.0", "3.0.0"),
+			Entry("OpenAPI 3.1", "3.1.0"),
+		)
+	})
+})
+
+func TestModels(t *testing.T) {
+	logger.SetLogLevel(logger.LogLevelNone)
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Models")
+}
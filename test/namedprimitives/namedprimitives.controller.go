@@ -0,0 +1,40 @@
+package namedprimitives_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+// The unique identifier of a user
+type UserID string
+
+// An amount of money, in cents
+type Cents int64
+
+type Ratio float64
+
+// An email address
+type Email = string
+
+type Account struct {
+	ID      UserID   `json:"id" validate:"required"`
+	Balance Cents    `json:"balance"`
+	Share   *Ratio   `json:"share"`
+	Email   Email    `json:"email"`
+	Friends []UserID `json:"friends"`
+}
+
+// @Route(/test/named-primitives)
+type NamedPrimitivesController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(GET)
+// @Route(/accounts/{id})
+// @Path(id)
+// @Query(minBalance)
+// @Query(email)
+// @Query(friends)
+// @Header(share)
+func (ec *NamedPrimitivesController) GetAccount(id UserID, minBalance Cents, email Email, friends []UserID, share Ratio) (Account, error) {
+	return Account{}, nil
+}
@@ -0,0 +1,153 @@
+package namedprimitives_test
+
+import (
+	"testing"
+
+	"github.com/gopher-fleece/gleece/definitions"
+	"github.com/gopher-fleece/gleece/infrastructure/logger"
+	"github.com/gopher-fleece/gleece/test/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+)
+
+func getModel(models []definitions.ModelMetadata, name string) *definitions.ModelMetadata {
+	for index := range models {
+		if models[index].Name == name {
+			return &models[index]
+		}
+	}
+	return nil
+}
+
+func getFieldTypes(model *definitions.ModelMetadata) map[string]string {
+	fieldTypes := map[string]string{}
+	for _, field := range model.Fields {
+		fieldTypes[field.Name] = field.Type
+	}
+	return fieldTypes
+}
+
+// getParamSchemas returns the schemas of the parameters of the account operation, keyed by the parameters' names
+func getParamSchemas(spec map[string]any) map[string]any {
+	operation := spec["paths"].(map[string]any)["/test/named-primitives/accounts/{id}"].(map[string]any)["get"].(map[string]any)
+	schemas := map[string]any{}
+	for _, param := range operation["parameters"].([]any) {
+		schemas[param.(map[string]any)["name"].(string)] = param.(map[string]any)["schema"]
+	}
+	return schemas
+}
+
+func typeOf(openApiType string) types.GomegaMatcher {
+	return HaveKeyWithValue("type", Or(Equal(openApiType), ConsistOf(openApiType)))
+}
+
+var _ = Describe("Named Primitives", func() {
+	It("Resolves the universe types of named primitive parameters", func() {
+		_, metadata, _, _, err := utils.GetConfigAndMetadata("gleece.test.config.json")
+		Expect(err).To(BeNil())
+
+		params := metadata[0].Routes[0].FuncParams
+		Expect(params[0].TypeMeta.UnderlyingType).To(Equal("string"))
+		Expect(params[1].TypeMeta.UnderlyingType).To(Equal("int64"))
+		Expect(params[2].TypeMeta.UnderlyingType).To(Equal("string"))
+		Expect(params[3].TypeMeta.ElementType.UnderlyingType).To(Equal("string"))
+		Expect(params[4].TypeMeta.UnderlyingType).To(Equal("float64"))
+	})
+
+	Context("Inline mode", func() {
+		It("Refers to named primitive fields by their universe types", func() {
+			_, _, models, _, err := utils.GetConfigAndMetadata("gleece.test.config.json")
+			Expect(err).To(BeNil())
+
+			Expect(models).To(HaveLen(1))
+			Expect(getFieldTypes(getModel(models, "Account"))).To(Equal(map[string]string{
+				"ID":      "string",
+				"Balance": "int64",
+				"Share":   "float64",
+				"Email":   "string",
+				"Friends": "[]string",
+			}))
+		})
+
+		DescribeTable("Describes named primitives by their universe types",
+			func(version string) {
+				config, metadata, models, hasStdError, err := utils.GetConfigAndMetadata("gleece.test.config.json")
+				Expect(err).To(BeNil())
+
+				spec := utils.GetSpec(config, metadata, models, hasStdError, version)
+
+				schemas := spec["components"].(map[string]any)["schemas"].(map[string]any)
+				Expect(schemas).ToNot(HaveKey("UserID"))
+				Expect(schemas).ToNot(HaveKey("Cents"))
+
+				properties := schemas["Account"].(map[string]any)["properties"].(map[string]any)
+				Expect(properties["id"]).To(typeOf("string"))
+				Expect(properties["balance"]).To(typeOf("integer"))
+				Expect(properties["friends"]).To(HaveKeyWithValue("items", typeOf("string")))
+
+				params := getParamSchemas(spec)
+				Expect(params["id"]).To(typeOf("string"))
+				Expect(params["minBalance"]).To(typeOf("integer"))
+				Expect(params["email"]).To(typeOf("string"))
+				Expect(params["friends"]).To(HaveKeyWithValue("items", typeOf("string")))
+				Expect(params["share"]).To(typeOf("number"))
+			},
+			Entry("OpenAPI 3.0", "3.0.0"),
+			Entry("OpenAPI 3.1", "3.1.0"),
+		)
+	})
+
+	Context("Schema mode", func() {
+		It("Records named primitives as models of their own", func() {
+			_, _, models, _, err := utils.GetConfigAndMetadata("gleece.schema.config.json")
+			Expect(err).To(BeNil())
+
+			Expect(models).To(HaveLen(5))
+			Expect(getFieldTypes(getModel(models, "Account"))).To(Equal(map[string]string{
+				"ID":      "UserID",
+				"Balance": "Cents",
+				"Share":   "Ratio",
+				"Email":   "Email",
+				"Friends": "[]UserID",
+			}))
+
+			userId := getModel(models, "UserID")
+			Expect(userId.UnderlyingType).To(Equal("string"))
+			Expect(userId.Description).To(Equal("The unique identifier of a user"))
+			Expect(getModel(models, "Email").Description).To(Equal("An email address"))
+		})
+
+		DescribeTable("Describes named primitives via references to their schemas",
+			func(version string) {
+				config, metadata, models, hasStdError, err := utils.GetConfigAndMetadata("gleece.schema.config.json")
+				Expect(err).To(BeNil())
+
+				spec := utils.GetSpec(config, metadata, models, hasStdError, version)
+
+				schemas := spec["components"].(map[string]any)["schemas"].(map[string]any)
+				Expect(schemas["UserID"]).To(typeOf("string"))
+				Expect(schemas["UserID"]).To(HaveKeyWithValue("description", "The unique identifier of a user"))
+				Expect(schemas["UserID"]).ToNot(HaveKey("enum"))
+				Expect(schemas["Cents"]).To(typeOf("integer"))
+				Expect(schemas["Ratio"]).To(typeOf("number"))
+
+				properties := schemas["Account"].(map[string]any)["properties"].(map[string]any)
+				Expect(properties["id"]).To(HaveKeyWithValue("$ref", "#/components/schemas/UserID"))
+
+				params := getParamSchemas(spec)
+				Expect(params["id"]).To(HaveKeyWithValue("$ref", "#/components/schemas/UserID"))
+				Expect(params["minBalance"]).To(HaveKeyWithValue("$ref", "#/components/schemas/Cents"))
+				Expect(params["friends"]).To(HaveKeyWithValue("items", HaveKeyWithValue("$ref", "#/components/schemas/UserID")))
+			},
+			Entry("OpenAPI 3.0", "3.0.0"),
+			Entry("OpenAPI 3.1", "3.1.0"),
+		)
+	})
+})
+
+func TestNamedPrimitives(t *testing.T) {
+	logger.SetLogLevel(logger.LogLevelNone)
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Named Primitives")
+}
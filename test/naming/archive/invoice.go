@@ -0,0 +1,8 @@
+package archive
+
+// @Name(ArchivedInvoice)
+// @Description An invoice kept for auditing purposes
+type Invoice struct {
+	Number     string `json:"number" validate:"required"`
+	ArchivedAt string `json:"archivedAt"`
+}
@@ -0,0 +1,14 @@
+package billing
+
+import "github.com/gopher-fleece/gleece/test/naming/legacy"
+
+type Invoice struct {
+	Number string `json:"number" validate:"required"`
+	Total  int    `json:"total"`
+}
+
+// @Description A billing statement, referring to both current and legacy invoices
+type Statement struct {
+	Current Invoice        `json:"current" validate:"required"`
+	Legacy  legacy.Invoice `json:"legacy"`
+}
@@ -0,0 +1,27 @@
+package naming_test
+
+import (
+	"github.com/gopher-fleece/gleece/test/naming/archive"
+	"github.com/gopher-fleece/gleece/test/naming/billing"
+	"github.com/gopher-fleece/runtime"
+)
+
+// @Tag(Explicit Naming Controller Tag)
+// @Route(/test/explicit-naming)
+// @Description Explicit Naming Controller
+type ExplicitNamingController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(GET)
+// @Route(/invoice)
+func (ec *ExplicitNamingController) GetInvoice() (billing.Invoice, error) {
+	return billing.Invoice{}, nil
+}
+
+// @Method(POST)
+// @Route(/archived-invoice)
+// @Body(invoice)
+func (ec *ExplicitNamingController) ArchiveInvoice(invoice billing.Invoice) (archive.Invoice, error) {
+	return archive.Invoice{}, nil
+}
@@ -0,0 +1,6 @@
+package legacy
+
+type Invoice struct {
+	Id     int    `json:"id" validate:"required"`
+	Amount string `json:"amount"`
+}
@@ -0,0 +1,27 @@
+package naming_test
+
+import (
+	"github.com/gopher-fleece/gleece/test/naming/billing"
+	"github.com/gopher-fleece/gleece/test/naming/legacy"
+	"github.com/gopher-fleece/runtime"
+)
+
+// @Tag(Naming Controller Tag)
+// @Route(/test/naming)
+// @Description Naming Controller
+type NamingController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(POST)
+// @Route(/legacy-invoice)
+// @Body(invoice)
+func (ec *NamingController) PostLegacyInvoice(invoice legacy.Invoice) (billing.Invoice, error) {
+	return billing.Invoice{}, nil
+}
+
+// @Method(GET)
+// @Route(/statement)
+func (ec *NamingController) GetStatement() (billing.Statement, error) {
+	return billing.Statement{}, nil
+}
@@ -0,0 +1,137 @@
+package naming_test
+
+import (
+	"testing"
+
+	"github.com/gopher-fleece/gleece/definitions"
+	"github.com/gopher-fleece/gleece/infrastructure/logger"
+	"github.com/gopher-fleece/gleece/test/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func findModel(models []definitions.ModelMetadata, name string) *definitions.ModelMetadata {
+	for _, model := range models {
+		if model.Name == name {
+			return &model
+		}
+	}
+	return nil
+}
+
+func getFieldByName(model *definitions.ModelMetadata, name string) *definitions.FieldMetadata {
+	for _, field := range model.Fields {
+		if field.Name == name {
+			return &field
+		}
+	}
+	return nil
+}
+
+func getSchemas(spec map[string]any) map[string]any {
+	return spec["components"].(map[string]any)["schemas"].(map[string]any)
+}
+
+func getResponseRef(spec map[string]any, path string, method string) any {
+	operation := spec["paths"].(map[string]any)[path].(map[string]any)[method].(map[string]any)
+	response := operation["responses"].(map[string]any)["200"].(map[string]any)
+	return response["content"].(map[string]any)["application/json"].(map[string]any)["schema"].(map[string]any)["$ref"]
+}
+
+var _ = Describe("Schema Naming", func() {
+	Context("Short schema names", func() {
+		It("Fails when distinct types produce the same schema name", func() {
+			_, _, _, _, err := utils.GetConfigAndMetadata("gleece.test.config.json")
+			Expect(err).To(MatchError(ContainSubstring("schema name 'Invoice' is shared by multiple types")))
+			Expect(err).To(MatchError(ContainSubstring("github.com/gopher-fleece/gleece/test/naming/billing.Invoice")))
+			Expect(err).To(MatchError(ContainSubstring("github.com/gopher-fleece/gleece/test/naming/legacy.Invoice")))
+		})
+	})
+
+	Context("Package-qualified schema names", func() {
+		var config *definitions.GleeceConfig
+		var metadata []definitions.ControllerMetadata
+		var models []definitions.ModelMetadata
+		var hasStdError bool
+
+		BeforeEach(func() {
+			var err error
+			config, metadata, models, hasStdError, err = utils.GetConfigAndMetadata("gleece.qualified.config.json")
+			Expect(err).To(BeNil())
+		})
+
+		It("Names models after their package and type", func() {
+			Expect(findModel(models, "billing.Invoice")).ToNot(BeNil())
+			Expect(findModel(models, "legacy.Invoice")).ToNot(BeNil())
+			Expect(findModel(models, "Invoice")).To(BeNil())
+
+			statement := findModel(models, "billing.Statement")
+			Expect(statement).ToNot(BeNil())
+			Expect(getFieldByName(statement, "Current").Type).To(Equal("billing.Invoice"))
+			Expect(getFieldByName(statement, "Legacy").Type).To(Equal("legacy.Invoice"))
+		})
+
+		It("Refers to models by their schema names from routes", func() {
+			route := metadata[0].Routes[0]
+			Expect(route.FuncParams[0].TypeMeta.Name).To(Equal("Invoice"))
+			Expect(route.FuncParams[0].TypeMeta.SchemaTypeName()).To(Equal("legacy.Invoice"))
+			Expect(route.Responses[0].SchemaTypeName()).To(Equal("billing.Invoice"))
+		})
+
+		DescribeTable("Emits a distinct component per type",
+			func(version string) {
+				spec := utils.GetSpec(config, metadata, models, hasStdError, version)
+				schemas := getSchemas(spec)
+
+				Expect(schemas["billing.Invoice"]).To(HaveKeyWithValue("required", ConsistOf("number")))
+				Expect(schemas["legacy.Invoice"]).To(HaveKeyWithValue("required", ConsistOf("id")))
+				Expect(schemas["billing.Statement"]).To(HaveKeyWithValue("properties", HaveKeyWithValue(
+					"legacy", HaveKeyWithValue("$ref", "#/components/schemas/legacy.Invoice"),
+				)))
+				Expect(getResponseRef(spec, "/test/naming/legacy-invoice", "post")).To(Equal("#/components/schemas/billing.Invoice"))
+			},
+			Entry("OpenAPI 3.0", "3.0.0"),
+			Entry("OpenAPI 3.1", "3.1.0"),
+		)
+	})
+
+	Context("Explicit schema names", func() {
+		var config *definitions.GleeceConfig
+		var metadata []definitions.ControllerMetadata
+		var models []definitions.ModelMetadata
+		var hasStdError bool
+
+		BeforeEach(func() {
+			var err error
+			config, metadata, models, hasStdError, err = utils.GetConfigAndMetadata("gleece.explicit.config.json")
+			Expect(err).To(BeNil())
+		})
+
+		It("Prefers the @Name annotation over the naming strategy", func() {
+			Expect(findModel(models, "Invoice")).ToNot(BeNil())
+
+			archived := findModel(models, "ArchivedInvoice")
+			Expect(archived).ToNot(BeNil())
+			Expect(archived.Description).To(Equal("An invoice kept for auditing purposes"))
+			Expect(archived.FullyQualifiedPackage).To(Equal("github.com/gopher-fleece/gleece/test/naming/archive"))
+		})
+
+		DescribeTable("Refers to explicitly named components",
+			func(version string) {
+				spec := utils.GetSpec(config, metadata, models, hasStdError, version)
+				Expect(getSchemas(spec)).To(HaveKey("ArchivedInvoice"))
+				Expect(getResponseRef(spec, "/test/explicit-naming/archived-invoice", "post")).To(
+					Equal("#/components/schemas/ArchivedInvoice"),
+				)
+			},
+			Entry("OpenAPI 3.0", "3.0.0"),
+			Entry("OpenAPI 3.1", "3.1.0"),
+		)
+	})
+})
+
+func TestNaming(t *testing.T) {
+	logger.SetLogLevel(logger.LogLevelNone)
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Naming")
+}
@@ -0,0 +1,40 @@
+package nestedmodels_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+type Item struct {
+	Name string `json:"name"`
+}
+
+type Order struct {
+	Items       []Item          `json:"items"`
+	ItemsByName map[string]Item `json:"itemsByName"`
+	Grid        [][]Item        `json:"grid"`
+	Pair        [2]*Item        `json:"pair"`
+	// The address to ship the order to
+	Shipping struct {
+		// The street of the address
+		Street string `json:"street" validate:"required"`
+		Geo    *struct {
+			Lat float64 `json:"lat"`
+			Lng float64 `json:"lng"`
+		} `json:"geo"`
+	} `json:"shipping"`
+	Lines []struct {
+		Sku string `json:"sku"`
+	} `json:"lines"`
+}
+
+// @Route(/test/nested-models)
+type NestedModelsController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(GET)
+// @Route(/orders/{id})
+// @Path(id)
+func (ec *NestedModelsController) GetOrder(id string) (Order, error) {
+	return Order{}, nil
+}
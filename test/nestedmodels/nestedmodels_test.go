@@ -0,0 +1,98 @@
+package nestedmodels_test
+
+import (
+	"testing"
+
+	"github.com/gopher-fleece/gleece/definitions"
+	"github.com/gopher-fleece/gleece/infrastructure/logger"
+	"github.com/gopher-fleece/gleece/test/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func getModel(models []definitions.ModelMetadata, name string) *definitions.ModelMetadata {
+	for index := range models {
+		if models[index].Name == name {
+			return &models[index]
+		}
+	}
+	return nil
+}
+
+func getFieldTypes(model *definitions.ModelMetadata) map[string]string {
+	fieldTypes := map[string]string{}
+	for _, field := range model.Fields {
+		fieldTypes[field.Name] = field.Type
+	}
+	return fieldTypes
+}
+
+var _ = Describe("Nested Models", func() {
+	var config *definitions.GleeceConfig
+	var metadata []definitions.ControllerMetadata
+	var models []definitions.ModelMetadata
+	var hasStdError bool
+
+	BeforeEach(func() {
+		var err error
+		config, metadata, models, hasStdError, err = utils.GetConfigAndMetadata("gleece.test.config.json")
+		Expect(err).To(BeNil())
+	})
+
+	It("Refers to the elements of slices, arrays and maps by their schema names", func() {
+		order := getModel(models, "Order")
+		Expect(order).ToNot(BeNil())
+
+		fieldTypes := getFieldTypes(order)
+		Expect(fieldTypes).To(HaveKeyWithValue("Items", "[]Item"))
+		Expect(fieldTypes).To(HaveKeyWithValue("ItemsByName", "map[string]Item"))
+		Expect(fieldTypes).To(HaveKeyWithValue("Grid", "[][]Item"))
+		Expect(fieldTypes).To(HaveKeyWithValue("Pair", "[]Item"))
+		Expect(getModel(models, "Item")).ToNot(BeNil())
+	})
+
+	It("Records anonymous structs as models named after their owners", func() {
+		Expect(models).To(HaveLen(5))
+
+		fieldTypes := getFieldTypes(getModel(models, "Order"))
+		Expect(fieldTypes).To(HaveKeyWithValue("Shipping", "OrderShipping"))
+		Expect(fieldTypes).To(HaveKeyWithValue("Lines", "[]OrderLines"))
+
+		shipping := getModel(models, "OrderShipping")
+		Expect(shipping).ToNot(BeNil())
+		Expect(shipping.Fields[0].Description).To(Equal("The street of the address"))
+		Expect(getFieldTypes(shipping)).To(HaveKeyWithValue("Geo", "OrderShippingGeo"))
+		Expect(shipping.Fields[1].IsByAddress).To(BeTrue())
+
+		Expect(getFieldTypes(getModel(models, "OrderShippingGeo"))).To(Equal(map[string]string{"Lat": "float64", "Lng": "float64"}))
+		Expect(getFieldTypes(getModel(models, "OrderLines"))).To(Equal(map[string]string{"Sku": "string"}))
+	})
+
+	DescribeTable("Describes nested models as components",
+		func(version string) {
+			spec := utils.GetSpec(config, metadata, models, hasStdError, version)
+			schemas := spec["components"].(map[string]any)["schemas"].(map[string]any)
+			Expect(schemas).To(HaveKey("Item"))
+			Expect(schemas).To(HaveKey("OrderShipping"))
+			Expect(schemas).To(HaveKey("OrderShippingGeo"))
+			Expect(schemas).To(HaveKey("OrderLines"))
+
+			properties := schemas["Order"].(map[string]any)["properties"].(map[string]any)
+			Expect(properties["items"]).To(HaveKeyWithValue("items", HaveKeyWithValue("$ref", "#/components/schemas/Item")))
+			Expect(properties["grid"]).To(HaveKeyWithValue("items", HaveKeyWithValue(
+				"items", HaveKeyWithValue("$ref", "#/components/schemas/Item"),
+			)))
+			Expect(properties["lines"]).To(HaveKeyWithValue("items", HaveKeyWithValue("$ref", "#/components/schemas/OrderLines")))
+
+			Expect(schemas["OrderShipping"]).To(HaveKeyWithValue("required", ConsistOf("street")))
+		},
+		Entry("OpenAPI 3.0", "3.0.0"),
+		Entry("OpenAPI 3.1", "3.1.0"),
+	)
+})
+
+func TestNestedModels(t *testing.T) {
+	logger.SetLogLevel(logger.LogLevelNone)
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Nested Models")
+}
@@ -0,0 +1,17 @@
+package paramdefaults_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+// @Route(/test/param-defaults-invalid)
+type InvalidParamDefaultsController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(GET)
+// @Route(/items)
+// @Query(limit, { default: "ten" })
+func (ec *InvalidParamDefaultsController) ListItems(limit int) error {
+	return nil
+}
@@ -0,0 +1,41 @@
+package paramdefaults_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+type SortOrder string
+
+const (
+	SortOrderAsc  SortOrder = "asc"
+	SortOrderDesc SortOrder = "desc"
+)
+
+type Item struct {
+	Name string `json:"name"`
+}
+
+// @Route(/test/param-defaults)
+type ParamDefaultsController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(GET)
+// @Route(/items)
+// @Query(limit, { default: 20, validate: "required" }) The maximum number of items
+// @Query(ratio, { default: 0.5 })
+// @Query(active, { default: true })
+// @Query(sort, { default: "desc" })
+// @Query(search)
+// @Header(region, { name: "x-region", default: "eu" })
+func (ec *ParamDefaultsController) ListItems(limit int, ratio float64, active *bool, sort SortOrder, search *string, region string) (Item, error) {
+	return Item{}, nil
+}
+
+// @Method(POST)
+// @Route(/items)
+// @FormField(name, { validate: "required" })
+// @FormField(note, { default: "none", validate: "required" })
+func (ec *ParamDefaultsController) CreateItem(name string, note string) (Item, error) {
+	return Item{Name: name}, nil
+}
@@ -0,0 +1,85 @@
+package paramdefaults_test
+
+import (
+	"testing"
+
+	"github.com/gopher-fleece/gleece/definitions"
+	"github.com/gopher-fleece/gleece/infrastructure/logger"
+	"github.com/gopher-fleece/gleece/test/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// getParameter returns the spec of the given parameter of the given operation
+func getParameter(operation map[string]any, name string) map[string]any {
+	for _, param := range operation["parameters"].([]any) {
+		if param.(map[string]any)["name"] == name {
+			return param.(map[string]any)
+		}
+	}
+
+	Fail("could not find parameter " + name)
+	return nil
+}
+
+var _ = Describe("Parameter Defaults", func() {
+	var config *definitions.GleeceConfig
+	var metadata []definitions.ControllerMetadata
+	var models []definitions.ModelMetadata
+	var hasStdError bool
+
+	BeforeEach(func() {
+		var err error
+		config, metadata, models, hasStdError, err = utils.GetConfigAndMetadata("gleece.test.config.json")
+		Expect(err).To(BeNil())
+	})
+
+	It("Extracts default values as they would be sent in the request", func() {
+		params := metadata[0].Routes[0].FuncParams
+		Expect(params[0].DefaultValue).To(Equal("20"))
+		Expect(params[1].DefaultValue).To(Equal("0.5"))
+		Expect(params[2].DefaultValue).To(Equal("true"))
+		Expect(params[3].DefaultValue).To(Equal("desc"))
+		Expect(params[4].DefaultValue).To(BeEmpty())
+		Expect(params[5].DefaultValue).To(Equal("eu"))
+		Expect(metadata[0].Routes[1].FuncParams[1].DefaultValue).To(Equal("none"))
+	})
+
+	DescribeTable("Describes default values in the spec",
+		func(version string) {
+			spec := utils.GetSpec(config, metadata, models, hasStdError, version)
+			path := spec["paths"].(map[string]any)["/test/param-defaults/items"].(map[string]any)
+			listItems := path["get"].(map[string]any)
+
+			limit := getParameter(listItems, "limit")
+			Expect(limit).ToNot(HaveKeyWithValue("required", true))
+			Expect(limit["schema"]).To(HaveKeyWithValue("default", BeNumerically("==", 20)))
+			Expect(getParameter(listItems, "ratio")["schema"]).To(HaveKeyWithValue("default", BeNumerically("==", 0.5)))
+			Expect(getParameter(listItems, "active")["schema"]).To(HaveKeyWithValue("default", true))
+			Expect(getParameter(listItems, "search")["schema"]).ToNot(HaveKey("default"))
+			Expect(getParameter(listItems, "x-region")["schema"]).To(HaveKeyWithValue("default", "eu"))
+
+			sort := getParameter(listItems, "sort")["schema"]
+			Expect(sort).To(HaveKeyWithValue("default", "desc"))
+			Expect(sort).To(HaveKeyWithValue("allOf", ConsistOf(HaveKeyWithValue("$ref", "#/components/schemas/SortOrder"))))
+
+			createItem := path["post"].(map[string]any)
+			formSchema := createItem["requestBody"].(map[string]any)["content"].(map[string]any)["application/x-www-form-urlencoded"].(map[string]any)["schema"].(map[string]any)
+			Expect(formSchema["required"]).To(ConsistOf("name"))
+			Expect(formSchema["properties"]).To(HaveKeyWithValue("note", HaveKeyWithValue("default", "none")))
+		},
+		Entry("OpenAPI 3.0", "3.0.0"),
+		Entry("OpenAPI 3.1", "3.1.0"),
+	)
+
+	It("Fails for default values that do not match the types of their parameters", func() {
+		_, _, _, _, err := utils.GetConfigAndMetadata("gleece.invalid.config.json")
+		Expect(err).To(MatchError(ContainSubstring("the default value 'ten' of parameter 'limit' is not a valid int")))
+	})
+})
+
+func TestParamDefaults(t *testing.T) {
+	logger.SetLogLevel(logger.LogLevelNone)
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Parameter Defaults")
+}
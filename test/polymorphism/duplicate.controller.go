@@ -0,0 +1,36 @@
+package polymorphism_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+// @Discriminator(kind)
+// @OneOf(Apple, { value: "fruit" })
+// @OneOf(Pear, { value: "fruit" })
+type Fruit interface {
+	Eat()
+}
+
+type Apple struct {
+	Kind string `json:"kind"`
+}
+
+func (a Apple) Eat() {}
+
+type Pear struct {
+	Kind string `json:"kind"`
+}
+
+func (p Pear) Eat() {}
+
+// @Route(/test/polymorphism/duplicate)
+type DuplicateController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(POST)
+// @Route(/fruits)
+// @Body(fruit)
+func (ec *DuplicateController) Eat(fruit Fruit) error {
+	return nil
+}
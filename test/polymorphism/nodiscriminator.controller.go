@@ -0,0 +1,28 @@
+package polymorphism_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+// @OneOf(Plane)
+type Vehicle interface {
+	Move()
+}
+
+type Plane struct {
+	Kind string `json:"kind"`
+}
+
+func (p Plane) Move() {}
+
+// @Route(/test/polymorphism/no-discriminator)
+type NoDiscriminatorController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(POST)
+// @Route(/vehicles)
+// @Body(vehicle)
+func (ec *NoDiscriminatorController) Move(vehicle Vehicle) error {
+	return nil
+}
@@ -0,0 +1,29 @@
+package polymorphism_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+// @Discriminator(type)
+// @OneOf(Email)
+type Message interface {
+	Send()
+}
+
+type Email struct {
+	Kind string `json:"kind"`
+}
+
+func (e Email) Send() {}
+
+// @Route(/test/polymorphism/no-property)
+type NoPropertyController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(POST)
+// @Route(/messages)
+// @Body(message)
+func (ec *NoPropertyController) Send(message Message) error {
+	return nil
+}
@@ -0,0 +1,27 @@
+package polymorphism_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+// @Discriminator(kind)
+// @OneOf(Rock)
+type Animal interface {
+	Breathe()
+}
+
+type Rock struct {
+	Kind string `json:"kind"`
+}
+
+// @Route(/test/polymorphism/not-implemented)
+type NotImplementedController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(POST)
+// @Route(/animals)
+// @Body(animal)
+func (ec *NotImplementedController) Add(animal Animal) error {
+	return nil
+}
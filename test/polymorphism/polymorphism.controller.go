@@ -0,0 +1,51 @@
+package polymorphism_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+// A shape to draw
+// @Discriminator(kind)
+// @OneOf(Circle, { value: "circle" })
+// @OneOf(Square)
+type Shape interface {
+	Area() float64
+}
+
+type Circle struct {
+	Kind   string  `json:"kind"`
+	Radius float64 `json:"radius" validate:"gt=0"`
+}
+
+func (c Circle) Area() float64 {
+	return 3.14 * c.Radius * c.Radius
+}
+
+type ShapeBase struct {
+	Kind string `json:"kind"`
+}
+
+type Square struct {
+	ShapeBase
+	Side float64 `json:"side"`
+}
+
+func (s *Square) Area() float64 {
+	return s.Side * s.Side
+}
+
+type Drawing struct {
+	Shape Shape `json:"shape"`
+}
+
+// @Route(/test/polymorphism)
+type PolymorphismController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(POST)
+// @Route(/shapes)
+// @Body(shape)
+func (ec *PolymorphismController) Draw(shape Shape) (Drawing, error) {
+	return Drawing{Shape: shape}, nil
+}
@@ -0,0 +1,136 @@
+package polymorphism_test
+
+import (
+	"testing"
+
+	"github.com/gopher-fleece/gleece/definitions"
+	"github.com/gopher-fleece/gleece/infrastructure/logger"
+	"github.com/gopher-fleece/gleece/test/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func getModel(models []definitions.ModelMetadata, name string) *definitions.ModelMetadata {
+	for index := range models {
+		if models[index].Name == name {
+			return &models[index]
+		}
+	}
+	return nil
+}
+
+var _ = Describe("Polymorphism", func() {
+	Context("Valid polymorphic interfaces", func() {
+		var config *definitions.GleeceConfig
+		var metadata []definitions.ControllerMetadata
+		var models []definitions.ModelMetadata
+		var hasStdError bool
+
+		BeforeEach(func() {
+			var err error
+			config, metadata, models, hasStdError, err = utils.GetConfigAndMetadata("gleece.test.config.json")
+			Expect(err).To(BeNil())
+		})
+
+		It("Extracts the members of polymorphic body parameters", func() {
+			typeMeta := metadata[0].Routes[0].FuncParams[0].TypeMeta
+			Expect(typeMeta.EntityKind).To(Equal(definitions.AstNodeKindInterface))
+			Expect(typeMeta.Discriminator).To(Equal("kind"))
+			Expect(typeMeta.OneOf).To(HaveLen(2))
+
+			Expect(typeMeta.OneOf[0].Name).To(Equal("Circle"))
+			Expect(typeMeta.OneOf[0].DiscriminatorValue).To(Equal("circle"))
+			Expect(typeMeta.OneOf[0].IsByAddress).To(BeFalse())
+
+			Expect(typeMeta.OneOf[1].Name).To(Equal("Square"))
+			Expect(typeMeta.OneOf[1].DiscriminatorValue).To(Equal("Square"))
+			Expect(typeMeta.OneOf[1].IsByAddress).To(BeTrue())
+		})
+
+		It("Produces models for polymorphic interfaces and their members", func() {
+			Expect(models).To(HaveLen(4))
+
+			shape := getModel(models, "Shape")
+			Expect(shape).ToNot(BeNil())
+			Expect(shape.IsPolymorphic()).To(BeTrue())
+			Expect(shape.Description).To(Equal("A shape to draw"))
+			Expect(shape.Discriminator).To(Equal("kind"))
+			Expect(shape.OneOf[0].SchemaName).To(Equal("Circle"))
+			Expect(shape.OneOf[1].SchemaName).To(Equal("Square"))
+
+			Expect(getModel(models, "Circle")).ToNot(BeNil())
+			Expect(getModel(models, "Square")).ToNot(BeNil())
+			Expect(getModel(models, "Drawing").Fields[0].Type).To(Equal("Shape"))
+		})
+
+		DescribeTable("Describes polymorphic interfaces via oneOf and a discriminator",
+			func(version string) {
+				spec := utils.GetSpec(config, metadata, models, hasStdError, version)
+				schemas := spec["components"].(map[string]any)["schemas"].(map[string]any)
+
+				shape := schemas["Shape"].(map[string]any)
+				Expect(shape).ToNot(HaveKey("type"))
+				Expect(shape["oneOf"]).To(ConsistOf(
+					HaveKeyWithValue("$ref", "#/components/schemas/Circle"),
+					HaveKeyWithValue("$ref", "#/components/schemas/Square"),
+				))
+				Expect(shape["discriminator"]).To(Equal(map[string]any{
+					"propertyName": "kind",
+					"mapping": map[string]any{
+						"circle": "#/components/schemas/Circle",
+						"Square": "#/components/schemas/Square",
+					},
+				}))
+
+				Expect(schemas["Drawing"]).To(HaveKeyWithValue("properties", HaveKeyWithValue(
+					"shape", HaveKeyWithValue("$ref", "#/components/schemas/Shape"),
+				)))
+
+				operation := spec["paths"].(map[string]any)["/test/polymorphism/shapes"].(map[string]any)["post"].(map[string]any)
+				bodySchema := operation["requestBody"].(map[string]any)["content"].(map[string]any)["application/json"].(map[string]any)["schema"]
+				Expect(bodySchema).To(HaveKeyWithValue("$ref", "#/components/schemas/Shape"))
+			},
+			Entry("OpenAPI 3.0", "3.0.0"),
+			Entry("OpenAPI 3.1", "3.1.0"),
+		)
+	})
+
+	Context("Invalid polymorphic interfaces", func() {
+		It("Fails for interfaces without a discriminator", func() {
+			_, _, _, _, err := utils.GetConfigAndMetadata("gleece.nodiscriminator.config.json")
+			Expect(err).To(MatchError(ContainSubstring("interface 'Vehicle' has @OneOf annotations but no @Discriminator")))
+		})
+
+		It("Fails for members which do not implement the interface", func() {
+			_, _, _, _, err := utils.GetConfigAndMetadata("gleece.notimplemented.config.json")
+			Expect(err).To(MatchError(ContainSubstring("@OneOf member 'Rock' does not implement interface 'Animal'")))
+		})
+
+		It("Fails for members without the discriminator property", func() {
+			_, _, _, _, err := utils.GetConfigAndMetadata("gleece.noproperty.config.json")
+			Expect(err).To(MatchError(ContainSubstring(
+				"@OneOf member 'Email' of interface 'Message' has no 'type' property to be discriminated by",
+			)))
+		})
+
+		It("Fails for members sharing a discriminator value", func() {
+			_, _, _, _, err := utils.GetConfigAndMetadata("gleece.duplicate.config.json")
+			Expect(err).To(MatchError(ContainSubstring(
+				"discriminator value 'fruit' is used by more than one @OneOf member of interface 'Fruit'",
+			)))
+		})
+
+		It("Fails for body parameters typed as interfaces without @OneOf", func() {
+			_, _, _, _, err := utils.GetConfigAndMetadata("gleece.unannotated.config.json")
+			Expect(err).To(MatchError(ContainSubstring(
+				"body parameters must be structs or interfaces annotated with @OneOf but 'plugin'",
+			)))
+		})
+	})
+})
+
+func TestPolymorphism(t *testing.T) {
+	logger.SetLogLevel(logger.LogLevelNone)
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Polymorphism")
+}
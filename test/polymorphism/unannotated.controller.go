@@ -0,0 +1,21 @@
+package polymorphism_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+type Plugin interface {
+	Run()
+}
+
+// @Route(/test/polymorphism/unannotated)
+type UnannotatedController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(POST)
+// @Route(/plugins)
+// @Body(plugin)
+func (ec *UnannotatedController) Install(plugin Plugin) error {
+	return nil
+}
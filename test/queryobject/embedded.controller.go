@@ -0,0 +1,26 @@
+package queryobject_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+type Paging struct {
+	Page int `query:"page"`
+}
+
+type EmbeddingFilter struct {
+	Paging
+	Search string `query:"search"`
+}
+
+// @Route(/test/query-object/embedded)
+type EmbeddedQueryObjectController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(GET)
+// @Route(/items)
+// @Query(filter)
+func (ec *EmbeddedQueryObjectController) GetItems(filter EmbeddingFilter) error {
+	return nil
+}
@@ -0,0 +1,26 @@
+package queryobject_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+type Range struct {
+	From int
+	To   int
+}
+
+type NestedFilter struct {
+	Range Range `query:"range"`
+}
+
+// @Route(/test/query-object/nested)
+type NestedQueryObjectController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(GET)
+// @Route(/items)
+// @Query(filter)
+func (ec *NestedQueryObjectController) GetItems(filter NestedFilter) error {
+	return nil
+}
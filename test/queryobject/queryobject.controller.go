@@ -0,0 +1,37 @@
+package queryobject_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+type Color string
+
+const (
+	ColorRed   Color = "red"
+	ColorGreen Color = "green"
+)
+
+type ItemsFilter struct {
+	// The page to fetch
+	Page     int     `query:"page" validate:"min=1"`
+	PageSize *int    `query:"page_size,omitempty" validate:"omitempty,max=100"`
+	Colors   []Color `query:"color"`
+	Search   string
+	Internal string `query:"-"`
+	hidden   string
+}
+
+// @Tag(Query Object Controller Tag)
+// @Route(/test/query-object)
+// @Description Query Object Controller
+type QueryObjectController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(GET)
+// @Route(/items)
+// @Query(filter)
+// @Query(sort)
+func (ec *QueryObjectController) GetItems(filter ItemsFilter, sort string) error {
+	return nil
+}
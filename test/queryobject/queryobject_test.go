@@ -0,0 +1,121 @@
+package queryobject_test
+
+import (
+	"testing"
+
+	"github.com/gopher-fleece/gleece/definitions"
+	"github.com/gopher-fleece/gleece/infrastructure/logger"
+	"github.com/gopher-fleece/gleece/test/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// getSpecParams generates an OpenAPI spec of the given version and returns the parameters of the given operation by name
+func getSpecParams(
+	config *definitions.GleeceConfig,
+	metadata []definitions.ControllerMetadata,
+	models []definitions.ModelMetadata,
+	hasStdError bool,
+	version string,
+	path string,
+) map[string]map[string]any {
+	spec := utils.GetSpec(config, metadata, models, hasStdError, version)
+	operation := spec["paths"].(map[string]any)[path].(map[string]any)["get"].(map[string]any)
+	params := map[string]map[string]any{}
+	for _, param := range operation["parameters"].([]any) {
+		paramMap := param.(map[string]any)
+		params[paramMap["name"].(string)] = paramMap
+	}
+	return params
+}
+
+var _ = Describe("Query Objects", func() {
+	Context("Valid query objects", func() {
+		var config *definitions.GleeceConfig
+		var metadata []definitions.ControllerMetadata
+		var models []definitions.ModelMetadata
+		var hasStdError bool
+
+		BeforeEach(func() {
+			var err error
+			config, metadata, models, hasStdError, err = utils.GetConfigAndMetadata("gleece.test.config.json")
+			Expect(err).To(BeNil())
+		})
+
+		It("Expands the exported fields of query objects", func() {
+			params := metadata[0].Routes[0].FuncParams
+			Expect(params).To(HaveLen(2))
+			Expect(params[1].QueryFields).To(BeEmpty())
+
+			fields := params[0].QueryFields
+			Expect(fields).To(HaveLen(4))
+
+			Expect(fields[0].FieldName).To(Equal("Page"))
+			Expect(fields[0].Name).To(Equal("filterPage"))
+			Expect(fields[0].NameInSchema).To(Equal("page"))
+			Expect(fields[0].PassedIn).To(Equal(definitions.PassedInQuery))
+			Expect(fields[0].Validator).To(Equal("min=1"))
+			Expect(fields[0].Description).To(Equal("The page to fetch"))
+
+			Expect(fields[1].NameInSchema).To(Equal("page_size"))
+			Expect(fields[1].TypeMeta.IsByAddress).To(BeTrue())
+
+			Expect(fields[2].NameInSchema).To(Equal("color"))
+			Expect(fields[2].TypeMeta.ElementType.Name).To(Equal("Color"))
+			Expect(fields[2].Style).To(Equal(definitions.ParamStyleForm))
+			Expect(fields[2].Explode).To(BeTrue())
+
+			Expect(fields[3].NameInSchema).To(Equal("Search"))
+		})
+
+		It("Produces models for the fields of query objects but not for the objects themselves", func() {
+			Expect(models).To(HaveLen(1))
+			Expect(models[0].Name).To(Equal("Color"))
+		})
+
+		DescribeTable("Emits a parameter per query object field",
+			func(version string) {
+				params := getSpecParams(config, metadata, models, hasStdError, version, "/test/query-object/items")
+				Expect(params).To(HaveLen(5))
+				Expect(params).ToNot(HaveKey("filter"))
+
+				Expect(params["page"]).To(HaveKeyWithValue("in", "query"))
+				Expect(params["page"]).To(HaveKeyWithValue("description", "The page to fetch"))
+				Expect(params["page"]).To(HaveKeyWithValue("schema", HaveKeyWithValue("type", "integer")))
+
+				Expect(params["color"]).To(HaveKeyWithValue("style", "form"))
+				Expect(params["color"]).To(HaveKeyWithValue("schema", HaveKeyWithValue(
+					"items", HaveKeyWithValue("$ref", "#/components/schemas/Color"),
+				)))
+
+				Expect(params).To(HaveKey("page_size"))
+				Expect(params).To(HaveKey("Search"))
+				Expect(params).To(HaveKey("sort"))
+			},
+			Entry("OpenAPI 3.0", "3.0.0"),
+			Entry("OpenAPI 3.1", "3.1.0"),
+		)
+	})
+
+	Context("Invalid query objects", func() {
+		It("Fails for query objects with struct fields", func() {
+			_, _, _, _, err := utils.GetConfigAndMetadata("gleece.nested.config.json")
+			Expect(err).To(MatchError(ContainSubstring(
+				"field 'Range' of query object 'NestedFilter' cannot be bound to a query parameter",
+			)))
+		})
+
+		It("Fails for query objects with embedded fields", func() {
+			_, _, _, _, err := utils.GetConfigAndMetadata("gleece.embedded.config.json")
+			Expect(err).To(MatchError(ContainSubstring(
+				"query object 'EmbeddingFilter' embeds 'Paging' but embedded fields are not currently supported",
+			)))
+		})
+	})
+})
+
+func TestQueryObject(t *testing.T) {
+	logger.SetLogLevel(logger.LogLevelNone)
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Query Object")
+}
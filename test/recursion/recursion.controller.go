@@ -0,0 +1,72 @@
+package recursion_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+type TreeNode struct {
+	Value    string     `json:"value"`
+	Parent   *TreeNode  `json:"parent"`
+	Children []TreeNode `json:"children"`
+}
+
+type Department struct {
+	Name      string     `json:"name"`
+	Manager   *Employee  `json:"manager"`
+	Employees []Employee `json:"employees"`
+}
+
+type Employee struct {
+	Name       string      `json:"name"`
+	Department *Department `json:"department"`
+}
+
+type Category struct {
+	Name          string              `json:"name"`
+	Subcategories map[string]Category `json:"subcategories"`
+	Related       [][]*Category       `json:"related"`
+}
+
+type Audited struct {
+	PreviousRevision *Revision `json:"previousRevision"`
+}
+
+type Revision struct {
+	Audited
+	Note string `json:"note"`
+}
+
+// @Route(/test/recursion)
+type RecursionController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(GET)
+// @Route(/tree)
+func (ec *RecursionController) GetTree() (TreeNode, error) {
+	return TreeNode{}, nil
+}
+
+// @Method(GET)
+// @Route(/departments)
+func (ec *RecursionController) GetDepartment() (Department, error) {
+	return Department{}, nil
+}
+
+// @Method(GET)
+// @Route(/employees)
+func (ec *RecursionController) GetEmployee() (Employee, error) {
+	return Employee{}, nil
+}
+
+// @Method(GET)
+// @Route(/categories)
+func (ec *RecursionController) GetCategories() (map[string]Category, error) {
+	return map[string]Category{}, nil
+}
+
+// @Method(GET)
+// @Route(/revisions)
+func (ec *RecursionController) GetRevision() (Revision, error) {
+	return Revision{}, nil
+}
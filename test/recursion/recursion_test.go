@@ -0,0 +1,133 @@
+package recursion_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/gopher-fleece/gleece/definitions"
+	"github.com/gopher-fleece/gleece/infrastructure/logger"
+	"github.com/gopher-fleece/gleece/test/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func getModel(models []definitions.ModelMetadata, name string) *definitions.ModelMetadata {
+	for index := range models {
+		if models[index].Name == name {
+			return &models[index]
+		}
+	}
+	return nil
+}
+
+func getFieldTypes(model *definitions.ModelMetadata) map[string]string {
+	fieldTypes := map[string]string{}
+	for _, field := range model.Fields {
+		fieldTypes[field.Name] = field.Type
+	}
+	return fieldTypes
+}
+
+// getSchemas generates an OpenAPI spec of the given version and returns its component schemas as generic maps
+func getSchemas(configName string, version string) map[string]any {
+	config, metadata, models, hasStdError, err := utils.GetConfigAndMetadata(configName)
+	Expect(err).To(BeNil())
+
+	spec := utils.GetSpec(config, metadata, models, hasStdError, version)
+	return spec["components"].(map[string]any)["schemas"].(map[string]any)
+}
+
+func getProperties(schemas map[string]any, schemaName string) map[string]any {
+	return schemas[schemaName].(map[string]any)["properties"].(map[string]any)
+}
+
+var _ = Describe("Recursion", func() {
+	Context("Flattened embedded structs", func() {
+		var models []definitions.ModelMetadata
+
+		BeforeEach(func() {
+			var err error
+			_, _, models, _, err = utils.GetConfigAndMetadata("gleece.test.config.json")
+			Expect(err).To(BeNil())
+		})
+
+		It("Records each recursive model exactly once", func() {
+			Expect(models).To(HaveLen(5))
+			for _, name := range []string{"TreeNode", "Department", "Employee", "Category", "Revision"} {
+				Expect(getModel(models, name)).ToNot(BeNil(), "model '%s' is missing", name)
+			}
+		})
+
+		It("Refers to directly recursive models by name", func() {
+			fieldTypes := getFieldTypes(getModel(models, "TreeNode"))
+			Expect(fieldTypes).To(HaveKeyWithValue("Parent", "TreeNode"))
+			Expect(fieldTypes).To(HaveKeyWithValue("Children", "[]TreeNode"))
+		})
+
+		It("Refers to mutually recursive models by name", func() {
+			Expect(getFieldTypes(getModel(models, "Department"))).To(HaveKeyWithValue("Employees", "[]Employee"))
+			Expect(getFieldTypes(getModel(models, "Employee"))).To(HaveKeyWithValue("Department", "Department"))
+		})
+
+		It("Refers to models recursing via maps and nested slices by name", func() {
+			fieldTypes := getFieldTypes(getModel(models, "Category"))
+			Expect(fieldTypes).To(HaveKeyWithValue("Subcategories", "map[string]Category"))
+			Expect(fieldTypes).To(HaveKeyWithValue("Related", "[][]Category"))
+		})
+
+		It("Promotes fields referring back to the embedding struct", func() {
+			Expect(getFieldTypes(getModel(models, "Revision"))).To(Equal(map[string]string{
+				"PreviousRevision": "Revision",
+				"Note":             "string",
+			}))
+		})
+
+		DescribeTable("Describes back-references via $ref",
+			func(version string) {
+				schemas := getSchemas("gleece.test.config.json", version)
+
+				tree := getProperties(schemas, "TreeNode")
+				Expect(tree["children"]).To(HaveKeyWithValue("items", HaveKeyWithValue("$ref", "#/components/schemas/TreeNode")))
+				Expect(json.Marshal(tree["parent"])).To(ContainSubstring(`"$ref":"#/components/schemas/TreeNode"`))
+
+				Expect(getProperties(schemas, "Department")["employees"]).To(HaveKeyWithValue(
+					"items", HaveKeyWithValue("$ref", "#/components/schemas/Employee"),
+				))
+				Expect(json.Marshal(getProperties(schemas, "Employee")["department"])).To(
+					ContainSubstring(`"$ref":"#/components/schemas/Department"`),
+				)
+
+				category := getProperties(schemas, "Category")
+				Expect(category["subcategories"]).To(HaveKeyWithValue(
+					"additionalProperties", HaveKeyWithValue("$ref", "#/components/schemas/Category"),
+				))
+				Expect(category["related"]).To(HaveKeyWithValue("items", HaveKeyWithValue(
+					"items", HaveKeyWithValue("$ref", "#/components/schemas/Category"),
+				)))
+			},
+			Entry("OpenAPI 3.0", "3.0.0"),
+			Entry("OpenAPI 3.1", "3.1.0"),
+		)
+	})
+
+	Context("Embedded structs composed via allOf", func() {
+		DescribeTable("Describes embedded structs referring back to the embedding struct",
+			func(version string) {
+				schemas := getSchemas("gleece.allof.config.json", version)
+
+				Expect(json.Marshal(schemas["Revision"])).To(ContainSubstring(`"$ref":"#/components/schemas/Audited"`))
+				Expect(json.Marshal(getProperties(schemas, "Audited")["previousRevision"])).To(
+					ContainSubstring(`"$ref":"#/components/schemas/Revision"`),
+				)
+			},
+			Entry("OpenAPI 3.0", "3.0.0"),
+			Entry("OpenAPI 3.1", "3.1.0"),
+		)
+	})
+})
+
+func TestRecursion(t *testing.T) {
+	logger.SetLogLevel(logger.LogLevelNone)
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Recursion")
+}
@@ -0,0 +1,17 @@
+package slices_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+// @Route(/test/slices/path)
+type PathSliceController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(GET)
+// @Route(/items/{ids})
+// @Path(ids)
+func (ec *PathSliceController) GetItems(ids []int) error {
+	return nil
+}
@@ -0,0 +1,17 @@
+package slices_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+// @Route(/test/slices/pipe)
+type ExplodedPipeController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(GET)
+// @Route(/items)
+// @Query(ids, { style: "pipeDelimited", explode: true })
+func (ec *ExplodedPipeController) GetItems(ids []int) error {
+	return nil
+}
@@ -0,0 +1,30 @@
+package slices_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+type Color string
+
+const (
+	ColorRed   Color = "red"
+	ColorGreen Color = "green"
+)
+
+// @Tag(Slices Controller Tag)
+// @Route(/test/slices)
+// @Description Slices Controller
+type SlicesController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(GET)
+// @Route(/items)
+// @Query(ids)
+// @Query(tags, { style: "form", explode: false })
+// @Query(names, { style: "pipeDelimited" })
+// @Query(colors)
+// @Header(labels, { name: "x-labels" })
+func (ec *SlicesController) GetItems(ids []int, tags []string, names []string, colors []Color, labels []string) error {
+	return nil
+}
@@ -0,0 +1,139 @@
+package slices_test
+
+import (
+	"testing"
+
+	"github.com/gopher-fleece/gleece/definitions"
+	"github.com/gopher-fleece/gleece/infrastructure/logger"
+	"github.com/gopher-fleece/gleece/test/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// getSpecParams generates an OpenAPI spec of the given version and returns the parameters of the given operation by name
+func getSpecParams(
+	config *definitions.GleeceConfig,
+	metadata []definitions.ControllerMetadata,
+	models []definitions.ModelMetadata,
+	hasStdError bool,
+	version string,
+	path string,
+) map[string]map[string]any {
+	spec := utils.GetSpec(config, metadata, models, hasStdError, version)
+	operation := spec["paths"].(map[string]any)[path].(map[string]any)["get"].(map[string]any)
+	params := map[string]map[string]any{}
+	for _, param := range operation["parameters"].([]any) {
+		paramMap := param.(map[string]any)
+		params[paramMap["name"].(string)] = paramMap
+	}
+	return params
+}
+
+var _ = Describe("Slice Parameters", func() {
+	Context("Valid slice parameters", func() {
+		var config *definitions.GleeceConfig
+		var metadata []definitions.ControllerMetadata
+		var models []definitions.ModelMetadata
+		var hasStdError bool
+
+		BeforeEach(func() {
+			var err error
+			config, metadata, models, hasStdError, err = utils.GetConfigAndMetadata("gleece.test.config.json")
+			Expect(err).To(BeNil())
+		})
+
+		It("Extracts the element types of slice parameters", func() {
+			params := metadata[0].Routes[0].FuncParams
+			Expect(params).To(HaveLen(5))
+
+			Expect(params[0].TypeMeta.Name).To(Equal("[]int"))
+			Expect(params[0].TypeMeta.IsSlice()).To(BeTrue())
+			Expect(params[0].TypeMeta.ElementType.Name).To(Equal("int"))
+			Expect(params[0].TypeMeta.ElementType.IsUniverseType).To(BeTrue())
+
+			Expect(params[3].TypeMeta.ElementType.Name).To(Equal("Color"))
+			Expect(params[3].TypeMeta.ElementType.EnumValues).To(Equal([]string{"red", "green"}))
+			Expect(params[3].TypeMeta.SchemaTypeName()).To(Equal("[]Color"))
+		})
+
+		It("Extracts the serialization styles of slice parameters", func() {
+			params := metadata[0].Routes[0].FuncParams
+
+			Expect(params[0].Style).To(Equal(definitions.ParamStyleForm))
+			Expect(params[0].Explode).To(BeTrue())
+			Expect(params[0].ValuesSeparator()).To(BeEmpty())
+
+			Expect(params[1].Style).To(Equal(definitions.ParamStyleForm))
+			Expect(params[1].Explode).To(BeFalse())
+			Expect(params[1].ValuesSeparator()).To(Equal(","))
+
+			Expect(params[2].Style).To(Equal(definitions.ParamStylePipeDelimited))
+			Expect(params[2].Explode).To(BeFalse())
+			Expect(params[2].ValuesSeparator()).To(Equal("|"))
+
+			Expect(params[4].Style).To(Equal(definitions.ParamStyleSimple))
+			Expect(params[4].Explode).To(BeFalse())
+			Expect(params[4].ValuesSeparator()).To(Equal(","))
+		})
+
+		It("Produces models for the elements of slice parameters", func() {
+			Expect(models).To(HaveLen(1))
+			Expect(models[0].Name).To(Equal("Color"))
+			Expect(models[0].IsEnum()).To(BeTrue())
+		})
+
+		DescribeTable("Emits the style and explode of slice parameters",
+			func(version string) {
+				params := getSpecParams(config, metadata, models, hasStdError, version, "/test/slices/items")
+
+				Expect(params["ids"]).To(HaveKeyWithValue("style", "form"))
+				Expect(params["ids"]).To(HaveKeyWithValue("explode", true))
+				Expect(params["ids"]).To(HaveKeyWithValue("schema", HaveKeyWithValue("items", HaveKeyWithValue("type", "integer"))))
+
+				Expect(params["tags"]).To(HaveKeyWithValue("style", "form"))
+				Expect(params["tags"]).To(HaveKeyWithValue("explode", false))
+
+				Expect(params["names"]).To(HaveKeyWithValue("style", "pipeDelimited"))
+				Expect(params["names"]).To(HaveKeyWithValue("explode", false))
+
+				Expect(params["colors"]).To(HaveKeyWithValue("schema", HaveKeyWithValue(
+					"items", HaveKeyWithValue("$ref", "#/components/schemas/Color"),
+				)))
+
+				Expect(params["x-labels"]).To(HaveKeyWithValue("in", "header"))
+				Expect(params["x-labels"]).To(HaveKeyWithValue("style", "simple"))
+			},
+			Entry("OpenAPI 3.0", "3.0.0"),
+			Entry("OpenAPI 3.1", "3.1.0"),
+		)
+	})
+
+	Context("Invalid slice parameters", func() {
+		It("Fails for slice path parameters", func() {
+			_, _, _, _, err := utils.GetConfigAndMetadata("gleece.path.config.json")
+			Expect(err).To(MatchError(ContainSubstring(
+				"slices are only supported for header and query parameters but Path parameter 'ids'",
+			)))
+		})
+
+		It("Fails for slices of non-primitive types", func() {
+			_, _, _, _, err := utils.GetConfigAndMetadata("gleece.struct.config.json")
+			Expect(err).To(MatchError(ContainSubstring(
+				"slice parameters are currently limited to slices of primitives only but Query parameter 'filters'",
+			)))
+		})
+
+		It("Fails for exploded pipe-delimited parameters", func() {
+			_, _, _, _, err := utils.GetConfigAndMetadata("gleece.pipe.config.json")
+			Expect(err).To(MatchError(ContainSubstring(
+				"query parameter 'ids' uses the 'pipeDelimited' style which cannot be exploded",
+			)))
+		})
+	})
+})
+
+func TestSlices(t *testing.T) {
+	logger.SetLogLevel(logger.LogLevelNone)
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Slices")
+}
@@ -0,0 +1,21 @@
+package slices_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+type Filter struct {
+	Field string
+}
+
+// @Route(/test/slices/struct)
+type StructSliceController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(GET)
+// @Route(/items)
+// @Query(filters)
+func (ec *StructSliceController) GetItems(filters []Filter) error {
+	return nil
+}
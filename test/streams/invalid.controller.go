@@ -0,0 +1,19 @@
+package streams_test
+
+import (
+	"io"
+
+	"github.com/gopher-fleece/runtime"
+)
+
+// @Route(/test/streams-invalid)
+type InvalidStreamController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(POST)
+// @Route(/upload)
+// @Query(content)
+func (ec *InvalidStreamController) Upload(content io.Reader) error {
+	return nil
+}
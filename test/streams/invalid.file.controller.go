@@ -0,0 +1,17 @@
+package streams_test
+
+import (
+	"github.com/gopher-fleece/gleece/responses"
+	"github.com/gopher-fleece/runtime"
+)
+
+// @Route(/test/streams-invalid-file)
+type InvalidFileController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(GET)
+// @Route(/attachment)
+func (ec *InvalidFileController) GetAttachment() (*responses.FileResponse, error) {
+	return nil, nil
+}
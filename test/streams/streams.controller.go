@@ -0,0 +1,35 @@
+package streams_test
+
+import (
+	"io"
+	"strings"
+
+	"github.com/gopher-fleece/gleece/responses"
+	"github.com/gopher-fleece/runtime"
+)
+
+// @Route(/test/streams)
+// @Produces(application/json)
+type StreamController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(GET)
+// @Route(/report)
+func (ec *StreamController) GetReport() (io.Reader, error) {
+	return strings.NewReader("report"), nil
+}
+
+// @Method(GET)
+// @Route(/avatar)
+// @Produces(image/png)
+// @Produces(image/jpeg)
+func (ec *StreamController) GetAvatar() (io.ReadCloser, error) {
+	return io.NopCloser(strings.NewReader("avatar")), nil
+}
+
+// @Method(GET)
+// @Route(/attachment)
+func (ec *StreamController) GetAttachment() (responses.FileResponse, error) {
+	return responses.FileResponse{Content: strings.NewReader("attachment"), FileName: "notes.txt"}, nil
+}
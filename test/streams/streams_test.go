@@ -0,0 +1,95 @@
+package streams_test
+
+import (
+	"testing"
+
+	"github.com/gopher-fleece/gleece/definitions"
+	"github.com/gopher-fleece/gleece/infrastructure/logger"
+	"github.com/gopher-fleece/gleece/test/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Streams", func() {
+	var config *definitions.GleeceConfig
+	var metadata []definitions.ControllerMetadata
+	var models []definitions.ModelMetadata
+	var hasStdError bool
+
+	BeforeEach(func() {
+		var err error
+		config, metadata, models, hasStdError, err = utils.GetConfigAndMetadata("gleece.test.config.json")
+		Expect(err).To(BeNil())
+	})
+
+	It("Recognizes io.Reader and io.ReadCloser return values as streams", func() {
+		Expect(metadata[0].Routes[0].GetValueReturnType().IsStream()).To(BeTrue())
+		Expect(metadata[0].Routes[1].GetValueReturnType().IsStream()).To(BeTrue())
+		Expect(models).To(BeEmpty())
+	})
+
+	It("Recognizes responses.FileResponse return values as file responses", func() {
+		route := metadata[0].Routes[2]
+		Expect(route.GetValueReturnType().IsFileResponse).To(BeTrue())
+		Expect(route.GetValueReturnType().IsStream()).To(BeFalse())
+		Expect(route.Produces).To(Equal([]definitions.ContentType{definitions.ContentTypeOctetStream}))
+		Expect(models).To(BeEmpty())
+	})
+
+	It("Produces application/octet-stream streams without inheriting the controller's media types", func() {
+		route := metadata[0].Routes[0]
+		Expect(route.Produces).To(Equal([]definitions.ContentType{definitions.ContentTypeOctetStream}))
+		Expect(route.ResponseContentType).To(Equal(definitions.ContentTypeOctetStream))
+	})
+
+	It("Produces streams of any media type given by the route", func() {
+		route := metadata[0].Routes[1]
+		Expect(route.Produces).To(Equal([]definitions.ContentType{definitions.ContentTypePNG, definitions.ContentTypeJPEG}))
+		Expect(route.ResponseContentType).To(Equal(definitions.ContentTypePNG))
+	})
+
+	DescribeTable("Describes streams as binary strings of each produced media type in the spec",
+		func(version string) {
+			spec := utils.GetSpec(config, metadata, models, hasStdError, version)
+			paths := spec["paths"].(map[string]any)
+			binarySchema := map[string]any{"type": "string", "format": "binary"}
+
+			reportOperation := paths["/test/streams/report"].(map[string]any)["get"].(map[string]any)
+			reportContent := reportOperation["responses"].(map[string]any)["200"].(map[string]any)["content"].(map[string]any)
+			Expect(reportContent).To(HaveLen(1))
+			Expect(reportContent["application/octet-stream"].(map[string]any)["schema"]).To(Equal(binarySchema))
+
+			avatarOperation := paths["/test/streams/avatar"].(map[string]any)["get"].(map[string]any)
+			avatarContent := avatarOperation["responses"].(map[string]any)["200"].(map[string]any)["content"].(map[string]any)
+			Expect(avatarContent).To(HaveLen(2))
+			Expect(avatarContent["image/png"].(map[string]any)["schema"]).To(Equal(binarySchema))
+			Expect(avatarContent["image/jpeg"].(map[string]any)["schema"]).To(Equal(binarySchema))
+
+			attachmentOperation := paths["/test/streams/attachment"].(map[string]any)["get"].(map[string]any)
+			attachmentContent := attachmentOperation["responses"].(map[string]any)["200"].(map[string]any)["content"].(map[string]any)
+			Expect(attachmentContent).To(HaveLen(1))
+			Expect(attachmentContent["application/octet-stream"].(map[string]any)["schema"]).To(Equal(binarySchema))
+			Expect(spec["components"]).ToNot(HaveKeyWithValue("schemas", HaveKey("FileResponse")))
+		},
+		Entry("OpenAPI 3.0", "3.0.0"),
+		Entry("OpenAPI 3.1", "3.1.0"),
+	)
+
+	It("Fails for streams passed as parameters", func() {
+		_, _, _, _, err := utils.GetConfigAndMetadata("gleece.invalid.config.json")
+		Expect(err).To(MatchError(ContainSubstring("Query parameter 'content' (schema name 'content', type 'Reader') is of kind 'Interface'")))
+	})
+
+	It("Fails for file responses returned by address", func() {
+		_, _, _, _, err := utils.GetConfigAndMetadata("gleece.invalid.file.config.json")
+		Expect(err).To(MatchError(ContainSubstring(
+			"return type 'FileResponse' is not supported - file responses must be returned by value",
+		)))
+	})
+})
+
+func TestStreams(t *testing.T) {
+	logger.SetLogLevel(logger.LogLevelNone)
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Streams")
+}
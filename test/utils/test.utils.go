@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -8,7 +9,9 @@ import (
 	"github.com/gopher-fleece/gleece/cmd"
 	"github.com/gopher-fleece/gleece/cmd/arguments"
 	"github.com/gopher-fleece/gleece/definitions"
+	"github.com/gopher-fleece/gleece/generator/swagen"
 	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
 )
 
 func GetControllersAndModels() ([]definitions.ControllerMetadata, []definitions.ModelMetadata, bool) {
@@ -25,6 +28,37 @@ func GetControllersAndModels() ([]definitions.ControllerMetadata, []definitions.
 	return controllers, flatModels, hasStdError
 }
 
+// GetConfigAndMetadata loads the given configuration, relative to the process working directory,
+// and extracts the metadata of the controllers and models it refers to
+func GetConfigAndMetadata(configName string) (
+	*definitions.GleeceConfig,
+	[]definitions.ControllerMetadata,
+	[]definitions.ModelMetadata,
+	bool,
+	error,
+) {
+	return cmd.GetConfigAndMetadata(arguments.CliArguments{ConfigPath: GetAbsPathByRelative(configName)})
+}
+
+// GetSpec generates an OpenAPI spec of the given version and returns it as a generic map
+func GetSpec(
+	config *definitions.GleeceConfig,
+	metadata []definitions.ControllerMetadata,
+	models []definitions.ModelMetadata,
+	hasStdError bool,
+	version string,
+) map[string]any {
+	openApiConfig := config.OpenAPIGeneratorConfig
+	openApiConfig.OpenAPI = version
+
+	specBytes, err := swagen.GenerateSpec(&openApiConfig, metadata, append([]definitions.ModelMetadata{}, models...), hasStdError)
+	Expect(err).To(BeNil())
+
+	spec := map[string]any{}
+	Expect(json.Unmarshal(specBytes, &spec)).To(Succeed())
+	return spec
+}
+
 func GetAbsPathByRelative(relativePath string) string {
 	cwd, err := os.Getwd()
 	if err != nil {
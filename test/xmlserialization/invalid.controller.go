@@ -0,0 +1,17 @@
+package xmlserialization_test
+
+import (
+	"github.com/gopher-fleece/runtime"
+)
+
+// @Route(/test/xml-invalid)
+type InvalidXmlController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(GET)
+// @Route(/labels)
+// @Produces(application/xml)
+func (ec *InvalidXmlController) GetLabels() (map[string]string, error) {
+	return map[string]string{}, nil
+}
@@ -0,0 +1,37 @@
+package xmlserialization_test
+
+import (
+	"encoding/xml"
+
+	"github.com/gopher-fleece/runtime"
+)
+
+type Tag struct {
+	Label string `json:"label" xml:"label"`
+}
+
+type Book struct {
+	XMLName xml.Name `json:"-" xml:"book"`
+	Id      string   `json:"id" xml:"id,attr"`
+	Title   string   `json:"title" xml:"title"`
+	Author  string   `json:"author" xml:"http://example.com/authors writer"`
+	Tags    []Tag    `json:"tags" xml:"tag"`
+	Notes   string   `json:"notes" xml:"notes>note"`
+	Pages   int      `json:"pages"`
+}
+
+// @Route(/test/xml)
+// @Consumes(application/json)
+// @Consumes(application/xml)
+type XmlController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(POST)
+// @Route(/books)
+// @Produces(application/xml)
+// @Produces(application/json)
+// @Body(book)
+func (ec *XmlController) CreateBook(book Book) (Book, error) {
+	return book, nil
+}
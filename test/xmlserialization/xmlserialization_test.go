@@ -0,0 +1,99 @@
+package xmlserialization_test
+
+import (
+	"testing"
+
+	"github.com/gopher-fleece/gleece/definitions"
+	"github.com/gopher-fleece/gleece/infrastructure/logger"
+	"github.com/gopher-fleece/gleece/test/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("XML Serialization", func() {
+	var config *definitions.GleeceConfig
+	var metadata []definitions.ControllerMetadata
+	var models []definitions.ModelMetadata
+	var hasStdError bool
+
+	BeforeEach(func() {
+		var err error
+		config, metadata, models, hasStdError, err = utils.GetConfigAndMetadata("gleece.test.config.json")
+		Expect(err).To(BeNil())
+	})
+
+	It("Supports XML request and response bodies", func() {
+		route := metadata[0].Routes[0]
+		Expect(route.Consumes).To(Equal([]definitions.ContentType{definitions.ContentTypeJSON, definitions.ContentTypeXML}))
+		Expect(route.Produces).To(Equal([]definitions.ContentType{definitions.ContentTypeXML, definitions.ContentTypeJSON}))
+		Expect(route.ResponseContentType).To(Equal(definitions.ContentTypeXML))
+	})
+
+	It("Takes the name of the model's XML element from its 'XMLName' field", func() {
+		var book *definitions.ModelMetadata
+		for i := range models {
+			if models[i].Name == "Book" {
+				book = &models[i]
+			}
+		}
+
+		Expect(book).ToNot(BeNil())
+		Expect(book.XmlName).To(Equal("book"))
+		for _, field := range book.Fields {
+			Expect(field.Name).ToNot(Equal("XMLName"))
+		}
+	})
+
+	DescribeTable("Describes the XML encoding of models in the spec",
+		func(version string) {
+			spec := utils.GetSpec(config, metadata, models, hasStdError, version)
+			schema := spec["components"].(map[string]any)["schemas"].(map[string]any)["Book"].(map[string]any)
+			Expect(schema["xml"]).To(Equal(map[string]any{"name": "book"}))
+
+			properties := schema["properties"].(map[string]any)
+			Expect(properties).ToNot(HaveKey("XMLName"))
+			Expect(properties["id"].(map[string]any)["xml"]).To(Equal(map[string]any{"name": "id", "attribute": true}))
+			Expect(properties["title"].(map[string]any)["xml"]).To(Equal(map[string]any{"name": "title"}))
+			Expect(properties["author"].(map[string]any)["xml"]).To(Equal(map[string]any{"name": "writer"}))
+			Expect(properties["notes"]).ToNot(HaveKey("xml"))
+			Expect(properties["pages"]).ToNot(HaveKey("xml"))
+
+			// The elements of arrays are named individually
+			tagItems := properties["tags"].(map[string]any)["items"].(map[string]any)
+			Expect(tagItems["xml"]).To(Equal(map[string]any{"name": "tag"}))
+			Expect(tagItems["allOf"]).To(HaveLen(1))
+		},
+		Entry("OpenAPI 3.0", "3.0.0"),
+		Entry("OpenAPI 3.1", "3.1.0"),
+	)
+
+	DescribeTable("Lists the XML media type of each operation in the spec",
+		func(version string) {
+			spec := utils.GetSpec(config, metadata, models, hasStdError, version)
+			operation := spec["paths"].(map[string]any)["/test/xml/books"].(map[string]any)["post"].(map[string]any)
+
+			requestContent := operation["requestBody"].(map[string]any)["content"].(map[string]any)
+			Expect(requestContent).To(HaveLen(2))
+			Expect(requestContent).To(HaveKey("application/json"))
+			Expect(requestContent).To(HaveKey("application/xml"))
+
+			responseContent := operation["responses"].(map[string]any)["200"].(map[string]any)["content"].(map[string]any)
+			Expect(responseContent).To(HaveLen(2))
+			Expect(responseContent).To(HaveKey("application/json"))
+			Expect(responseContent).To(HaveKey("application/xml"))
+		},
+		Entry("OpenAPI 3.0", "3.0.0"),
+		Entry("OpenAPI 3.1", "3.1.0"),
+	)
+
+	It("Fails for maps produced as XML", func() {
+		_, _, _, _, err := utils.GetConfigAndMetadata("gleece.invalid.config.json")
+		Expect(err).To(MatchError(ContainSubstring("route 'GetLabels' cannot produce 'application/xml' as maps cannot be serialized to XML")))
+	})
+})
+
+func TestXmlSerialization(t *testing.T) {
+	logger.SetLogLevel(logger.LogLevelNone)
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "XML Serialization")
+}